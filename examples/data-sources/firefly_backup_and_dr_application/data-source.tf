@@ -0,0 +1,17 @@
+# Look up a backup application by ID
+data "firefly_backup_and_dr_application" "by_id" {
+  application_id = "692ec8acce65b3dc46cfceb6"
+}
+
+# Look up a backup application by name, the name must match exactly one application
+data "firefly_backup_and_dr_application" "production" {
+  application_name = "Production Backup"
+}
+
+output "production_scope" {
+  value = data.firefly_backup_and_dr_application.production.scope
+}
+
+output "production_backup_repo" {
+  value = try(data.firefly_backup_and_dr_application.production.vcs.repo_id, null)
+}
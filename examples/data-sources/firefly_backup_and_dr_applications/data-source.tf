@@ -47,3 +47,12 @@ output "aws_east_policies" {
 locals {
   active_application_ids = [for p in data.firefly_backup_and_dr_applications.active.applications : p.application_id]
 }
+
+# Count the active policies per region with the facets
+output "active_policies_per_region" {
+  value = {
+    for facet in data.firefly_backup_and_dr_applications.active.facets :
+    facet.field => { for value in facet.values : value.value => value.count }
+    if facet.field == "region"
+  }
+}
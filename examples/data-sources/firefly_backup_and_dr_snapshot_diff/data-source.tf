@@ -0,0 +1,20 @@
+# Compare the two most recent completed snapshots of a backup application
+data "firefly_backup_and_dr_snapshots" "production" {
+  application_id = firefly_backup_and_dr_application.production.application_id
+  status         = "completed"
+  limit          = 2
+}
+
+data "firefly_backup_and_dr_snapshot_diff" "latest" {
+  application_id     = firefly_backup_and_dr_application.production.application_id
+  base_snapshot_id   = data.firefly_backup_and_dr_snapshots.production.snapshots[1].snapshot_id
+  target_snapshot_id = data.firefly_backup_and_dr_snapshots.production.snapshots[0].snapshot_id
+}
+
+# Fail a DR drill when resources disappeared since the previous backup
+check "no_removed_resources" {
+  assert {
+    condition     = length(data.firefly_backup_and_dr_snapshot_diff.latest.removed) == 0
+    error_message = "Resources were removed since the previous backup: ${join(", ", data.firefly_backup_and_dr_snapshot_diff.latest.removed[*].resource_id)}"
+  }
+}
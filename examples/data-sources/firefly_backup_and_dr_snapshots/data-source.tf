@@ -0,0 +1,9 @@
+# Get the completed snapshots of a backup application, most recent first
+data "firefly_backup_and_dr_snapshots" "production" {
+  application_id = firefly_backup_and_dr_application.production.application_id
+  status         = "completed"
+}
+
+output "latest_snapshot_id" {
+  value = try(data.firefly_backup_and_dr_snapshots.production.snapshots[0].snapshot_id, null)
+}
@@ -0,0 +1,20 @@
+# Look up the AWS integration by name, so the configuration works in every Firefly environment
+data "firefly_cloud_integrations" "production" {
+  name       = "production"
+  exact_name = true
+  type       = "aws"
+}
+
+resource "firefly_backup_and_dr_application" "production" {
+  account_id       = "your-account-id"
+  application_name = "production"
+  integration_id   = one(data.firefly_cloud_integrations.production.integrations[*].id)
+  region           = "eu-west-1"
+  provider_type    = "aws"
+  frequency        = 24
+}
+
+# List all connected Kubernetes clusters
+data "firefly_cloud_integrations" "clusters" {
+  type = "k8s"
+}
@@ -18,7 +18,14 @@ data "firefly_governance_policies" "s3_policies" {
 
 # Get policies for a specific compliance framework
 data "firefly_governance_policies" "soc2_policies" {
-  labels = ["SOC2"]
+  frameworks = ["SOC2"]
+}
+
+# Get Firefly's built-in policies of high or critical severity for S3 buckets
+data "firefly_governance_policies" "builtin_s3" {
+  is_default = true
+  severity   = ["high", "critical"]
+  type       = ["aws_s3_bucket"]
 }
 
 # Filter policies by combining multiple criteria
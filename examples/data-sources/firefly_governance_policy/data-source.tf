@@ -0,0 +1,33 @@
+# Look up one of Firefly's built-in policies by its exact name
+data "firefly_governance_policy" "s3_encryption" {
+  name = "S3 Bucket Without Encryption"
+}
+
+# Look up a policy by ID
+data "firefly_governance_policy" "custom" {
+  id = "6712f3c5d1e2a4b5c6d7e8f9"
+}
+
+# Enforce the built-in policy in a guardrail
+resource "firefly_workflows_guardrail" "s3_encryption" {
+  name       = "Require S3 Encryption"
+  type       = "policy"
+  is_enabled = true
+  severity   = "strict"
+
+  scope {
+    workspaces {
+      include = ["*"]
+    }
+  }
+
+  criteria {
+    policy {
+      severity = "high"
+
+      policies {
+        include = [data.firefly_governance_policy.s3_encryption.id]
+      }
+    }
+  }
+}
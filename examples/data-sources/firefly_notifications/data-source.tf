@@ -0,0 +1,14 @@
+# Look up a notification channel created in the Firefly UI by its name
+data "firefly_notifications" "alerts" {
+  name       = "Platform Alerts"
+  exact_name = true
+}
+
+# Get all Slack notification channels
+data "firefly_notifications" "slack" {
+  type = "slack"
+}
+
+output "alerts_notification_id" {
+  value = one(data.firefly_notifications.alerts.notifications[*].id)
+}
@@ -0,0 +1,15 @@
+# Fetch a user by email
+data "firefly_user" "alice" {
+  email = "alice@example.com"
+}
+
+# Fetch a user by ID
+data "firefly_user" "by_id" {
+  id = "existing-user-id"
+}
+
+resource "firefly_project_membership" "alice" {
+  project_id = firefly_workflows_project.example.id
+  user_id    = data.firefly_user.alice.id
+  role       = "admin"
+}
@@ -0,0 +1,16 @@
+# Get all users of the account
+data "firefly_users" "all" {}
+
+# Get the account admins
+data "firefly_users" "admins" {
+  role = "admin"
+}
+
+# Search users by email domain
+data "firefly_users" "contractors" {
+  email = "@contractor.example.com"
+}
+
+output "admin_emails" {
+  value = [for user in data.firefly_users.admins.users : user.email]
+}
@@ -0,0 +1,16 @@
+# Look up the GitHub integration by name, so the configuration works in every Firefly environment
+data "firefly_vcs_integrations" "github" {
+  name       = "Main GitHub"
+  exact_name = true
+  type       = "github"
+}
+
+resource "firefly_workflows_runners_workspace" "example" {
+  name               = "production-app"
+  repository         = "myorg/infrastructure"
+  vcs_integration_id = one(data.firefly_vcs_integrations.github.integrations[*].id)
+  vcs_type           = "github"
+  default_branch     = "main"
+  iac_type           = "terraform"
+  terraform_version  = "1.6.0"
+}
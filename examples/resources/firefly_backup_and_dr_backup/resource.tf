@@ -0,0 +1,21 @@
+# Back up production before every release, then apply the release once the backup completed
+resource "firefly_backup_and_dr_backup" "pre_release" {
+  application_id = firefly_backup_and_dr_application.production.application_id
+
+  triggers = {
+    release = var.release
+  }
+}
+
+resource "firefly_workflows_run" "release" {
+  workspace_id = firefly_workflows_runners_workspace.production.id
+
+  triggers = {
+    release = var.release
+    backup  = firefly_backup_and_dr_backup.pre_release.snapshot_id
+  }
+}
+
+output "pre_release_snapshot_id" {
+  value = firefly_backup_and_dr_backup.pre_release.snapshot_id
+}
@@ -0,0 +1,23 @@
+# Game day: restore the last backup of the production application to the DR region
+resource "firefly_backup_and_dr_restore" "drill" {
+  application_id = firefly_backup_and_dr_application.production.application_id
+  target_region  = "us-west-2"
+  auto_create_pr = true
+
+  # A new restore starts for every drill
+  triggers = {
+    drill = var.drill_date
+  }
+}
+
+# Restore a specific snapshot to another account
+resource "firefly_backup_and_dr_restore" "snapshot" {
+  application_id  = firefly_backup_and_dr_application.production.application_id
+  snapshot_id     = data.firefly_backup_and_dr_snapshots.production.snapshots[1].snapshot_id
+  target_account  = "your-dr-integration-id"
+  timeout_minutes = 120
+}
+
+output "restore_pull_request" {
+  value = firefly_backup_and_dr_restore.drill.pull_request_url
+}
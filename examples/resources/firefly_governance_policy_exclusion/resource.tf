@@ -0,0 +1,19 @@
+data "firefly_governance_policy" "s3_encryption" {
+  name = "S3 Bucket Without Encryption"
+}
+
+# Exclude the access logs bucket until the end of the quarter
+resource "firefly_governance_policy_exclusion" "access_logs" {
+  policy_id     = data.firefly_governance_policy.s3_encryption.id
+  assets        = ["arn:aws:s3:::acme-access-logs"]
+  justification = "Access logs don't hold customer data, encryption is tracked in SEC-142"
+  expires_at    = "2025-06-30T00:00:00Z"
+}
+
+# Exclude every SQS queue of the sandbox account, without expiry
+resource "firefly_governance_policy_exclusion" "sandbox_queues" {
+  policy_id         = firefly_governance_policy.queue_encryption.id
+  resource_types    = ["aws_sqs_queue"]
+  provider_accounts = ["123456789012"]
+  justification     = "The sandbox account only holds test data"
+}
@@ -0,0 +1,38 @@
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+variable "pagerduty_integration_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "firefly_notification" "slack" {
+  name        = "platform-alerts"
+  type        = "slack"
+  webhook_url = var.slack_webhook_url
+  channel     = "#platform-alerts"
+}
+
+resource "firefly_notification" "email" {
+  name   = "platform-team"
+  type   = "email"
+  emails = ["platform@example.com", "oncall@example.com"]
+}
+
+resource "firefly_notification" "pagerduty" {
+  name            = "platform-on-call"
+  type            = "pagerduty"
+  integration_key = var.pagerduty_integration_key
+}
+
+# Alert the on-call rotation when a backup fails
+resource "firefly_backup_and_dr_application" "production" {
+  account_id       = "123456789012"
+  application_name = "production"
+  integration_id   = "aws-integration-id"
+  region           = "us-east-1"
+  provider_type    = "aws"
+  notification_id  = firefly_notification.pagerduty.id
+}
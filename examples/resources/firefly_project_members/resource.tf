@@ -0,0 +1,23 @@
+resource "firefly_workflows_project" "example" {
+  name        = "my-project"
+  description = "Example project with members"
+}
+
+resource "firefly_project_members" "example" {
+  project_id = firefly_workflows_project.example.id
+
+  member {
+    user_id = "user123"
+    role    = "admin"
+  }
+
+  member {
+    email = "member@example.com"
+    role  = "member"
+  }
+
+  member {
+    email = "viewer@example.com"
+    role  = "viewer"
+  }
+}
@@ -22,4 +22,11 @@ resource "firefly_project_membership" "viewer" {
   user_id    = "user789"
   email      = "viewer@example.com"
   role       = "viewer"
+}
+
+# The user ID is looked up from the email
+resource "firefly_project_membership" "by_email" {
+  project_id = firefly_workflows_project.example.id
+  email      = "developer@example.com"
+  role       = "member"
 }
\ No newline at end of file
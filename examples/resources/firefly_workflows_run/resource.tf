@@ -0,0 +1,24 @@
+# Apply the network workspace, then the application workspace that depends on it
+resource "firefly_workflows_run" "network" {
+  workspace_id = firefly_workflows_runners_workspace.network.id
+
+  triggers = {
+    release = var.release
+  }
+}
+
+resource "firefly_workflows_run" "app" {
+  workspace_id    = firefly_workflows_runners_workspace.app.id
+  timeout_minutes = 60
+
+  # A new run starts whenever the network run changes
+  triggers = {
+    network_run = firefly_workflows_run.network.run_id
+  }
+}
+
+# Plan only, e.g. to validate a change before merging it
+resource "firefly_workflows_run" "plan" {
+  workspace_id = firefly_workflows_runners_workspace.app.id
+  task_type    = "plan"
+}
@@ -34,4 +34,17 @@ resource "firefly_workflows_runners_workspace" "example" {
     sensitivity = "string"
     destination = "iac"
   }
+
+  # Typed Terraform variables
+  terraform_variables = {
+    instance_count = 3
+    zones          = ["us-west-2a", "us-west-2b"]
+  }
+
+  # Provider credentials, never returned by the API
+  providers_credentials = {
+    aws = {
+      role_arn = "arn:aws:iam::123456789012:role/firefly-runner"
+    }
+  }
 }
\ No newline at end of file
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// API gives access to the Firefly API services. *Client implements it, tests can
+// provide their own implementation to avoid going through HTTP.
+type API interface {
+	Workspaces() WorkspacesAPI
+	Guardrails() GuardrailsAPI
+	Projects() ProjectsAPI
+	RunnersWorkspaces() RunnersWorkspacesAPI
+	VariableSets() VariableSetsAPI
+	GovernancePolicies() GovernancePoliciesAPI
+	BackupAndDr() BackupAndDrAPI
+	Users() UsersAPI
+	Notifications() NotificationsAPI
+	Integrations() IntegrationsAPI
+}
+
+// WorkspacesAPI is the interface of WorkspaceService
+type WorkspacesAPI interface {
+	ListWorkspaces(ctx context.Context, request *ListWorkspacesRequest, page, pageSize int) ([]Workspace, error)
+	AllWorkspaces(ctx context.Context, request *ListWorkspacesRequest) iter.Seq2[Workspace, error]
+	DeleteWorkspace(ctx context.Context, workspaceID string) (*DeleteWorkspaceResponse, error)
+	UpdateWorkspaceLabels(ctx context.Context, workspaceID string, labels []string) (*UpdateWorkspaceLabelsResponse, error)
+	ListWorkspaceRuns(ctx context.Context, workspaceID string, request *ListWorkspaceRunsRequest, page, pageSize int) ([]WorkspaceRun, error)
+	AllWorkspaceRuns(ctx context.Context, workspaceID string, request *ListWorkspaceRunsRequest) iter.Seq2[WorkspaceRun, error]
+}
+
+// GuardrailsAPI is the interface of GuardrailService
+type GuardrailsAPI interface {
+	ListGuardrails(ctx context.Context, request *ListGuardrailsRequest, page, pageSize int) ([]GuardrailRule, error)
+	AllGuardrails(ctx context.Context, request *ListGuardrailsRequest) iter.Seq2[GuardrailRule, error]
+	CreateGuardrail(ctx context.Context, guardrail *GuardrailRule) (*CreateGuardrailResponse, error)
+	GetGuardrail(ctx context.Context, ruleID string) (*GuardrailRule, error)
+	UpdateGuardrail(ctx context.Context, ruleID string, guardrail *GuardrailRule) (*UpdateGuardrailResponse, error)
+	DeleteGuardrail(ctx context.Context, ruleID string) (*DeleteGuardrailResponse, error)
+}
+
+// ProjectsAPI is the interface of ProjectService
+type ProjectsAPI interface {
+	CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error)
+	GetProject(ctx context.Context, id string) (*Project, error)
+	UpdateProject(ctx context.Context, id string, req UpdateProjectRequest) (*Project, error)
+	DeleteProject(ctx context.Context, id string) error
+	ListProjectMembers(ctx context.Context, projectID string) ([]Member, error)
+	AddProjectMembers(ctx context.Context, projectID string, members []Member) ([]Member, error)
+	RemoveProjectMembers(ctx context.Context, projectID string, userIDs []string) error
+	GetProjectMember(ctx context.Context, projectID, userID string) (*Member, error)
+	AddProjectMember(ctx context.Context, projectID string, member Member) (*Member, error)
+	RemoveProjectMember(ctx context.Context, projectID, userID string) error
+	UpdateProjectMember(ctx context.Context, projectID string, member Member) (*Member, error)
+	ListProjects(ctx context.Context, pageSize, offset int, searchQuery string) (*ProjectsListResponse, error)
+	AllProjects(ctx context.Context, searchQuery string) iter.Seq2[Project, error]
+}
+
+// RunnersWorkspacesAPI is the interface of RunnersWorkspaceService
+type RunnersWorkspacesAPI interface {
+	CreateRunnersWorkspace(ctx context.Context, req CreateRunnersWorkspaceRequest) (*RunnersWorkspace, error)
+	GetRunnersWorkspace(ctx context.Context, id string) (*RunnersWorkspace, error)
+	UpdateRunnersWorkspace(ctx context.Context, id string, req UpdateRunnersWorkspaceRequest) (*RunnersWorkspace, error)
+	DeleteRunnersWorkspace(ctx context.Context, id string) error
+	DestroyWorkspaceResources(ctx context.Context, id string, req RunTaskRequest) (*TaskResponse, error)
+	GetTask(ctx context.Context, workspaceID, taskID string) (*TaskResponse, error)
+	ListTasks(ctx context.Context, workspaceID string) ([]TaskResponse, error)
+	RunWorkspaceTask(ctx context.Context, id string, req RunTaskRequest) (*TaskResponse, error)
+	GetWorkspaceRun(ctx context.Context, workspaceID, runID string) (*WorkspaceRun, error)
+}
+
+// VariableSetsAPI is the interface of VariableSetService
+type VariableSetsAPI interface {
+	CreateVariableSet(ctx context.Context, req CreateVariableSetRequest) (*CreateVariableSetResponse, error)
+	GetVariableSet(ctx context.Context, id string) (*VariableSet, error)
+	UpdateVariableSet(ctx context.Context, id string, req UpdateVariableSetRequest) (*VariableSet, error)
+	DeleteVariableSet(ctx context.Context, id string) error
+	UpsertVariablesInSet(ctx context.Context, id string, req UpsertVariableSetVariablesRequest) ([]Variable, error)
+	DeleteVariablesFromSet(ctx context.Context, id string, req DeleteVariablesRequest) error
+	ListVariableSets(ctx context.Context, pageSize, offset int, searchQuery string) ([]VariableSet, error)
+	AllVariableSets(ctx context.Context, searchQuery string) iter.Seq2[VariableSet, error]
+}
+
+// GovernancePoliciesAPI is the interface of GovernancePolicyService
+type GovernancePoliciesAPI interface {
+	List(ctx context.Context, request *GovernancePolicyListRequest) (*GovernancePoliciesResponse, error)
+	All(ctx context.Context, request *GovernancePolicyListRequest) iter.Seq2[GovernancePolicy, error]
+	Get(ctx context.Context, id string) (*GovernancePolicy, error)
+	Create(ctx context.Context, policy *GovernancePolicy) (*GovernancePolicy, error)
+	Update(ctx context.Context, id string, policy *GovernancePolicy) (*GovernancePolicy, error)
+	Delete(ctx context.Context, id string) error
+	CreateExclusion(ctx context.Context, policyID string, exclusion *GovernancePolicyExclusionRequest) (*GovernancePolicyExclusion, error)
+	GetExclusion(ctx context.Context, policyID, exclusionID string) (*GovernancePolicyExclusion, error)
+	UpdateExclusion(ctx context.Context, policyID, exclusionID string, exclusion *GovernancePolicyExclusionRequest) (*GovernancePolicyExclusion, error)
+	DeleteExclusion(ctx context.Context, policyID, exclusionID string) error
+}
+
+// BackupAndDrAPI is the interface of BackupAndDrService
+type BackupAndDrAPI interface {
+	Create(ctx context.Context, policy *PolicyCreateRequest) (*PolicyResponse, error)
+	Get(ctx context.Context, policyID string) (*PolicyResponse, error)
+	Update(ctx context.Context, policyID string, policy *PolicyUpdateRequest) (*PolicyResponse, error)
+	Delete(ctx context.Context, policyID string) error
+	List(ctx context.Context, filters *PolicyListFilters) (*PolicyListResponse, error)
+	All(ctx context.Context, filters *PolicyListFilters) iter.Seq2[PolicyResponse, error]
+	ListSnapshots(ctx context.Context, policyID string, filters *SnapshotListFilters) (*SnapshotListResponse, error)
+	AllSnapshots(ctx context.Context, policyID string, filters *SnapshotListFilters) iter.Seq2[Snapshot, error]
+	GetSnapshot(ctx context.Context, policyID, snapshotID string) (*Snapshot, error)
+	DiffSnapshots(ctx context.Context, policyID, baseSnapshotID, targetSnapshotID string) (*SnapshotDiff, error)
+	TriggerBackup(ctx context.Context, policyID string) (*Snapshot, error)
+	StartRestore(ctx context.Context, policyID string, restore *RestoreRequest) (*Restore, error)
+	GetRestore(ctx context.Context, policyID, restoreID string) (*Restore, error)
+}
+
+// UsersAPI is the interface of UserService
+type UsersAPI interface {
+	ListUsers(ctx context.Context, request *ListUsersRequest, pageSize, offset int) (*UsersListResponse, error)
+	AllUsers(ctx context.Context, request *ListUsersRequest) iter.Seq2[User, error]
+	GetUser(ctx context.Context, id string) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+}
+
+// NotificationsAPI is the interface of NotificationService
+type NotificationsAPI interface {
+	ListNotifications(ctx context.Context, request *ListNotificationsRequest, pageSize, offset int) (*NotificationsListResponse, error)
+	AllNotifications(ctx context.Context, request *ListNotificationsRequest) iter.Seq2[Notification, error]
+	CreateNotification(ctx context.Context, req NotificationRequest) (*Notification, error)
+	GetNotification(ctx context.Context, id string) (*Notification, error)
+	UpdateNotification(ctx context.Context, id string, req NotificationRequest) (*Notification, error)
+	DeleteNotification(ctx context.Context, id string) error
+}
+
+// IntegrationsAPI is the interface of IntegrationService
+type IntegrationsAPI interface {
+	ListVcsIntegrations(ctx context.Context, request *ListIntegrationsRequest, pageSize, offset int) (*VcsIntegrationsListResponse, error)
+	AllVcsIntegrations(ctx context.Context, request *ListIntegrationsRequest) iter.Seq2[VcsIntegration, error]
+	ListCloudIntegrations(ctx context.Context, request *ListIntegrationsRequest, pageSize, offset int) (*CloudIntegrationsListResponse, error)
+	AllCloudIntegrations(ctx context.Context, request *ListIntegrationsRequest) iter.Seq2[CloudIntegration, error]
+}
+
+var (
+	_ API                   = (*Client)(nil)
+	_ WorkspacesAPI         = (*WorkspaceService)(nil)
+	_ GuardrailsAPI         = (*GuardrailService)(nil)
+	_ ProjectsAPI           = (*ProjectService)(nil)
+	_ RunnersWorkspacesAPI  = (*RunnersWorkspaceService)(nil)
+	_ VariableSetsAPI       = (*VariableSetService)(nil)
+	_ GovernancePoliciesAPI = (*GovernancePolicyService)(nil)
+	_ BackupAndDrAPI        = (*BackupAndDrService)(nil)
+	_ UsersAPI              = (*UserService)(nil)
+	_ NotificationsAPI      = (*NotificationService)(nil)
+	_ IntegrationsAPI       = (*IntegrationService)(nil)
+)
+
+// Workspaces returns the workspace service
+func (c *Client) Workspaces() WorkspacesAPI {
+	return c.workspaces
+}
+
+// Guardrails returns the guardrail service
+func (c *Client) Guardrails() GuardrailsAPI {
+	return c.guardrails
+}
+
+// Projects returns the project service
+func (c *Client) Projects() ProjectsAPI {
+	return c.projects
+}
+
+// RunnersWorkspaces returns the runners workspace service
+func (c *Client) RunnersWorkspaces() RunnersWorkspacesAPI {
+	return c.runnersWorkspaces
+}
+
+// VariableSets returns the variable set service
+func (c *Client) VariableSets() VariableSetsAPI {
+	return c.variableSets
+}
+
+// GovernancePolicies returns the governance policy service
+func (c *Client) GovernancePolicies() GovernancePoliciesAPI {
+	return c.governancePolicies
+}
+
+// BackupAndDr returns the backup and disaster recovery service
+func (c *Client) BackupAndDr() BackupAndDrAPI {
+	return c.backupAndDr
+}
+
+// Users returns the user service
+func (c *Client) Users() UsersAPI {
+	return c.users
+}
+
+// Notifications returns the notification channel service
+func (c *Client) Notifications() NotificationsAPI {
+	return c.notifications
+}
+
+// Integrations returns the VCS and cloud integration service
+func (c *Client) Integrations() IntegrationsAPI {
+	return c.integrations
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultTokenRefreshMargin is how long before expiry a token is refreshed
+const DefaultTokenRefreshMargin = time.Minute
+
+// TokenSource supplies the bearer tokens used to authenticate API requests.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	// Token returns a valid access token, fetching a new one if needed
+	Token(ctx context.Context) (string, error)
+	// Invalidate discards token after the API rejected it, so that the next
+	// call to Token fetches a fresh one
+	Invalidate(token string)
+}
+
+// staticTokenSource always returns the same token
+type staticTokenSource string
+
+// StaticTokenSource returns a TokenSource for a pre-issued access token
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+// Token implements TokenSource
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// Invalidate implements TokenSource; a static token cannot be refreshed
+func (s staticTokenSource) Invalidate(token string) {}
+
+// loginTokenSource obtains tokens from the /v2/login endpoint using an access and secret key
+type loginTokenSource struct {
+	client        *Client
+	accessKey     string
+	secretKey     string
+	refreshMargin time.Duration
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+
+	// loginSlot allows a single login at a time, so that concurrent callers
+	// waiting for a token share the result of one request
+	loginSlot chan struct{}
+}
+
+// newLoginTokenSource creates a token source that logs in with the given keys
+func newLoginTokenSource(c *Client, accessKey, secretKey string, refreshMargin time.Duration) *loginTokenSource {
+	return &loginTokenSource{
+		client:        c,
+		accessKey:     accessKey,
+		secretKey:     secretKey,
+		refreshMargin: refreshMargin,
+		loginSlot:     make(chan struct{}, 1),
+	}
+}
+
+// cached returns the current token if it is not about to expire
+func (s *loginTokenSource) cached() (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(s.refreshMargin).Before(s.expiresAt) {
+		return s.token, true
+	}
+	return "", false
+}
+
+// Token implements TokenSource
+func (s *loginTokenSource) Token(ctx context.Context) (string, error) {
+	if token, ok := s.cached(); ok {
+		return token, nil
+	}
+
+	select {
+	case s.loginSlot <- struct{}{}:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	defer func() { <-s.loginSlot }()
+
+	// Another caller may have logged in while we were waiting
+	if token, ok := s.cached(); ok {
+		return token, nil
+	}
+
+	authResp, err := s.client.login(ctx, s.accessKey, s.secretKey)
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = authResp.AccessToken
+	s.expiresAt = time.Unix(authResp.ExpiresAt, 0)
+
+	return s.token, nil
+}
+
+// Invalidate implements TokenSource
+func (s *loginTokenSource) Invalidate(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Only drop the token if it has not been replaced in the meantime
+	if s.token == token {
+		s.token = ""
+		s.expiresAt = time.Time{}
+	}
+}
+
+// login exchanges an access and secret key for an access token
+func (c *Client) login(ctx context.Context, accessKey, secretKey string) (*AuthResponse, error) {
+	reqBody, err := json.Marshal(map[string]string{
+		"accessKey": accessKey,
+		"secretKey": secretKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding login request: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v2/login", c.baseURL), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("error creating login request: %s", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	// Logging in has no side effects, so it is always safe to retry
+	req = markRetryable(req)
+
+	resp, err := c.doWithRetry(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing login request: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "log in")
+	}
+
+	var authResp AuthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
+		return nil, fmt.Errorf("error decoding login response: %s", err)
+	}
+
+	return &authResp, nil
+}
+
+// doAuthenticated sends req with the given bearer token
+func (c *Client) doAuthenticated(req *http.Request, token string) (*http.Response, error) {
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+	req.Header.Set("User-Agent", c.userAgent)
+
+	return c.doWithRetry(req)
+}
+
+// replayRequest returns a copy of req with a fresh body, or nil if the body cannot be replayed
+func replayRequest(req *http.Request) *http.Request {
+	if req.Body != nil && req.GetBody == nil {
+		return nil
+	}
+
+	replay := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil
+		}
+		replay.Body = body
+	}
+
+	return replay
+}
+
+// drainBody discards the rest of the response body so the connection can be reused
+func drainBody(resp *http.Response) {
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// Authenticate obtains an access token, which checks that the configured credentials are valid
+func (c *Client) Authenticate(ctx context.Context) error {
+	_, err := c.tokens.Token(ctx)
+	return err
+}
@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// addCountingLoginHandler issues a new token on every login and counts the logins
+func addCountingLoginHandler(mockServer *MockServer, lifetime time.Duration) *int32 {
+	var logins int32
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&logins, 1)
+		// Make concurrent callers pile up behind the login
+		time.Sleep(10 * time.Millisecond)
+		authResp := AuthResponse{
+			AccessToken: fmt.Sprintf("token-%d", n),
+			ExpiresAt:   time.Now().Add(lifetime).Unix(),
+			TokenType:   "Bearer",
+		}
+		json.NewEncoder(w).Encode(authResp)
+	})
+	return &logins
+}
+
+func TestTokenSource_ConcurrentRequestsShareOneLogin(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	logins := addCountingLoginHandler(mockServer, time.Hour)
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(Project{ID: "test-project-id"})
+	})
+
+	client := newTestClient(t, mockServer.URL())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("GetProject failed: %v", err)
+	}
+
+	if got := atomic.LoadInt32(logins); got != 1 {
+		t.Errorf("Expected 1 login, got %d", got)
+	}
+}
+
+func TestTokenSource_RefreshesBeforeExpiry(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// Tokens expire within the default refresh margin, so every call logs in again
+	logins := addCountingLoginHandler(mockServer, 30*time.Second)
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Project{ID: "test-project-id"})
+	})
+
+	client := newTestClient(t, mockServer.URL())
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err != nil {
+			t.Fatalf("GetProject failed: %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(logins); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+}
+
+func TestTokenSource_ReauthenticatesOnUnauthorized(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	logins := addCountingLoginHandler(mockServer, time.Hour)
+
+	// The first token is revoked server-side
+	var calls int32
+	mockServer.AddHandler("/v2/runners/projects", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			http.Error(w, "Token revoked", http.StatusUnauthorized)
+			return
+		}
+
+		var createReq CreateProjectRequest
+		if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil || createReq.Name != "Test Project" {
+			http.Error(w, "Invalid body", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Project{ID: "new-project-id", Name: createReq.Name})
+	})
+
+	client := newTestClient(t, mockServer.URL())
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Projects().CreateProject(context.Background(), CreateProjectRequest{Name: "Test Project"}); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("CreateProject failed: %v", err)
+	}
+
+	if got := atomic.LoadInt32(logins); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+}
+
+func TestTokenSource_GivesUpAfterOneReauthentication(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	logins := addCountingLoginHandler(mockServer, time.Hour)
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Forbidden key", http.StatusUnauthorized)
+	})
+
+	client := newTestClient(t, mockServer.URL())
+
+	_, err := client.Projects().GetProject(context.Background(), "test-project-id")
+	if !IsUnauthorized(err) {
+		t.Fatalf("Expected unauthorized error, got %v", err)
+	}
+
+	if got := atomic.LoadInt32(logins); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+}
+
+func TestStaticTokenSource(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Login should not be called with a custom token source")
+	})
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer static-token" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(Project{ID: "test-project-id"})
+	})
+
+	client, err := NewClient(Config{
+		APIURL:      mockServer.URL(),
+		TokenSource: StaticTokenSource("static-token"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err != nil {
+		t.Fatalf("GetProject failed: %v", err)
+	}
+}
+
+func TestTokenSource_LoginRespectsContext(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	release := make(chan struct{})
+	defer close(release)
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+
+	client := newTestClient(t, mockServer.URL())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Both the caller doing the login and the one waiting for it must give up
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.tokens.Token(ctx); err == nil {
+				t.Error("Expected error when the context expires")
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,11 +1,13 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
+	"strconv"
 )
 
 // BackupAndDrService provides access to the Backup & DR API methods
@@ -98,6 +100,9 @@ type PolicyListFilters struct {
 	IntegrationID string
 	Region        string
 	ProviderType  string
+	// Page is the 1-based page to fetch and PageSize the number of policies per page, the API defaults apply when zero
+	Page     int
+	PageSize int
 }
 
 // Pagination represents pagination metadata
@@ -181,10 +186,10 @@ func ConvertCreateToUpdate(create *PolicyCreateRequest) *PolicyUpdateRequest {
 }
 
 // Create creates a new backup policy
-func (s *BackupAndDrService) Create(policy *PolicyCreateRequest) (*PolicyResponse, error) {
+func (s *BackupAndDrService) Create(ctx context.Context, policy *PolicyCreateRequest) (*PolicyResponse, error) {
 	endpoint := "/v2/backup-and-dr/policies"
 
-	req, err := s.client.newRequest("POST", endpoint, policy)
+	req, err := s.client.newRequest(ctx, "POST", endpoint, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -196,8 +201,7 @@ func (s *BackupAndDrService) Create(policy *PolicyCreateRequest) (*PolicyRespons
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "create backup policy")
 	}
 
 	var result PolicyResponse
@@ -209,10 +213,10 @@ func (s *BackupAndDrService) Create(policy *PolicyCreateRequest) (*PolicyRespons
 }
 
 // Get retrieves a specific backup policy by ID
-func (s *BackupAndDrService) Get(policyID string) (*PolicyResponse, error) {
+func (s *BackupAndDrService) Get(ctx context.Context, policyID string) (*PolicyResponse, error) {
 	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s", url.PathEscape(policyID))
 
-	req, err := s.client.newRequest("GET", endpoint, nil)
+	req, err := s.client.newRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -223,13 +227,8 @@ func (s *BackupAndDrService) Get(policyID string) (*PolicyResponse, error) {
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("policy not found: %s", policyID)
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "get backup policy")
 	}
 
 	var result PolicyResponse
@@ -241,10 +240,10 @@ func (s *BackupAndDrService) Get(policyID string) (*PolicyResponse, error) {
 }
 
 // Update updates an existing backup policy
-func (s *BackupAndDrService) Update(policyID string, policy *PolicyUpdateRequest) (*PolicyResponse, error) {
+func (s *BackupAndDrService) Update(ctx context.Context, policyID string, policy *PolicyUpdateRequest) (*PolicyResponse, error) {
 	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s", url.PathEscape(policyID))
 
-	req, err := s.client.newRequest("PUT", endpoint, policy)
+	req, err := s.client.newRequest(ctx, "PUT", endpoint, policy)
 	if err != nil {
 		return nil, err
 	}
@@ -256,8 +255,7 @@ func (s *BackupAndDrService) Update(policyID string, policy *PolicyUpdateRequest
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "update backup policy")
 	}
 
 	var result PolicyResponse
@@ -269,10 +267,10 @@ func (s *BackupAndDrService) Update(policyID string, policy *PolicyUpdateRequest
 }
 
 // Delete deletes a backup policy
-func (s *BackupAndDrService) Delete(policyID string) error {
+func (s *BackupAndDrService) Delete(ctx context.Context, policyID string) error {
 	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s", url.PathEscape(policyID))
 
-	req, err := s.client.newRequest("DELETE", endpoint, nil)
+	req, err := s.client.newRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return err
 	}
@@ -285,15 +283,14 @@ func (s *BackupAndDrService) Delete(policyID string) error {
 
 	// Accept both 200 and 204 for successful deletion
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp, "delete backup policy")
 	}
 
 	return nil
 }
 
 // List retrieves all backup policies with optional filters
-func (s *BackupAndDrService) List(filters *PolicyListFilters) (*PolicyListResponse, error) {
+func (s *BackupAndDrService) List(ctx context.Context, filters *PolicyListFilters) (*PolicyListResponse, error) {
 	endpoint := "/v2/backup-and-dr/policies"
 
 	// Build query parameters if filters are provided
@@ -311,13 +308,19 @@ func (s *BackupAndDrService) List(filters *PolicyListFilters) (*PolicyListRespon
 		if filters.ProviderType != "" {
 			queryParams.Add("provider_type", filters.ProviderType)
 		}
+		if filters.Page > 0 {
+			queryParams.Add("page", strconv.Itoa(filters.Page))
+		}
+		if filters.PageSize > 0 {
+			queryParams.Add("page_size", strconv.Itoa(filters.PageSize))
+		}
 	}
 
 	if len(queryParams) > 0 {
 		endpoint = endpoint + "?" + queryParams.Encode()
 	}
 
-	req, err := s.client.newRequest("GET", endpoint, nil)
+	req, err := s.client.newRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -329,8 +332,7 @@ func (s *BackupAndDrService) List(filters *PolicyListFilters) (*PolicyListRespon
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "list backup policies")
 	}
 
 	var result PolicyListResponse
@@ -340,3 +342,24 @@ func (s *BackupAndDrService) List(filters *PolicyListFilters) (*PolicyListRespon
 
 	return &result, nil
 }
+
+// All iterates over every backup policy matching the filters, fetching pages as needed
+func (s *BackupAndDrService) All(ctx context.Context, filters *PolicyListFilters) iter.Seq2[PolicyResponse, error] {
+	// Work on a copy so the caller's filters are not modified between pages
+	pageFilters := PolicyListFilters{}
+	if filters != nil {
+		pageFilters = *filters
+	}
+	if pageFilters.PageSize == 0 {
+		pageFilters.PageSize = DefaultPageSize
+	}
+
+	return paginate(ctx, func(ctx context.Context, page int) ([]PolicyResponse, bool, error) {
+		pageFilters.Page = page + 1
+		result, err := s.List(ctx, &pageFilters)
+		if err != nil {
+			return nil, false, err
+		}
+		return result.Data, result.Pagination.HasNext, nil
+	})
+}
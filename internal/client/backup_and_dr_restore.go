@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Statuses of a restore
+const (
+	RestoreStatusPending   = "pending"
+	RestoreStatusRunning   = "running"
+	RestoreStatusCompleted = "completed"
+	RestoreStatusFailed    = "failed"
+)
+
+// IsTerminalRestoreStatus reports whether a restore with the given status has finished
+func IsTerminalRestoreStatus(status string) bool {
+	return status == RestoreStatusCompleted || status == RestoreStatusFailed
+}
+
+// RestoreRequest represents a request to restore a snapshot of a backup policy. The target
+// account, region and auto PR setting of the policy apply when not set.
+type RestoreRequest struct {
+	SnapshotID    string `json:"snapshot_id"`
+	TargetAccount string `json:"target_account,omitempty"`
+	TargetRegion  string `json:"target_region,omitempty"`
+	AutoCreatePR  *bool  `json:"auto_create_pr,omitempty"`
+}
+
+// Restore represents the restore of a snapshot to a target account and region
+type Restore struct {
+	RestoreID     string `json:"restore_id"`
+	PolicyID      string `json:"policy_id"`
+	SnapshotID    string `json:"snapshot_id"`
+	TargetAccount string `json:"target_account"`
+	TargetRegion  string `json:"target_region"`
+	AutoCreatePR  bool   `json:"auto_create_pr"`
+	Status        string `json:"status"`
+	// PullRequestURL is the VCS pull request with the IaC of the restored resources, set once
+	// completed when AutoCreatePR is true
+	PullRequestURL string `json:"pull_request_url,omitempty"`
+	ErrorMessage   string `json:"error_message,omitempty"`
+	CreatedAt      string `json:"created_at"`
+	CompletedAt    string `json:"completed_at,omitempty"`
+}
+
+// RestorePoller returns a PollFunc reading a restore of a backup policy, for WaitFor
+func RestorePoller(api BackupAndDrAPI, policyID, restoreID string) PollFunc[*Restore] {
+	return func(ctx context.Context) (*Restore, bool, error) {
+		restore, err := api.GetRestore(ctx, policyID, restoreID)
+		if err != nil {
+			return nil, false, err
+		}
+		return restore, IsTerminalRestoreStatus(restore.Status), nil
+	}
+}
+
+// StartRestore starts restoring a snapshot of a backup policy. The restore runs asynchronously,
+// use GetRestore or RestorePoller to follow it.
+func (s *BackupAndDrService) StartRestore(ctx context.Context, policyID string, restore *RestoreRequest) (*Restore, error) {
+	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s/restores", url.PathEscape(policyID))
+
+	req, err := s.client.newRequest(ctx, "POST", endpoint, restore)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, "start backup restore")
+	}
+
+	var result Restore
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetRestore retrieves the status of a restore of a backup policy
+func (s *BackupAndDrService) GetRestore(ctx context.Context, policyID, restoreID string) (*Restore, error) {
+	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s/restores/%s", url.PathEscape(policyID), url.PathEscape(restoreID))
+
+	req, err := s.client.newRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "get backup restore")
+	}
+
+	var result Restore
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackupAndDrService_Restore(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	mockServer.AddHandler("/v2/backup-and-dr/policies/policy-123/restores", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// The unset settings of the policy must not be sent
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["auto_create_pr"]; ok || body["target_region"] != "eu-west-1" {
+			http.Error(w, "Unexpected body", http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Restore{RestoreID: "restore-1", PolicyID: "policy-123", SnapshotID: "snapshot-1", TargetRegion: "eu-west-1", Status: RestoreStatusPending})
+	})
+
+	polls := 0
+	mockServer.AddHandler("/v2/backup-and-dr/policies/policy-123/restores/restore-1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		restore := Restore{RestoreID: "restore-1", PolicyID: "policy-123", Status: RestoreStatusRunning}
+		if polls == 2 {
+			restore.Status = RestoreStatusCompleted
+			restore.PullRequestURL = "https://github.com/acme/infra/pull/1"
+		}
+		json.NewEncoder(w).Encode(restore)
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	restore, err := client.BackupAndDr().StartRestore(ctx, "policy-123", &RestoreRequest{SnapshotID: "snapshot-1", TargetRegion: "eu-west-1"})
+	if err != nil {
+		t.Fatalf("StartRestore failed: %v", err)
+	}
+	if restore.RestoreID != "restore-1" || restore.Status != RestoreStatusPending {
+		t.Errorf("Unexpected restore %+v", restore)
+	}
+
+	restore, err = WaitFor(ctx, RestorePoller(client.BackupAndDr(), "policy-123", restore.RestoreID), WaitOptions{InitialInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("WaitFor failed: %v", err)
+	}
+	if polls != 2 || restore.PullRequestURL != "https://github.com/acme/infra/pull/1" {
+		t.Errorf("Expected the completed restore after 2 polls, got %+v after %d", restore, polls)
+	}
+}
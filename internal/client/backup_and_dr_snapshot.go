@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Statuses of a snapshot, also reported as the last backup status of a policy
+const (
+	SnapshotStatusRunning   = "running"
+	SnapshotStatusCompleted = "completed"
+	SnapshotStatusFailed    = "failed"
+)
+
+// IsTerminalSnapshotStatus reports whether a snapshot with the given status has finished
+func IsTerminalSnapshotStatus(status string) bool {
+	return status == SnapshotStatusCompleted || status == SnapshotStatusFailed
+}
+
+// Snapshot represents a backup of the resources in the scope of a Backup & DR application
+type Snapshot struct {
+	SnapshotID  string `json:"snapshot_id"`
+	PolicyID    string `json:"policy_id"`
+	Status      string `json:"status"`
+	Trigger     string `json:"trigger,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	CompletedAt string `json:"completed_at,omitempty"`
+	// ResourcesCount is the number of resources backed up, FailedResourcesCount the number that could not be
+	ResourcesCount       int `json:"resources_count"`
+	FailedResourcesCount int `json:"failed_resources_count"`
+}
+
+// BackupPoller returns a PollFunc reading a snapshot of a backup policy until it has finished, for WaitFor
+func BackupPoller(api BackupAndDrAPI, policyID, snapshotID string) PollFunc[*Snapshot] {
+	return func(ctx context.Context) (*Snapshot, bool, error) {
+		snapshot, err := api.GetSnapshot(ctx, policyID, snapshotID)
+		if err != nil {
+			return nil, false, err
+		}
+		return snapshot, IsTerminalSnapshotStatus(snapshot.Status), nil
+	}
+}
+
+// SnapshotListFilters represents filters for listing the snapshots of an application
+type SnapshotListFilters struct {
+	Status string
+	// Page is the 1-based page to fetch and PageSize the number of snapshots per page, the API defaults apply when zero
+	Page     int
+	PageSize int
+}
+
+// SnapshotListResponse represents the response from listing snapshots, most recent first
+type SnapshotListResponse struct {
+	Data       []Snapshot `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// SnapshotDiffResource represents a resource that differs between two snapshots
+type SnapshotDiffResource struct {
+	ResourceID   string `json:"resource_id"`
+	ResourceType string `json:"resource_type"`
+	Name         string `json:"name,omitempty"`
+	Region       string `json:"region,omitempty"`
+	// ChangedAttributes are the configuration attributes of a modified resource that changed
+	ChangedAttributes []string `json:"changed_attributes,omitempty"`
+}
+
+// SnapshotDiff represents the resources added, removed and modified from a base snapshot to a target snapshot
+type SnapshotDiff struct {
+	BaseSnapshotID   string                 `json:"base_snapshot_id"`
+	TargetSnapshotID string                 `json:"target_snapshot_id"`
+	Added            []SnapshotDiffResource `json:"added"`
+	Removed          []SnapshotDiffResource `json:"removed"`
+	Modified         []SnapshotDiffResource `json:"modified"`
+}
+
+// ListSnapshots retrieves a page of the snapshots of a backup policy, most recent first
+func (s *BackupAndDrService) ListSnapshots(ctx context.Context, policyID string, filters *SnapshotListFilters) (*SnapshotListResponse, error) {
+	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s/snapshots", url.PathEscape(policyID))
+
+	queryParams := url.Values{}
+	if filters != nil {
+		if filters.Status != "" {
+			queryParams.Add("status", filters.Status)
+		}
+		if filters.Page > 0 {
+			queryParams.Add("page", strconv.Itoa(filters.Page))
+		}
+		if filters.PageSize > 0 {
+			queryParams.Add("page_size", strconv.Itoa(filters.PageSize))
+		}
+	}
+
+	if len(queryParams) > 0 {
+		endpoint = endpoint + "?" + queryParams.Encode()
+	}
+
+	req, err := s.client.newRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "list backup snapshots")
+	}
+
+	var result SnapshotListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// AllSnapshots iterates over every snapshot of a backup policy matching the filters, most recent first
+func (s *BackupAndDrService) AllSnapshots(ctx context.Context, policyID string, filters *SnapshotListFilters) iter.Seq2[Snapshot, error] {
+	// Work on a copy so the caller's filters are not modified between pages
+	pageFilters := SnapshotListFilters{}
+	if filters != nil {
+		pageFilters = *filters
+	}
+	if pageFilters.PageSize == 0 {
+		pageFilters.PageSize = DefaultPageSize
+	}
+
+	return paginate(ctx, func(ctx context.Context, page int) ([]Snapshot, bool, error) {
+		pageFilters.Page = page + 1
+		result, err := s.ListSnapshots(ctx, policyID, &pageFilters)
+		if err != nil {
+			return nil, false, err
+		}
+		return result.Data, result.Pagination.HasNext, nil
+	})
+}
+
+// GetSnapshot retrieves a snapshot of a backup policy by ID. The API has no endpoint reading a
+// single snapshot, so the snapshots are listed most recent first until it is found.
+func (s *BackupAndDrService) GetSnapshot(ctx context.Context, policyID, snapshotID string) (*Snapshot, error) {
+	return Find(s.AllSnapshots(ctx, policyID, nil), func(snapshot Snapshot) bool {
+		return snapshot.SnapshotID == snapshotID
+	})
+}
+
+// DiffSnapshots compares the resources of two snapshots of a backup policy. Resources are added
+// or modified in targetSnapshotID compared to baseSnapshotID.
+func (s *BackupAndDrService) DiffSnapshots(ctx context.Context, policyID, baseSnapshotID, targetSnapshotID string) (*SnapshotDiff, error) {
+	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s/snapshots/%s/diff?%s",
+		url.PathEscape(policyID), url.PathEscape(targetSnapshotID), url.Values{"base": {baseSnapshotID}}.Encode())
+
+	req, err := s.client.newRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "diff backup snapshots")
+	}
+
+	var result SnapshotDiff
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// TriggerBackup starts an immediate backup of a backup policy and returns the snapshot being
+// taken. The backup runs asynchronously, use BackupPoller to wait for it.
+func (s *BackupAndDrService) TriggerBackup(ctx context.Context, policyID string) (*Snapshot, error) {
+	endpoint := fmt.Sprintf("/v2/backup-and-dr/policies/%s/backup", url.PathEscape(policyID))
+
+	req, err := s.client.newRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, "trigger backup")
+	}
+
+	var result Snapshot
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newSnapshotsTestClient returns a client for a mock server holding 150 snapshots of policy-123
+func newSnapshotsTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	mockServer := NewMockServer()
+	t.Cleanup(mockServer.Close)
+
+	mockServer.AddLoginHandler()
+
+	mockServer.AddHandler("/v2/backup-and-dr/policies/policy-123/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		if page < 1 || pageSize < 1 || r.URL.Query().Get("status") != "completed" {
+			http.Error(w, "Unexpected query", http.StatusBadRequest)
+			return
+		}
+
+		var snapshots []Snapshot
+		for i := (page - 1) * pageSize; i < min(page*pageSize, 150); i++ {
+			snapshots = append(snapshots, Snapshot{SnapshotID: "snapshot-" + strconv.Itoa(i), PolicyID: "policy-123", Status: "completed"})
+		}
+
+		json.NewEncoder(w).Encode(SnapshotListResponse{
+			Data:       snapshots,
+			Pagination: Pagination{Page: page, PageSize: pageSize, Total: 150, HasNext: page*pageSize < 150},
+		})
+	})
+
+	mockServer.AddHandler("/v2/backup-and-dr/policies/policy-123/snapshots/snapshot-0/diff", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("base") != "snapshot-1" {
+			http.Error(w, "snapshot not found", http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(SnapshotDiff{
+			BaseSnapshotID:   "snapshot-1",
+			TargetSnapshotID: "snapshot-0",
+			Added:            []SnapshotDiffResource{{ResourceID: "i-123", ResourceType: "aws_instance"}},
+			Modified:         []SnapshotDiffResource{{ResourceID: "sg-123", ResourceType: "aws_security_group", ChangedAttributes: []string{"ingress"}}},
+		})
+	})
+
+	return newTestClient(t, mockServer.URL())
+}
+
+func TestBackupAndDrService_AllSnapshots(t *testing.T) {
+	client := newSnapshotsTestClient(t)
+
+	snapshots, err := Collect(client.BackupAndDr().AllSnapshots(context.Background(), "policy-123", &SnapshotListFilters{Status: "completed"}), 0)
+	if err != nil {
+		t.Fatalf("AllSnapshots failed: %v", err)
+	}
+	if len(snapshots) != 150 || snapshots[149].SnapshotID != "snapshot-149" {
+		t.Errorf("Expected 150 snapshots, got %d", len(snapshots))
+	}
+}
+
+func TestBackupAndDrService_DiffSnapshots(t *testing.T) {
+	client := newSnapshotsTestClient(t)
+
+	diff, err := client.BackupAndDr().DiffSnapshots(context.Background(), "policy-123", "snapshot-1", "snapshot-0")
+	if err != nil {
+		t.Fatalf("DiffSnapshots failed: %v", err)
+	}
+	if len(diff.Added) != 1 || len(diff.Removed) != 0 || len(diff.Modified) != 1 || diff.Modified[0].ChangedAttributes[0] != "ingress" {
+		t.Errorf("Unexpected diff %+v", diff)
+	}
+
+	if _, err := client.BackupAndDr().DiffSnapshots(context.Background(), "policy-123", "unknown", "snapshot-0"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestBackupAndDrService_TriggerBackup(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddLoginHandler()
+
+	mockServer.AddHandler("/v2/backup-and-dr/policies/policy-123/backup", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Snapshot{SnapshotID: "snapshot-2", PolicyID: "policy-123", Status: SnapshotStatusRunning, Trigger: "on_demand"})
+	})
+
+	// A scheduled backup starts after the on-demand one, which completes on the second poll
+	polls := 0
+	mockServer.AddHandler("/v2/backup-and-dr/policies/policy-123/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		snapshots := []Snapshot{
+			{SnapshotID: "snapshot-3", PolicyID: "policy-123", Status: SnapshotStatusRunning, Trigger: "scheduled"},
+			{SnapshotID: "snapshot-2", PolicyID: "policy-123", Status: []string{SnapshotStatusRunning, SnapshotStatusCompleted}[min(polls-1, 1)], Trigger: "on_demand"},
+			{SnapshotID: "snapshot-1", PolicyID: "policy-123", Status: SnapshotStatusCompleted, Trigger: "scheduled"},
+		}
+		json.NewEncoder(w).Encode(SnapshotListResponse{Data: snapshots, Pagination: Pagination{Page: 1, PageSize: len(snapshots), Total: len(snapshots)}})
+	})
+
+	client := newTestClient(t, mockServer.URL())
+
+	ctx := context.Background()
+	snapshot, err := client.BackupAndDr().TriggerBackup(ctx, "policy-123")
+	if err != nil {
+		t.Fatalf("TriggerBackup failed: %v", err)
+	}
+	if snapshot.SnapshotID != "snapshot-2" || snapshot.Status != SnapshotStatusRunning {
+		t.Errorf("Unexpected snapshot %+v", snapshot)
+	}
+
+	taken, err := WaitFor(ctx, BackupPoller(client.BackupAndDr(), "policy-123", snapshot.SnapshotID), WaitOptions{InitialInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("WaitFor failed: %v", err)
+	}
+	if polls != 2 || taken.SnapshotID != "snapshot-2" || taken.Status != SnapshotStatusCompleted {
+		t.Errorf("Expected the completed backup after 2 polls, got %+v after %d", taken, polls)
+	}
+
+	if _, err := client.BackupAndDr().GetSnapshot(ctx, "policy-123", "unknown"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
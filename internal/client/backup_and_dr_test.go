@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -36,21 +37,21 @@ func TestBackupAndDrService_Create(t *testing.T) {
 		}
 
 		createResp := PolicyResponse{
-			PolicyID:        "policy-123",
-			AccountID:       testAccountID,
-			PolicyName:      createReq.PolicyName,
-			IntegrationID:   createReq.IntegrationID,
-			Region:          createReq.Region,
-			ProviderType:    createReq.ProviderType,
-			Frequency:       createReq.Frequency,
-			Description:     createReq.Description,
-			Scope:           createReq.Scope,
-			NotificationID:  createReq.NotificationID,
-			VCS:             createReq.VCS,
-			Status:          "Active",
-			SnapshotsCount:  0,
-			CreatedAt:       "2025-01-01T00:00:00Z",
-			UpdatedAt:       "2025-01-01T00:00:00Z",
+			PolicyID:       "policy-123",
+			AccountID:      testAccountID,
+			PolicyName:     createReq.PolicyName,
+			IntegrationID:  createReq.IntegrationID,
+			Region:         createReq.Region,
+			ProviderType:   createReq.ProviderType,
+			Frequency:      createReq.Frequency,
+			Description:    createReq.Description,
+			Scope:          createReq.Scope,
+			NotificationID: createReq.NotificationID,
+			VCS:            createReq.VCS,
+			Status:         "Active",
+			SnapshotsCount: 0,
+			CreatedAt:      "2025-01-01T00:00:00Z",
+			UpdatedAt:      "2025-01-01T00:00:00Z",
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -77,7 +78,7 @@ func TestBackupAndDrService_Create(t *testing.T) {
 		BackupOnSave:  true,
 	}
 
-	response, err := c.BackupAndDr.Create(policy)
+	response, err := c.BackupAndDr().Create(context.Background(), policy)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -159,7 +160,7 @@ func TestBackupAndDrService_CreateWithScope(t *testing.T) {
 		BackupOnSave: true,
 	}
 
-	response, err := c.BackupAndDr.Create(policy)
+	response, err := c.BackupAndDr().Create(context.Background(), policy)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -231,7 +232,7 @@ func TestBackupAndDrService_CreateWithVCS(t *testing.T) {
 		BackupOnSave: true,
 	}
 
-	response, err := c.BackupAndDr.Create(policy)
+	response, err := c.BackupAndDr().Create(context.Background(), policy)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -306,7 +307,7 @@ func TestBackupAndDrService_CreateWithResilienceFields(t *testing.T) {
 		ResilienceEnabled: true,
 	}
 
-	response, err := c.BackupAndDr.Create(policy)
+	response, err := c.BackupAndDr().Create(context.Background(), policy)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -376,7 +377,7 @@ func TestBackupAndDrService_Get(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	response, err := c.BackupAndDr.Get("policy-123")
+	response, err := c.BackupAndDr().Get(context.Background(), "policy-123")
 	if err != nil {
 		t.Fatalf("Get failed: %v", err)
 	}
@@ -465,7 +466,7 @@ func TestBackupAndDrService_Update(t *testing.T) {
 	}
 
 	updatePolicy := ConvertCreateToUpdate(policy)
-	response, err := c.BackupAndDr.Update("policy-123", updatePolicy)
+	response, err := c.BackupAndDr().Update(context.Background(), "policy-123", updatePolicy)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -516,7 +517,7 @@ func TestBackupAndDrService_Delete(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	err = c.BackupAndDr.Delete("policy-123")
+	err = c.BackupAndDr().Delete(context.Background(), "policy-123")
 	if err != nil {
 		t.Fatalf("Delete failed: %v", err)
 	}
@@ -584,7 +585,7 @@ func TestBackupAndDrService_List(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	response, err := c.BackupAndDr.List(nil)
+	response, err := c.BackupAndDr().List(context.Background(), nil)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -661,7 +662,7 @@ func TestBackupAndDrService_ListWithFilters(t *testing.T) {
 		Region: "us-east-1",
 	}
 
-	response, err := c.BackupAndDr.List(filters)
+	response, err := c.BackupAndDr().List(context.Background(), filters)
 	if err != nil {
 		t.Fatalf("List with filters failed: %v", err)
 	}
@@ -701,12 +702,12 @@ func TestBackupAndDrService_ErrorHandling(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	_, err = c.BackupAndDr.Get("not-found")
+	_, err = c.BackupAndDr().Get(context.Background(), "not-found")
 	if err == nil {
 		t.Error("Expected error for not found policy, got nil")
 	}
 
-	err = c.BackupAndDr.Delete("not-found")
+	err = c.BackupAndDr().Delete(context.Background(), "not-found")
 	if err == nil {
 		t.Error("Expected error for deleting not found policy, got nil")
 	}
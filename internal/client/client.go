@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,6 +22,16 @@ type Config struct {
 	SecretKey  string
 	APIURL     string
 	HTTPClient *http.Client
+
+	// TokenSource overrides the access/secret key login, AccessKey and SecretKey are not needed when set
+	TokenSource TokenSource
+	// TokenRefreshMargin is how long before expiry the token is refreshed, defaults to DefaultTokenRefreshMargin
+	TokenRefreshMargin time.Duration
+
+	// MaxRetries is the number of times a failed request is retried (0 disables retries)
+	MaxRetries int
+	// RetryMaxWait caps the wait between two retries, defaults to DefaultRetryMaxWait
+	RetryMaxWait time.Duration
 }
 
 // Client is a client for interacting with the Firefly API
@@ -28,21 +39,22 @@ type Client struct {
 	baseURL    *url.URL
 	userAgent  string
 	httpClient *http.Client
+	retry      retryPolicy
 
 	// Authentication
-	accessKey string
-	secretKey string
-	authToken string
-	expiresAt time.Time
-
-	// Services
-	Workspaces         *WorkspaceService
-	Guardrails         *GuardrailService
-	Projects           *ProjectService
-	RunnersWorkspaces  *RunnersWorkspaceService
-	VariableSets       *VariableSetService
-	GovernancePolicies *GovernancePolicyService
-	BackupAndDr        *BackupAndDrService
+	tokens TokenSource
+
+	// Services, exposed through the API accessors
+	workspaces         *WorkspaceService
+	guardrails         *GuardrailService
+	projects           *ProjectService
+	runnersWorkspaces  *RunnersWorkspaceService
+	variableSets       *VariableSetService
+	governancePolicies *GovernancePolicyService
+	backupAndDr        *BackupAndDrService
+	users              *UserService
+	notifications      *NotificationService
+	integrations       *IntegrationService
 }
 
 // AuthResponse represents the response from the login endpoint
@@ -54,12 +66,14 @@ type AuthResponse struct {
 
 // NewClient creates a new client for interacting with the Firefly API
 func NewClient(config Config) (*Client, error) {
-	if config.AccessKey == "" {
-		return nil, fmt.Errorf("access key is required")
-	}
+	if config.TokenSource == nil {
+		if config.AccessKey == "" {
+			return nil, fmt.Errorf("access key is required")
+		}
 
-	if config.SecretKey == "" {
-		return nil, fmt.Errorf("secret key is required")
+		if config.SecretKey == "" {
+			return nil, fmt.Errorf("secret key is required")
+		}
 	}
 
 	baseURL := defaultBaseURL
@@ -75,7 +89,7 @@ func NewClient(config Config) (*Client, error) {
 	httpClient := config.HTTPClient
 	if httpClient == nil {
 		httpClient = &http.Client{
-			Timeout: time.Second * 120, // Increased from 30s to 120s for governance policy operations
+			Timeout: DefaultRequestTimeout,
 		}
 	}
 
@@ -83,100 +97,83 @@ func NewClient(config Config) (*Client, error) {
 		baseURL:    parsedBaseURL,
 		userAgent:  defaultUserAgent,
 		httpClient: httpClient,
-		accessKey:  config.AccessKey,
-		secretKey:  config.SecretKey,
+		retry:      newRetryPolicy(config),
+		tokens:     config.TokenSource,
+	}
+
+	if c.tokens == nil {
+		refreshMargin := config.TokenRefreshMargin
+		if refreshMargin <= 0 {
+			refreshMargin = DefaultTokenRefreshMargin
+		}
+		c.tokens = newLoginTokenSource(c, config.AccessKey, config.SecretKey, refreshMargin)
 	}
 
 	// Create service endpoints
-	c.Workspaces = &WorkspaceService{client: c}
-	c.Guardrails = &GuardrailService{client: c}
-	c.Projects = &ProjectService{client: c}
-	c.RunnersWorkspaces = &RunnersWorkspaceService{client: c}
-	c.VariableSets = &VariableSetService{client: c}
-	c.GovernancePolicies = &GovernancePolicyService{client: c}
-	c.BackupAndDr = &BackupAndDrService{client: c}
+	c.workspaces = &WorkspaceService{client: c}
+	c.guardrails = &GuardrailService{client: c}
+	c.projects = &ProjectService{client: c}
+	c.runnersWorkspaces = &RunnersWorkspaceService{client: c}
+	c.variableSets = &VariableSetService{client: c}
+	c.governancePolicies = &GovernancePolicyService{client: c}
+	c.backupAndDr = &BackupAndDrService{client: c}
+	c.users = &UserService{client: c}
+	c.notifications = &NotificationService{client: c}
+	c.integrations = &IntegrationService{client: c}
 
 	return c, nil
 }
 
-// ensureAuthenticated ensures the client has a valid authentication token
-func (c *Client) ensureAuthenticated() error {
-	// If we have a token and it's not expired, we're good
-	if c.authToken != "" && time.Now().Before(c.expiresAt) {
-		return nil
-	}
-
-	// Otherwise, we need to authenticate
-	reqBody, err := json.Marshal(map[string]string{
-		"accessKey": c.accessKey,
-		"secretKey": c.secretKey,
-	})
-	if err != nil {
-		return fmt.Errorf("error encoding login request: %s", err)
-	}
-
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/v2/login", c.baseURL), bytes.NewBuffer(reqBody))
-	if err != nil {
-		return fmt.Errorf("error creating login request: %s", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", c.userAgent)
+// doRequest sends an authenticated HTTP request and returns an HTTP response
+func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
 
-	resp, err := c.httpClient.Do(req)
+	token, err := c.tokens.Token(ctx)
 	if err != nil {
-		return fmt.Errorf("error executing login request: %s", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("login failed with status %d: %s", resp.StatusCode, string(body))
+	resp, err := c.doAuthenticated(req, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
 	}
 
-	var authResp AuthResponse
-	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
-		return fmt.Errorf("error decoding login response: %s", err)
+	// The token was rejected before its expiry (e.g. revoked), so fetch a new
+	// one and replay the request once
+	replay := replayRequest(req)
+	if replay == nil {
+		return resp, nil
 	}
 
-	c.authToken = authResp.AccessToken
-	c.expiresAt = time.Unix(authResp.ExpiresAt, 0)
-
-	return nil
-}
-
-// doRequest sends an HTTP request and returns an HTTP response
-func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
-	// Ensure we're authenticated
-	if err := c.ensureAuthenticated(); err != nil {
+	c.tokens.Invalidate(token)
+	token, err = c.tokens.Token(ctx)
+	if err != nil {
+		resp.Body.Close()
 		return nil, err
 	}
+	drainBody(resp)
 
-	// Set common headers
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.authToken))
-	req.Header.Set("User-Agent", c.userAgent)
-
-	// Execute the request
-	return c.httpClient.Do(req)
+	return c.doAuthenticated(replay, token)
 }
 
-// newRequest creates a new HTTP request
-func (c *Client) newRequest(method, path string, body interface{}) (*http.Request, error) {
+// newRequest creates a new HTTP request bound to ctx, so cancelling ctx aborts the call
+func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
 	u, err := c.baseURL.Parse(path)
 	if err != nil {
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	// Buffer the body in a bytes.Reader so the request can be replayed on retries
+	var buf io.Reader
 	if body != nil {
-		buf = new(bytes.Buffer)
-		err = json.NewEncoder(buf).Encode(body)
+		encoded, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
+		buf = bytes.NewReader(encoded)
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,9 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -40,6 +42,38 @@ func (m *MockServer) AddHandler(path string, handler http.HandlerFunc) {
 	m.mux.HandleFunc(path, handler)
 }
 
+// AddLoginHandler mocks the login endpoint, returning a token valid for an hour
+func (m *MockServer) AddLoginHandler() {
+	m.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+}
+
+// newTestClient creates a client for the API at apiURL with fast retries. The configure functions
+// change the configuration before the client is created.
+func newTestClient(t *testing.T, apiURL string, configure ...func(*Config)) *Client {
+	t.Helper()
+
+	config := Config{
+		AccessKey:    "test-access",
+		SecretKey:    "test-secret",
+		APIURL:       apiURL,
+		RetryMaxWait: 50 * time.Millisecond,
+	}
+	for _, fn := range configure {
+		fn(&config)
+	}
+
+	client, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	client.retry.minWait = time.Millisecond
+
+	return client
+}
+
 func TestNewClient(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -85,38 +119,38 @@ func TestNewClient(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			client, err := NewClient(tt.config)
-			
+
 			if tt.expectError {
 				if err == nil {
 					t.Errorf("expected error but got none")
 				}
 				return
 			}
-			
+
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 				return
 			}
-			
+
 			if client == nil {
 				t.Error("expected client but got nil")
 				return
 			}
-			
+
 			// Verify services are initialized
-			if client.Workspaces == nil {
+			if client.workspaces == nil {
 				t.Error("Workspaces service not initialized")
 			}
-			if client.Guardrails == nil {
+			if client.guardrails == nil {
 				t.Error("Guardrails service not initialized")
 			}
-			if client.Projects == nil {
+			if client.projects == nil {
 				t.Error("Projects service not initialized")
 			}
-			if client.RunnersWorkspaces == nil {
+			if client.runnersWorkspaces == nil {
 				t.Error("RunnersWorkspaces service not initialized")
 			}
-			if client.VariableSets == nil {
+			if client.variableSets == nil {
 				t.Error("VariableSets service not initialized")
 			}
 		})
@@ -166,13 +200,13 @@ func TestAuthentication(t *testing.T) {
 	}
 
 	// Test authentication
-	err = client.ensureAuthenticated()
+	token, err := client.tokens.Token(context.Background())
 	if err != nil {
 		t.Fatalf("Authentication failed: %v", err)
 	}
 
-	if client.authToken != "test-token" {
-		t.Errorf("Expected token 'test-token', got '%s'", client.authToken)
+	if token != "test-token" {
+		t.Errorf("Expected token 'test-token', got '%s'", token)
 	}
 }
 
@@ -196,7 +230,7 @@ func TestAuthenticationFailure(t *testing.T) {
 	}
 
 	// Test authentication failure
-	err = client.ensureAuthenticated()
+	_, err = client.tokens.Token(context.Background())
 	if err == nil {
 		t.Error("Expected authentication to fail")
 	}
@@ -224,7 +258,7 @@ func TestRequestWithAuthentication(t *testing.T) {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
-		
+
 		fmt.Fprint(w, `{"success": true}`)
 	})
 
@@ -239,7 +273,7 @@ func TestRequestWithAuthentication(t *testing.T) {
 	}
 
 	// Make authenticated request
-	req, err := client.newRequest(http.MethodGet, "/v2/test", nil)
+	req, err := client.newRequest(context.Background(), http.MethodGet, "/v2/test", nil)
 	if err != nil {
 		t.Fatalf("Failed to create request: %v", err)
 	}
@@ -253,4 +287,36 @@ func TestRequestWithAuthentication(t *testing.T) {
 	if resp.StatusCode != http.StatusOK {
 		t.Errorf("Expected status 200, got %d", resp.StatusCode)
 	}
-}
\ No newline at end of file
+}
+
+func TestRequestCancelledByContext(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// Block until the client gives up on the request
+	mockServer.AddHandler("/v2/runners/projects/slow", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Projects().GetProject(ctx, "slow")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned when a lookup that is resolved client-side (for
+// example by scanning a list endpoint) does not find the requested object
+var ErrNotFound = errors.New("not found")
+
+// APIError represents a non-successful response returned by the Firefly API
+type APIError struct {
+	// Operation is a short description of what the client was doing, e.g. "get project"
+	Operation string
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Method and Path identify the request that failed
+	Method string
+	Path   string
+	// RequestID is the request identifier returned by the API, if any
+	RequestID string
+	// Message is the error message decoded from the response body
+	Message string
+	// Body is the raw response body
+	Body string
+}
+
+// apiErrorBody covers the error payload shapes returned by the different Firefly APIs
+type apiErrorBody struct {
+	Message string          `json:"message"`
+	Error   string          `json:"error"`
+	Detail  json.RawMessage `json:"detail"`
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Body
+	}
+	if e.RequestID != "" {
+		msg = fmt.Sprintf("%s (request ID: %s)", msg, e.RequestID)
+	}
+	return fmt.Sprintf("failed to %s: %s (status code: %d)", e.Operation, msg, e.StatusCode)
+}
+
+// newAPIError builds an APIError from a non-successful HTTP response and consumes its body
+func newAPIError(resp *http.Response, operation string) *APIError {
+	apiErr := &APIError{
+		Operation:  operation,
+		StatusCode: resp.StatusCode,
+		RequestID:  requestIDFromHeader(resp.Header),
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.Path = resp.Request.URL.Path
+		}
+	}
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	apiErr.Body = strings.TrimSpace(string(bodyBytes))
+	apiErr.Message = decodeErrorMessage(bodyBytes)
+
+	return apiErr
+}
+
+// requestIDFromHeader returns the request identifier set by the API gateway, if any
+func requestIDFromHeader(header http.Header) string {
+	for _, key := range []string{"X-Request-Id", "X-Amzn-Requestid", "X-Correlation-Id"} {
+		if id := header.Get(key); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// decodeErrorMessage extracts a human readable message from a JSON error body
+func decodeErrorMessage(body []byte) string {
+	var decoded apiErrorBody
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return ""
+	}
+
+	if decoded.Message != "" {
+		return decoded.Message
+	}
+	if decoded.Error != "" {
+		return decoded.Error
+	}
+	if len(decoded.Detail) > 0 {
+		var detail string
+		if err := json.Unmarshal(decoded.Detail, &detail); err == nil {
+			return detail
+		}
+		return string(decoded.Detail)
+	}
+
+	return ""
+}
+
+// StatusCode returns the HTTP status code carried by err, or 0 if err is not an APIError
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err indicates that the requested object does not exist
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || StatusCode(err) == http.StatusNotFound
+}
+
+// IsConflict reports whether err is a 409 Conflict response
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsRateLimited reports whether err is a 429 Too Many Requests response
+func IsRateLimited(err error) bool {
+	return StatusCode(err) == http.StatusTooManyRequests
+}
+
+// IsUnauthorized reports whether err is a 401 Unauthorized response
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
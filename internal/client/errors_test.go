@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAPIError_DecodesFireflyErrorBody(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	mockServer.AddHandler("/v2/runners/workspaces/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "workspace does not exist"}`)
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.RunnersWorkspaces().GetRunnersWorkspace(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error for missing workspace")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Method != http.MethodGet {
+		t.Errorf("Expected method GET, got %s", apiErr.Method)
+	}
+	if apiErr.Path != "/v2/runners/workspaces/missing" {
+		t.Errorf("Expected path '/v2/runners/workspaces/missing', got '%s'", apiErr.Path)
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("Expected request ID 'req-123', got '%s'", apiErr.RequestID)
+	}
+	if apiErr.Message != "workspace does not exist" {
+		t.Errorf("Expected message 'workspace does not exist', got '%s'", apiErr.Message)
+	}
+	if !IsNotFound(err) {
+		t.Error("Expected IsNotFound to be true")
+	}
+}
+
+func TestAPIError_NotFoundIsNotInferredFromBody(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// A workspace literally named "not found" must not be treated as deleted
+	mockServer.AddHandler("/v2/runners/workspaces/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `workspace "not found" is locked (404 runs pending)`, http.StatusBadRequest)
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.RunnersWorkspaces().GetRunnersWorkspace(context.Background(), "not found")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+
+	if IsNotFound(err) {
+		t.Error("Expected IsNotFound to be false for a 400 response")
+	}
+	if StatusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", StatusCode(err))
+	}
+}
+
+func TestAPIError_Helpers(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		notFound     bool
+		conflict     bool
+		rateLimited  bool
+		unauthorized bool
+	}{
+		{name: "not found", err: &APIError{StatusCode: http.StatusNotFound}, notFound: true},
+		{name: "conflict", err: &APIError{StatusCode: http.StatusConflict}, conflict: true},
+		{name: "rate limited", err: &APIError{StatusCode: http.StatusTooManyRequests}, rateLimited: true},
+		{name: "unauthorized", err: &APIError{StatusCode: http.StatusUnauthorized}, unauthorized: true},
+		{name: "wrapped", err: fmt.Errorf("outer: %w", &APIError{StatusCode: http.StatusNotFound}), notFound: true},
+		{name: "client-side not found", err: fmt.Errorf("guardrail rule with ID x: %w", ErrNotFound), notFound: true},
+		{name: "plain error", err: errors.New("404 not found")},
+		{name: "nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.notFound)
+			}
+			if got := IsConflict(tt.err); got != tt.conflict {
+				t.Errorf("IsConflict() = %v, want %v", got, tt.conflict)
+			}
+			if got := IsRateLimited(tt.err); got != tt.rateLimited {
+				t.Errorf("IsRateLimited() = %v, want %v", got, tt.rateLimited)
+			}
+			if got := IsUnauthorized(tt.err); got != tt.unauthorized {
+				t.Errorf("IsUnauthorized() = %v, want %v", got, tt.unauthorized)
+			}
+		})
+	}
+}
+
+func TestAPIError_ErrorString(t *testing.T) {
+	err := &APIError{
+		Operation:  "get project",
+		StatusCode: http.StatusNotFound,
+		Body:       "Project not found",
+	}
+
+	expected := "failed to get project: Project not found (status code: 404)"
+	if err.Error() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, err.Error())
+	}
+
+	err.RequestID = "abc"
+	expected = "failed to get project: Project not found (request ID: abc) (status code: 404)"
+	if err.Error() != expected {
+		t.Errorf("Expected '%s', got '%s'", expected, err.Error())
+	}
+}
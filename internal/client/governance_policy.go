@@ -1,9 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
 )
@@ -38,6 +39,7 @@ type GovernancePolicy struct {
 	Severity    int                 `json:"severity,omitempty"`
 	Category    string              `json:"category,omitempty"`
 	Frameworks  []string            `json:"frameworks,omitempty"`
+	IsDefault   bool                `json:"isDefault,omitempty"` // Built-in Firefly policy
 }
 
 // FlexibleStringArray handles both string and []string JSON formats
@@ -98,7 +100,7 @@ type GovernancePolicyListRequest struct {
 }
 
 // List retrieves governance policies
-func (s *GovernancePolicyService) List(request *GovernancePolicyListRequest) (*GovernancePoliciesResponse, error) {
+func (s *GovernancePolicyService) List(ctx context.Context, request *GovernancePolicyListRequest) (*GovernancePoliciesResponse, error) {
 	endpoint := "/v2/governance/insights"
 	
 	// Set default values if not provided
@@ -109,11 +111,14 @@ func (s *GovernancePolicyService) List(request *GovernancePolicyListRequest) (*G
 		request.PageSize = 50
 	}
 	
-	req, err := s.client.newRequest("POST", endpoint, request)
+	req, err := s.client.newRequest(ctx, "POST", endpoint, request)
 	if err != nil {
 		return nil, err
 	}
 	
+	// Searching has no side effects, so the request is safe to retry
+	req = markRetryable(req)
+	
 	resp, err := s.client.doRequest(req)
 	if err != nil {
 		return nil, err
@@ -121,8 +126,7 @@ func (s *GovernancePolicyService) List(request *GovernancePolicyListRequest) (*G
 	defer resp.Body.Close()
 	
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "list governance policies")
 	}
 	
 	var result GovernancePoliciesResponse
@@ -133,28 +137,51 @@ func (s *GovernancePolicyService) List(request *GovernancePolicyListRequest) (*G
 	return &result, nil
 }
 
+// All iterates over every governance policy matching the request, fetching pages as needed
+func (s *GovernancePolicyService) All(ctx context.Context, request *GovernancePolicyListRequest) iter.Seq2[GovernancePolicy, error] {
+	// Work on a copy so the caller's request is not modified between pages
+	pageReq := GovernancePolicyListRequest{}
+	if request != nil {
+		pageReq = *request
+	}
+	pageSize := pageReq.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	return paginate(ctx, func(ctx context.Context, page int) ([]GovernancePolicy, bool, error) {
+		pageReq.Page = page + 1
+		pageReq.PageSize = pageSize
+		response, err := s.List(ctx, &pageReq)
+		if err != nil {
+			return nil, false, err
+		}
+		return response.Hits, (page+1)*pageSize < response.Total, nil
+	})
+}
+
 // Get retrieves a specific governance policy by ID
-func (s *GovernancePolicyService) Get(id string) (*GovernancePolicy, error) {
+func (s *GovernancePolicyService) Get(ctx context.Context, id string) (*GovernancePolicy, error) {
 	// Use the List endpoint with specific ID filter to get a single policy
 	request := &GovernancePolicyListRequest{
 		ID:       []string{id},
 		PageSize: 1,
 	}
 	
-	response, err := s.List(request)
+	response, err := s.List(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 	
 	if len(response.Hits) == 0 {
-		return nil, fmt.Errorf("policy not found: %s", id)
+		return nil, fmt.Errorf("governance policy %s: %w", id, ErrNotFound)
 	}
 	
 	return &response.Hits[0], nil
 }
 
 // Create creates a new governance policy
-func (s *GovernancePolicyService) Create(policy *GovernancePolicy) (*GovernancePolicy, error) {
+func (s *GovernancePolicyService) Create(ctx context.Context, policy *GovernancePolicy) (*GovernancePolicy, error) {
 	endpoint := "/v2/governance/insights/create"
 	
 	// Convert to request struct
@@ -170,7 +197,7 @@ func (s *GovernancePolicyService) Create(policy *GovernancePolicy) (*GovernanceP
 		Frameworks:  policy.Frameworks,
 	}
 	
-	req, err := s.client.newRequest("POST", endpoint, request)
+	req, err := s.client.newRequest(ctx, "POST", endpoint, request)
 	if err != nil {
 		return nil, err
 	}
@@ -182,8 +209,7 @@ func (s *GovernancePolicyService) Create(policy *GovernancePolicy) (*GovernanceP
 	defer resp.Body.Close()
 	
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "create governance policy")
 	}
 	
 	var result GovernancePolicy
@@ -195,7 +221,7 @@ func (s *GovernancePolicyService) Create(policy *GovernancePolicy) (*GovernanceP
 }
 
 // Update updates an existing governance policy
-func (s *GovernancePolicyService) Update(id string, policy *GovernancePolicy) (*GovernancePolicy, error) {
+func (s *GovernancePolicyService) Update(ctx context.Context, id string, policy *GovernancePolicy) (*GovernancePolicy, error) {
 	endpoint := fmt.Sprintf("/v2/governance/insights/%s", url.PathEscape(id))
 	
 	// Convert to request struct
@@ -211,7 +237,7 @@ func (s *GovernancePolicyService) Update(id string, policy *GovernancePolicy) (*
 		Frameworks:  policy.Frameworks,
 	}
 	
-	req, err := s.client.newRequest("PUT", endpoint, request)
+	req, err := s.client.newRequest(ctx, "PUT", endpoint, request)
 	if err != nil {
 		return nil, err
 	}
@@ -223,8 +249,7 @@ func (s *GovernancePolicyService) Update(id string, policy *GovernancePolicy) (*
 	defer resp.Body.Close()
 	
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, newAPIError(resp, "update governance policy")
 	}
 	
 	var result GovernancePolicy
@@ -236,10 +261,10 @@ func (s *GovernancePolicyService) Update(id string, policy *GovernancePolicy) (*
 }
 
 // Delete deletes a governance policy
-func (s *GovernancePolicyService) Delete(id string) error {
+func (s *GovernancePolicyService) Delete(ctx context.Context, id string) error {
 	endpoint := fmt.Sprintf("/v2/governance/insights/%s", url.PathEscape(id))
 	
-	req, err := s.client.newRequest("DELETE", endpoint, nil)
+	req, err := s.client.newRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		return err
 	}
@@ -251,8 +276,7 @@ func (s *GovernancePolicyService) Delete(id string) error {
 	defer resp.Body.Close()
 	
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp, "delete governance policy")
 	}
 	
 	return nil
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGovernancePolicyService_Exclusions(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	mockServer.AddHandler("/v2/governance/insights/policy-123/exclusions", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// An exclusion without expiry must not send expiresAt
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["expiresAt"]; ok || body["justification"] != "Access logs are not sensitive" {
+			http.Error(w, "Unexpected body", http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(GovernancePolicyExclusion{ID: "exclusion-1", PolicyID: "policy-123", Assets: []string{"arn:aws:s3:::logs"}, Justification: "Access logs are not sensitive"})
+	})
+
+	mockServer.AddHandler("/v2/governance/insights/policy-123/exclusions/exclusion-1", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, `{"message":"exclusion not found"}`, http.StatusNotFound)
+		}
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	exclusion, err := client.GovernancePolicies().CreateExclusion(ctx, "policy-123", &GovernancePolicyExclusionRequest{
+		Assets:        []string{"arn:aws:s3:::logs"},
+		Justification: "Access logs are not sensitive",
+	})
+	if err != nil {
+		t.Fatalf("CreateExclusion failed: %v", err)
+	}
+	if exclusion.ID != "exclusion-1" || exclusion.PolicyID != "policy-123" {
+		t.Errorf("Unexpected exclusion %+v", exclusion)
+	}
+
+	if _, err := client.GovernancePolicies().GetExclusion(ctx, "policy-123", "exclusion-1"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+
+	if err := client.GovernancePolicies().DeleteExclusion(ctx, "policy-123", "exclusion-1"); err != nil {
+		t.Errorf("DeleteExclusion failed: %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
@@ -25,7 +26,7 @@ func TestGovernancePolicyService_CreatePolicy(t *testing.T) {
 			return
 		}
 
-		var createReq GovernancePolicy
+		var createReq GovernancePolicyRequest
 		if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
 			http.Error(w, "Invalid JSON", http.StatusBadRequest)
 			return
@@ -91,7 +92,7 @@ firefly {
 		Frameworks:  []string{"SOC2"},
 	}
 
-	response, err := client.GovernancePolicies.Create(policy)
+	response, err := client.GovernancePolicies().Create(context.Background(), policy)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -184,7 +185,7 @@ func TestGovernancePolicyService_ListPolicies(t *testing.T) {
 		PageSize: 50,
 	}
 
-	response, err := client.GovernancePolicies.List(request)
+	response, err := client.GovernancePolicies().List(context.Background(), request)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -261,7 +262,7 @@ func TestGovernancePolicyService_GetPolicy(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	policy, err := client.GovernancePolicies.Get("test-policy-id")
+	policy, err := client.GovernancePolicies().Get(context.Background(), "test-policy-id")
 	if err != nil {
 		t.Fatalf("Get failed: %v", err)
 	}
@@ -342,7 +343,7 @@ func TestGovernancePolicyService_UpdatePolicy(t *testing.T) {
 		Category:    "performance",
 	}
 
-	response, err := client.GovernancePolicies.Update("test-policy-id", policy)
+	response, err := client.GovernancePolicies().Update(context.Background(), "test-policy-id", policy)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -397,7 +398,7 @@ func TestGovernancePolicyService_DeletePolicy(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	err = client.GovernancePolicies.Delete("test-policy-id")
+	err = client.GovernancePolicies().Delete(context.Background(), "test-policy-id")
 	if err != nil {
 		t.Fatalf("Delete failed: %v", err)
 	}
@@ -505,7 +506,7 @@ func TestGovernancePolicyService_UpdatePolicyWithFrameworkChange(t *testing.T) {
 		Frameworks:  []string{"tagging_policies"}, // Change from devops to tagging_policies
 	}
 
-	response, err := client.GovernancePolicies.Update("68d926e57e33bb411adcb37a", policy)
+	response, err := client.GovernancePolicies().Update(context.Background(), "68d926e57e33bb411adcb37a", policy)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -516,7 +517,7 @@ func TestGovernancePolicyService_UpdatePolicyWithFrameworkChange(t *testing.T) {
 	}
 
 	// The refetch should get the correct data
-	correctPolicy, err := client.GovernancePolicies.Get("68d926e57e33bb411adcb37a")
+	correctPolicy, err := client.GovernancePolicies().Get(context.Background(), "68d926e57e33bb411adcb37a")
 	if err != nil {
 		t.Fatalf("Get after update failed: %v", err)
 	}
@@ -1,9 +1,12 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -159,18 +162,21 @@ type DeleteGuardrailResponse struct {
 }
 
 // ListGuardrails retrieves guardrail rules from Firefly
-func (s *GuardrailService) ListGuardrails(request *ListGuardrailsRequest, page, pageSize int) ([]GuardrailRule, error) {
+func (s *GuardrailService) ListGuardrails(ctx context.Context, request *ListGuardrailsRequest, page, pageSize int) ([]GuardrailRule, error) {
 	// Construct query parameters
 	queryParams := url.Values{}
 	queryParams.Add("page", strconv.Itoa(page))
 	queryParams.Add("pageSize", strconv.Itoa(pageSize))
 
 	// Create the request
-	req, err := s.client.newRequest(http.MethodPost, fmt.Sprintf("/v2/guardrails/search?%s", queryParams.Encode()), request)
+	req, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/guardrails/search?%s", queryParams.Encode()), request)
 	if err != nil {
 		return nil, err
 	}
 
+	// Searching has no side effects, so the request is safe to retry
+	req = markRetryable(req)
+
 	// Execute the request
 	resp, err := s.client.doRequest(req)
 	if err != nil {
@@ -180,8 +186,7 @@ func (s *GuardrailService) ListGuardrails(request *ListGuardrailsRequest, page,
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list guardrails: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "list guardrails")
 	}
 
 	// Parse the response
@@ -193,10 +198,18 @@ func (s *GuardrailService) ListGuardrails(request *ListGuardrailsRequest, page,
 	return guardrails, nil
 }
 
+// AllGuardrails iterates over every guardrail rule matching the request, fetching pages as needed
+func (s *GuardrailService) AllGuardrails(ctx context.Context, request *ListGuardrailsRequest) iter.Seq2[GuardrailRule, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]GuardrailRule, bool, error) {
+		guardrails, err := s.ListGuardrails(ctx, request, page, DefaultPageSize)
+		return guardrails, len(guardrails) == DefaultPageSize, err
+	})
+}
+
 // CreateGuardrail creates a new guardrail rule
-func (s *GuardrailService) CreateGuardrail(guardrail *GuardrailRule) (*CreateGuardrailResponse, error) {
+func (s *GuardrailService) CreateGuardrail(ctx context.Context, guardrail *GuardrailRule) (*CreateGuardrailResponse, error) {
 	// Create the request
-	req, err := s.client.newRequest(http.MethodPost, "/v2/guardrails", guardrail)
+	req, err := s.client.newRequest(ctx, http.MethodPost, "/v2/guardrails", guardrail)
 	if err != nil {
 		return nil, err
 	}
@@ -210,8 +223,7 @@ func (s *GuardrailService) CreateGuardrail(guardrail *GuardrailRule) (*CreateGua
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create guardrail: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "create guardrail")
 	}
 
 	// Parse the response - API returns just the rule ID as a string
@@ -239,33 +251,31 @@ func (s *GuardrailService) CreateGuardrail(guardrail *GuardrailRule) (*CreateGua
 }
 
 // GetGuardrail retrieves a guardrail rule by ID
-func (s *GuardrailService) GetGuardrail(ruleID string) (*GuardrailRule, error) {
+func (s *GuardrailService) GetGuardrail(ctx context.Context, ruleID string) (*GuardrailRule, error) {
 	// We'll use the list API with a filter since there's no direct get endpoint in the OpenAPI spec
 	request := &ListGuardrailsRequest{
 		Filters: &GuardrailFilters{},
 		// No direct ID filter available, we'll filter client-side
 	}
 
-	// Make the request
-	guardrails, err := s.ListGuardrails(request, 0, 100)
+	// Find the guardrail with the matching ID, looking through every page
+	rule, err := Find(s.AllGuardrails(ctx, request), func(rule GuardrailRule) bool {
+		return rule.ID == ruleID
+	})
 	if err != nil {
-		return nil, err
-	}
-
-	// Find the guardrail with the matching ID
-	for _, rule := range guardrails {
-		if rule.ID == ruleID {
-			return &rule, nil
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("guardrail rule with ID %s: %w", ruleID, err)
 		}
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("guardrail rule with ID %s not found", ruleID)
+	return rule, nil
 }
 
 // UpdateGuardrail updates an existing guardrail rule
-func (s *GuardrailService) UpdateGuardrail(ruleID string, guardrail *GuardrailRule) (*UpdateGuardrailResponse, error) {
+func (s *GuardrailService) UpdateGuardrail(ctx context.Context, ruleID string, guardrail *GuardrailRule) (*UpdateGuardrailResponse, error) {
 	// Create the request
-	req, err := s.client.newRequest(http.MethodPatch, fmt.Sprintf("/v2/guardrails/%s", ruleID), guardrail)
+	req, err := s.client.newRequest(ctx, http.MethodPatch, fmt.Sprintf("/v2/guardrails/%s", ruleID), guardrail)
 	if err != nil {
 		return nil, err
 	}
@@ -279,8 +289,7 @@ func (s *GuardrailService) UpdateGuardrail(ruleID string, guardrail *GuardrailRu
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update guardrail: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "update guardrail")
 	}
 
 	// Parse the response
@@ -293,9 +302,9 @@ func (s *GuardrailService) UpdateGuardrail(ruleID string, guardrail *GuardrailRu
 }
 
 // DeleteGuardrail deletes a guardrail rule by ID
-func (s *GuardrailService) DeleteGuardrail(ruleID string) (*DeleteGuardrailResponse, error) {
+func (s *GuardrailService) DeleteGuardrail(ctx context.Context, ruleID string) (*DeleteGuardrailResponse, error) {
 	// Create the request
-	req, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/v2/guardrails/%s", ruleID), nil)
+	req, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/guardrails/%s", ruleID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -309,8 +318,7 @@ func (s *GuardrailService) DeleteGuardrail(ruleID string) (*DeleteGuardrailRespo
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to delete guardrail: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "delete guardrail")
 	}
 
 	// Parse the response
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -75,7 +76,7 @@ func TestGuardrailService_CreateGuardrail(t *testing.T) {
 		},
 	}
 
-	response, err := client.Guardrails.CreateGuardrail(guardrail)
+	response, err := client.Guardrails().CreateGuardrail(context.Background(), guardrail)
 	if err != nil {
 		t.Fatalf("CreateGuardrail failed: %v", err)
 	}
@@ -146,7 +147,7 @@ func TestGuardrailService_UpdateGuardrail(t *testing.T) {
 		Severity:  1,
 	}
 
-	response, err := client.Guardrails.UpdateGuardrail("test-rule-id", guardrail)
+	response, err := client.Guardrails().UpdateGuardrail(context.Background(), "test-rule-id", guardrail)
 	if err != nil {
 		t.Fatalf("UpdateGuardrail failed: %v", err)
 	}
@@ -207,7 +208,7 @@ func TestGuardrailService_DeleteGuardrail(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	response, err := client.Guardrails.DeleteGuardrail("test-rule-id")
+	response, err := client.Guardrails().DeleteGuardrail(context.Background(), "test-rule-id")
 	if err != nil {
 		t.Fatalf("DeleteGuardrail failed: %v", err)
 	}
@@ -219,4 +220,4 @@ func TestGuardrailService_DeleteGuardrail(t *testing.T) {
 	if response.Message != "Guardrail deleted successfully" {
 		t.Errorf("Expected success message, got '%s'", response.Message)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CloudTypeEnum represents the type of cloud provider integration
+type CloudTypeEnum string
+
+const (
+	CloudTypeAWS        CloudTypeEnum = "aws"
+	CloudTypeGCP        CloudTypeEnum = "gcp"
+	CloudTypeAzure      CloudTypeEnum = "azure"
+	CloudTypeKubernetes CloudTypeEnum = "k8s"
+)
+
+// IntegrationService handles communication with the VCS and cloud integrations related methods of the Firefly API.
+// Integrations are connected in the Firefly UI, the API only lists them.
+type IntegrationService struct {
+	client *Client
+}
+
+// VcsIntegration represents a version control system connected to the account
+type VcsIntegration struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	Type      VcsTypeEnum `json:"type"`
+	Status    string      `json:"status"`
+	CreatedAt string      `json:"createdAt,omitempty"`
+}
+
+// CloudIntegration represents a cloud provider account connected to the account
+type CloudIntegration struct {
+	ID   string        `json:"id"`
+	Name string        `json:"name"`
+	Type CloudTypeEnum `json:"type"`
+	// AccountID is the AWS account ID, GCP project ID, Azure subscription ID or Kubernetes cluster ID
+	AccountID string `json:"accountId"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"createdAt,omitempty"`
+}
+
+// ListIntegrationsRequest filters the integrations of the account
+type ListIntegrationsRequest struct {
+	// Name matches the integrations whose name contains it
+	Name string
+	// Type matches the integrations of exactly this type
+	Type string
+}
+
+// VcsIntegrationsListResponse represents the response from listing VCS integrations
+type VcsIntegrationsListResponse struct {
+	Data       []VcsIntegration `json:"data"`
+	TotalCount int              `json:"totalCount"`
+}
+
+// CloudIntegrationsListResponse represents the response from listing cloud integrations
+type CloudIntegrationsListResponse struct {
+	Data       []CloudIntegration `json:"data"`
+	TotalCount int                `json:"totalCount"`
+}
+
+// listIntegrations retrieves a page of the integrations of the given kind, vcs or cloud, and decodes it into result
+func (s *IntegrationService) listIntegrations(ctx context.Context, kind string, request *ListIntegrationsRequest, pageSize, offset int, result any) error {
+	queryParams := url.Values{}
+	queryParams.Add("pageSize", strconv.Itoa(pageSize))
+	queryParams.Add("offset", strconv.Itoa(offset))
+	if request != nil {
+		if request.Name != "" {
+			queryParams.Add("name", request.Name)
+		}
+		if request.Type != "" {
+			queryParams.Add("type", request.Type)
+		}
+	}
+
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/integrations/%s?%s", kind, queryParams.Encode()), nil)
+	if err != nil {
+		return err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp, fmt.Sprintf("list %s integrations", kind))
+	}
+
+	// Parse the response
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return nil
+}
+
+// ListVcsIntegrations retrieves a page of the VCS integrations matching the request
+func (s *IntegrationService) ListVcsIntegrations(ctx context.Context, request *ListIntegrationsRequest, pageSize, offset int) (*VcsIntegrationsListResponse, error) {
+	var integrationsResp VcsIntegrationsListResponse
+	if err := s.listIntegrations(ctx, "vcs", request, pageSize, offset, &integrationsResp); err != nil {
+		return nil, err
+	}
+	return &integrationsResp, nil
+}
+
+// AllVcsIntegrations iterates over every VCS integration matching the request, fetching pages as needed
+func (s *IntegrationService) AllVcsIntegrations(ctx context.Context, request *ListIntegrationsRequest) iter.Seq2[VcsIntegration, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]VcsIntegration, bool, error) {
+		offset := page * DefaultPageSize
+		integrationsResp, err := s.ListVcsIntegrations(ctx, request, DefaultPageSize, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		return integrationsResp.Data, offset+len(integrationsResp.Data) < integrationsResp.TotalCount, nil
+	})
+}
+
+// ListCloudIntegrations retrieves a page of the cloud integrations matching the request
+func (s *IntegrationService) ListCloudIntegrations(ctx context.Context, request *ListIntegrationsRequest, pageSize, offset int) (*CloudIntegrationsListResponse, error) {
+	var integrationsResp CloudIntegrationsListResponse
+	if err := s.listIntegrations(ctx, "cloud", request, pageSize, offset, &integrationsResp); err != nil {
+		return nil, err
+	}
+	return &integrationsResp, nil
+}
+
+// AllCloudIntegrations iterates over every cloud integration matching the request, fetching pages as needed
+func (s *IntegrationService) AllCloudIntegrations(ctx context.Context, request *ListIntegrationsRequest) iter.Seq2[CloudIntegration, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]CloudIntegration, bool, error) {
+		offset := page * DefaultPageSize
+		integrationsResp, err := s.ListCloudIntegrations(ctx, request, DefaultPageSize, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		return integrationsResp.Data, offset+len(integrationsResp.Data) < integrationsResp.TotalCount, nil
+	})
+}
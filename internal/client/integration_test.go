@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// newIntegrationsTestClient returns a client for a mock server listing one VCS and one cloud integration
+func newIntegrationsTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	mockServer := NewMockServer()
+	t.Cleanup(mockServer.Close)
+
+	mockServer.AddLoginHandler()
+
+	// Mock list VCS integrations
+	mockServer.AddHandler("/v2/integrations/vcs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageSize") == "" || r.URL.Query().Get("offset") == "" {
+			http.Error(w, "Missing pagination", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(VcsIntegrationsListResponse{
+			Data:       []VcsIntegration{{ID: "vcs-1", Name: r.URL.Query().Get("name"), Type: VcsTypeGithub, Status: "active"}},
+			TotalCount: 1,
+		})
+	})
+
+	// Mock list cloud integrations
+	mockServer.AddHandler("/v2/integrations/cloud", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != string(CloudTypeAWS) {
+			http.Error(w, "Unexpected type", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CloudIntegrationsListResponse{
+			Data:       []CloudIntegration{{ID: "cloud-1", Name: "production", Type: CloudTypeAWS, AccountID: "123456789012", Status: "active"}},
+			TotalCount: 1,
+		})
+	})
+
+	return newTestClient(t, mockServer.URL())
+}
+
+func TestIntegrationService_AllVcsIntegrations(t *testing.T) {
+	client := newIntegrationsTestClient(t)
+
+	integrations, err := Collect(client.Integrations().AllVcsIntegrations(context.Background(), &ListIntegrationsRequest{Name: "main-github"}), 0)
+	if err != nil {
+		t.Fatalf("AllVcsIntegrations failed: %v", err)
+	}
+	if len(integrations) != 1 || integrations[0].Name != "main-github" || integrations[0].Type != VcsTypeGithub {
+		t.Errorf("Unexpected integrations %+v", integrations)
+	}
+}
+
+func TestIntegrationService_AllCloudIntegrations(t *testing.T) {
+	client := newIntegrationsTestClient(t)
+
+	integrations, err := Collect(client.Integrations().AllCloudIntegrations(context.Background(), &ListIntegrationsRequest{Type: string(CloudTypeAWS)}), 0)
+	if err != nil {
+		t.Fatalf("AllCloudIntegrations failed: %v", err)
+	}
+	if len(integrations) != 1 || integrations[0].AccountID != "123456789012" {
+		t.Errorf("Unexpected integrations %+v", integrations)
+	}
+
+	if _, err := client.Integrations().ListCloudIntegrations(context.Background(), nil, 10, 0); StatusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected a 400 error, got %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// EnvHTTPLogLevel sets the level of the HTTP wire logs and enables them when set, e.g. TF_LOG_PROVIDER_FIREFLY=TRACE
+	EnvHTTPLogLevel = "TF_LOG_PROVIDER_FIREFLY"
+
+	// httpLogSubsystem is the tflog subsystem the wire logs are written to
+	httpLogSubsystem = "firefly_http"
+
+	// maxLoggedBodySize caps the size of a logged body
+	maxLoggedBodySize = 64 * 1024
+
+	redacted = "[REDACTED]"
+)
+
+// sensitiveFields are JSON keys whose values are never logged, compared case-insensitively
+var sensitiveFields = map[string]bool{
+	"accesskey":    true,
+	"secretkey":    true,
+	"accesstoken":  true,
+	"refreshtoken": true,
+	"token":        true,
+	"password":     true,
+	"secret":       true,
+	// Workspace maps holding secret Terraform inputs and cloud credentials are hidden as a whole
+	"terraformsensitivevariables": true,
+	"providerscredentials":        true,
+	// Notification destinations that grant posting to a channel or paging an on-call rotation
+	"webhookurl":     true,
+	"integrationkey": true,
+	"apikey":         true,
+}
+
+// HTTPLoggingEnabledFromEnv reports whether wire logging was requested through EnvHTTPLogLevel
+func HTTPLoggingEnabledFromEnv() bool {
+	return os.Getenv(EnvHTTPLogLevel) != ""
+}
+
+// loggingTransport is an http.RoundTripper that logs requests and responses at TRACE level
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+// NewLoggingTransport wraps next so that every request and response is logged through tflog,
+// with credentials, tokens and secret variable values redacted
+func NewLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &loggingTransport{next: next}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLogSubsystem, tflog.WithLevelFromEnv(EnvHTTPLogLevel))
+
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "Sending Firefly API request", map[string]interface{}{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    requestBodyForLog(req),
+	})
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemTrace(ctx, httpLogSubsystem, "Firefly API request failed", map[string]interface{}{
+			"method":     req.Method,
+			"url":        req.URL.String(),
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+		return nil, err
+	}
+
+	// Buffer the body so it can be logged and still be read by the caller
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	tflog.SubsystemTrace(ctx, httpLogSubsystem, "Received Firefly API response", map[string]interface{}{
+		"method":      req.Method,
+		"url":         req.URL.String(),
+		"status_code": resp.StatusCode,
+		"latency_ms":  latency.Milliseconds(),
+		"headers":     redactHeaders(resp.Header),
+		"body":        redactBody(body),
+	})
+
+	return resp, nil
+}
+
+// requestBodyForLog returns the redacted request body, read from a copy so the request is left untouched
+func requestBodyForLog(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+	if req.GetBody == nil {
+		return "[body not replayable, not logged]"
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "[body not logged: " + err.Error() + "]"
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return "[body not logged: " + err.Error() + "]"
+	}
+
+	return redactBody(content)
+}
+
+// redactHeaders returns a copy of the headers with credentials removed
+func redactHeaders(header http.Header) map[string]string {
+	result := make(map[string]string, len(header))
+	for key, values := range header {
+		value := strings.Join(values, ", ")
+		switch strings.ToLower(key) {
+		case "authorization":
+			if scheme, _, ok := strings.Cut(value, " "); ok {
+				value = scheme + " " + redacted
+			} else {
+				value = redacted
+			}
+		case "cookie", "set-cookie":
+			value = redacted
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// redactBody returns the body as a string, with sensitive values of JSON documents redacted
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		// Not JSON, so nothing can be redacted selectively
+		return truncateForLog(string(body))
+	}
+
+	cleaned, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return "[body not logged: " + err.Error() + "]"
+	}
+
+	return truncateForLog(string(cleaned))
+}
+
+// redactValue walks a decoded JSON document, hiding credentials and the value of secret variables
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		isSecretVariable := false
+		if sensitivity, ok := v["sensitivity"].(string); ok && sensitivity == string(SensitivitySecret) {
+			isSecretVariable = true
+		}
+
+		for key, child := range v {
+			if sensitiveFields[strings.ToLower(key)] || (isSecretVariable && key == "value") {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(child)
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactValue(child)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// truncateForLog shortens overly long bodies
+func truncateForLog(body string) string {
+	if len(body) <= maxLoggedBodySize {
+		return body
+	}
+	return body[:maxLoggedBodySize] + "...[truncated]"
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport_RedactsSecrets(t *testing.T) {
+	t.Setenv(EnvHTTPLogLevel, "TRACE")
+
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(AuthResponse{AccessToken: "super-secret-token", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	})
+
+	mockServer.AddHandler("/v2/runners/projects", func(w http.ResponseWriter, r *http.Request) {
+		var createReq CreateProjectRequest
+		if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil || len(createReq.Variables) != 2 {
+			http.Error(w, "Invalid body", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Project{ID: "new-project-id", Name: createReq.Name, Variables: createReq.Variables})
+	})
+
+	httpClient, err := NewHTTPClient(TransportConfig{LogHTTP: true})
+	if err != nil {
+		t.Fatalf("NewHTTPClient failed: %v", err)
+	}
+
+	client, err := NewClient(Config{
+		AccessKey:  "my-access-key",
+		SecretKey:  "my-secret-key",
+		APIURL:     mockServer.URL(),
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	project, err := client.Projects().CreateProject(ctx, CreateProjectRequest{
+		Name: "Logged Project",
+		Variables: []Variable{
+			{Key: "REGION", Value: "us-east-1", Sensitivity: SensitivityString},
+			{Key: "DB_PASSWORD", Value: "hunter2", Sensitivity: SensitivitySecret},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+
+	// The caller still sees the full response body
+	if project.ID != "new-project-id" || project.Variables[1].Value != "hunter2" {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("Failed to decode logs: %v", err)
+	}
+
+	// Login request and response, then the create request and response
+	if len(entries) != 4 {
+		t.Fatalf("Expected 4 log entries, got %d: %s", len(entries), output.String())
+	}
+
+	logs, _ := json.Marshal(entries)
+	for _, secret := range []string{"my-access-key", "my-secret-key", "super-secret-token", "hunter2"} {
+		if strings.Contains(string(logs), secret) {
+			t.Errorf("Logs contain secret %q", secret)
+		}
+	}
+
+	for _, visible := range []string{"us-east-1", "Logged Project", "Bearer [REDACTED]"} {
+		if !strings.Contains(string(logs), visible) {
+			t.Errorf("Expected logs to contain %q", visible)
+		}
+	}
+
+	response := entries[3]
+	if response["status_code"] != float64(http.StatusCreated) {
+		t.Errorf("Expected status code 201 in log entry, got %v", response["status_code"])
+	}
+	if _, ok := response["latency_ms"]; !ok {
+		t.Error("Expected latency in log entry")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "not JSON", body: "plain error", want: "plain error"},
+		{name: "login", body: `{"accessKey":"a","secretKey":"b"}`, want: `{"accessKey":"[REDACTED]","secretKey":"[REDACTED]"}`},
+		{name: "nested secret variable", body: `{"variables":[{"key":"K","value":"v","sensitivity":"secret"}]}`, want: `{"variables":[{"key":"K","sensitivity":"secret","value":"[REDACTED]"}]}`},
+		{name: "workspace sensitive variables", body: `{"name":"w","terraformSensitiveVariables":{"db_password":"p"}}`, want: `{"name":"w","terraformSensitiveVariables":"[REDACTED]"}`},
+		{name: "workspace providers credentials", body: `{"providersCredentials":{"aws":{"role_arn":"arn"}},"terraformVariables":{"region":"r"}}`, want: `{"providersCredentials":"[REDACTED]","terraformVariables":{"region":"r"}}`},
+		{name: "notification request", body: `{"name":"n","type":"pagerduty","webhookUrl":"https://hooks.slack.com/services/T/B/X","integrationKey":"k","apiKey":"a","region":"eu"}`, want: `{"apiKey":"[REDACTED]","integrationKey":"[REDACTED]","name":"n","region":"eu","type":"pagerduty","webhookUrl":"[REDACTED]"}`},
+		{name: "plain variable", body: `[{"key":"K","value":"v","sensitivity":"string"}]`, want: `[{"key":"K","sensitivity":"string","value":"v"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("redactBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoggingTransport_LeavesRequestBodyIntact(t *testing.T) {
+	var received string
+	next := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		received = string(body)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok")), Header: http.Header{}}, nil
+	})
+
+	req, err := http.NewRequest(http.MethodPost, "https://api.firefly.ai/v2/login", bytes.NewReader([]byte(`{"secretKey":"s"}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := NewLoggingTransport(next).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if received != `{"secretKey":"s"}` {
+		t.Errorf("Expected the original body to be sent, got %s", received)
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != "ok" {
+		t.Errorf("Expected the response body to stay readable, got %s", body)
+	}
+}
+
+// roundTripFunc adapts a function to the http.RoundTripper interface
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Notification channel types
+const (
+	NotificationTypeSlack     = "slack"
+	NotificationTypeTeams     = "teams"
+	NotificationTypeEmail     = "email"
+	NotificationTypeWebhook   = "webhook"
+	NotificationTypePagerDuty = "pagerduty"
+	NotificationTypeOpsgenie  = "opsgenie"
+)
+
+// NotificationTypes lists the supported notification channel types
+var NotificationTypes = []string{
+	NotificationTypeSlack,
+	NotificationTypeTeams,
+	NotificationTypeEmail,
+	NotificationTypeWebhook,
+	NotificationTypePagerDuty,
+	NotificationTypeOpsgenie,
+}
+
+// NotificationService handles communication with the notification channels related methods of the Firefly API
+type NotificationService struct {
+	client *Client
+}
+
+// Notification represents a notification channel, referenced by guardrails and backup policies.
+// The API does not return the secrets of a channel: WebhookURL, Token, IntegrationKey and APIKey
+// are only set when creating or updating it.
+type Notification struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Type           string   `json:"type"`
+	WebhookURL     string   `json:"webhookUrl,omitempty"`
+	Channel        string   `json:"channel,omitempty"`
+	Emails         []string `json:"emails,omitempty"`
+	Token          string   `json:"token,omitempty"`
+	IntegrationKey string   `json:"integrationKey,omitempty"`
+	APIKey         string   `json:"apiKey,omitempty"`
+	Region         string   `json:"region,omitempty"`
+	CreatedAt      string   `json:"createdAt,omitempty"`
+	UpdatedAt      string   `json:"updatedAt,omitempty"`
+}
+
+// NotificationRequest represents the request to create or replace a notification channel.
+// Only the destination fields of its type are used.
+type NotificationRequest struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// WebhookURL is the incoming webhook of Slack and Microsoft Teams channels, or the URL called by webhook channels
+	WebhookURL string `json:"webhookUrl,omitempty"`
+	// Channel overrides the Slack channel of the incoming webhook
+	Channel string `json:"channel,omitempty"`
+	// Emails are the recipients of email channels
+	Emails []string `json:"emails,omitempty"`
+	// Token is sent as a bearer token by webhook channels
+	Token string `json:"token,omitempty"`
+	// IntegrationKey is the Events API v2 integration key of PagerDuty channels
+	IntegrationKey string `json:"integrationKey,omitempty"`
+	// APIKey is the API integration key of Opsgenie channels
+	APIKey string `json:"apiKey,omitempty"`
+	// Region is the Opsgenie region, us or eu
+	Region string `json:"region,omitempty"`
+}
+
+// ListNotificationsRequest filters the notification channels of the account
+type ListNotificationsRequest struct {
+	// Name matches the channels whose name contains it
+	Name string
+	// Type matches the channels of exactly this type
+	Type string
+}
+
+// NotificationsListResponse represents the response from listing notification channels
+type NotificationsListResponse struct {
+	Data       []Notification `json:"data"`
+	TotalCount int            `json:"totalCount"`
+}
+
+// ListNotifications retrieves a page of the notification channels matching the request
+func (s *NotificationService) ListNotifications(ctx context.Context, request *ListNotificationsRequest, pageSize, offset int) (*NotificationsListResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("pageSize", strconv.Itoa(pageSize))
+	queryParams.Add("offset", strconv.Itoa(offset))
+	if request != nil {
+		if request.Name != "" {
+			queryParams.Add("name", request.Name)
+		}
+		if request.Type != "" {
+			queryParams.Add("type", request.Type)
+		}
+	}
+
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/v2/notifications?"+queryParams.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "list notifications")
+	}
+
+	// Parse the response
+	var notificationsResp NotificationsListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&notificationsResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &notificationsResp, nil
+}
+
+// AllNotifications iterates over every notification channel matching the request, fetching pages as needed
+func (s *NotificationService) AllNotifications(ctx context.Context, request *ListNotificationsRequest) iter.Seq2[Notification, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]Notification, bool, error) {
+		offset := page * DefaultPageSize
+		notificationsResp, err := s.ListNotifications(ctx, request, DefaultPageSize, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		return notificationsResp.Data, offset+len(notificationsResp.Data) < notificationsResp.TotalCount, nil
+	})
+}
+
+// CreateNotification creates a new notification channel
+func (s *NotificationService) CreateNotification(ctx context.Context, req NotificationRequest) (*Notification, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/v2/notifications", req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-201 responses
+	if resp.StatusCode != http.StatusCreated {
+		return nil, newAPIError(resp, "create notification")
+	}
+
+	// Parse the response
+	var notification Notification
+	if err := json.NewDecoder(resp.Body).Decode(&notification); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &notification, nil
+}
+
+// GetNotification retrieves a notification channel by ID
+func (s *NotificationService) GetNotification(ctx context.Context, id string) (*Notification, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/notifications/%s", url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "get notification")
+	}
+
+	// Parse the response
+	var notification Notification
+	if err := json.NewDecoder(resp.Body).Decode(&notification); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &notification, nil
+}
+
+// UpdateNotification replaces the settings of a notification channel
+func (s *NotificationService) UpdateNotification(ctx context.Context, id string, req NotificationRequest) (*Notification, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("/v2/notifications/%s", url.PathEscape(id)), req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "update notification")
+	}
+
+	// Parse the response
+	var notification Notification
+	if err := json.NewDecoder(resp.Body).Decode(&notification); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &notification, nil
+}
+
+// DeleteNotification deletes a notification channel
+func (s *NotificationService) DeleteNotification(ctx context.Context, id string) error {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/notifications/%s", url.PathEscape(id)), nil)
+	if err != nil {
+		return err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-204 responses
+	if resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp, "delete notification")
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// newNotificationsTestClient returns a client for a mock server storing a single notification channel
+func newNotificationsTestClient(t *testing.T) (*Client, *NotificationRequest) {
+	t.Helper()
+
+	mockServer := NewMockServer()
+	t.Cleanup(mockServer.Close)
+
+	mockServer.AddLoginHandler()
+
+	// received is the last create or update request
+	received := &NotificationRequest{}
+
+	// Mock create and list notifications
+	mockServer.AddHandler("/v2/notifications", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(Notification{ID: "notification-1", Name: received.Name, Type: received.Type, Channel: received.Channel})
+		case http.MethodGet:
+			if r.URL.Query().Get("type") != NotificationTypeSlack {
+				json.NewEncoder(w).Encode(NotificationsListResponse{Data: []Notification{}})
+				return
+			}
+			json.NewEncoder(w).Encode(NotificationsListResponse{
+				Data:       []Notification{{ID: "notification-1", Name: "alerts", Type: NotificationTypeSlack}},
+				TotalCount: 1,
+			})
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Mock get, update and delete notification
+	mockServer.AddHandler("/v2/notifications/notification-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(Notification{ID: "notification-1", Name: received.Name, Type: received.Type})
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(received); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(Notification{ID: "notification-1", Name: received.Name, Type: received.Type})
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+
+	return newTestClient(t, mockServer.URL()), received
+}
+
+func TestNotificationService_Lifecycle(t *testing.T) {
+	client, received := newNotificationsTestClient(t)
+	ctx := context.Background()
+
+	notification, err := client.Notifications().CreateNotification(ctx, NotificationRequest{
+		Name:       "alerts",
+		Type:       NotificationTypeSlack,
+		WebhookURL: "https://hooks.slack.com/services/secret",
+		Channel:    "#alerts",
+	})
+	if err != nil {
+		t.Fatalf("CreateNotification failed: %v", err)
+	}
+	if notification.ID != "notification-1" || notification.Channel != "#alerts" {
+		t.Errorf("Unexpected notification %+v", notification)
+	}
+	if received.WebhookURL != "https://hooks.slack.com/services/secret" {
+		t.Errorf("Expected the webhook URL to be sent, got %+v", received)
+	}
+
+	notification, err = client.Notifications().UpdateNotification(ctx, "notification-1", NotificationRequest{Name: "renamed", Type: NotificationTypeSlack})
+	if err != nil {
+		t.Fatalf("UpdateNotification failed: %v", err)
+	}
+	if notification.Name != "renamed" {
+		t.Errorf("Expected the updated name, got %q", notification.Name)
+	}
+
+	if _, err := client.Notifications().GetNotification(ctx, "notification-1"); err != nil {
+		t.Errorf("GetNotification failed: %v", err)
+	}
+	if _, err := client.Notifications().GetNotification(ctx, "unknown"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+
+	if err := client.Notifications().DeleteNotification(ctx, "notification-1"); err != nil {
+		t.Errorf("DeleteNotification failed: %v", err)
+	}
+}
+
+func TestNotificationService_AllNotifications(t *testing.T) {
+	client, _ := newNotificationsTestClient(t)
+
+	notifications, err := Collect(client.Notifications().AllNotifications(context.Background(), &ListNotificationsRequest{Type: NotificationTypeSlack}), 0)
+	if err != nil {
+		t.Fatalf("AllNotifications failed: %v", err)
+	}
+	if len(notifications) != 1 || notifications[0].Name != "alerts" {
+		t.Errorf("Unexpected notifications %+v", notifications)
+	}
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPageSize is the number of items requested per page by the All... iterators
+const DefaultPageSize = 100
+
+// pageFunc fetches the page with the given zero-based index and reports whether more pages follow
+type pageFunc[T any] func(ctx context.Context, page int) (items []T, more bool, err error)
+
+// paginate turns a page fetching function into an iterator over every item of every page.
+// Iteration stops at the first error, which is yielded together with the zero value of T.
+func paginate[T any](ctx context.Context, fetch pageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page := 0; ; page++ {
+			items, more, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// An empty page also ends the iteration, in case the API keeps reporting more pages
+			if !more || len(items) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers the items produced by seq into a slice, stopping after limit
+// items when limit is positive
+func Collect[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if limit > 0 && len(items) >= limit {
+			break
+		}
+	}
+	return items, nil
+}
+
+// Filter returns the items produced by seq that match. Errors are always passed through.
+func Filter[T any](seq iter.Seq2[T, error], match func(T) bool) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for item, err := range seq {
+			if err == nil && !match(item) {
+				continue
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first item produced by seq that matches, or ErrNotFound
+func Find[T any](seq iter.Seq2[T, error], match func(T) bool) (*T, error) {
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		if match(item) {
+			return &item, nil
+		}
+	}
+	return nil, ErrNotFound
+}
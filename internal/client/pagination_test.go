@@ -0,0 +1,289 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// pageBounds returns the slice bounds of a page within total items
+func pageBounds(start, size, total int) (int, int) {
+	if start > total {
+		start = total
+	}
+	end := start + size
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+func TestWorkspaceService_AllWorkspaces(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	const total = 250
+	var requests int32
+	mockServer.AddHandler("/workspaces/search", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		start, end := pageBounds(page*pageSize, pageSize, total)
+		workspaces := []Workspace{}
+		for i := start; i < end; i++ {
+			workspaces = append(workspaces, Workspace{WorkspaceID: fmt.Sprintf("ws-%d", i)})
+		}
+		json.NewEncoder(w).Encode(workspaces)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	workspaces, err := Collect(client.Workspaces().AllWorkspaces(context.Background(), &ListWorkspacesRequest{}), 0)
+	if err != nil {
+		t.Fatalf("AllWorkspaces failed: %v", err)
+	}
+
+	if len(workspaces) != total {
+		t.Fatalf("Expected %d workspaces, got %d", total, len(workspaces))
+	}
+	if workspaces[total-1].WorkspaceID != "ws-249" {
+		t.Errorf("Expected last workspace 'ws-249', got '%s'", workspaces[total-1].WorkspaceID)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 page requests, got %d", requests)
+	}
+}
+
+func TestCollect_StopsFetchingAtLimit(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var requests int32
+	mockServer.AddHandler("/v2/runners/variables/variable-sets", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		variableSets := make([]VariableSet, pageSize)
+		for i := range variableSets {
+			variableSets[i] = VariableSet{ID: fmt.Sprintf("vs-%d", offset+i)}
+		}
+		json.NewEncoder(w).Encode(variableSets)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	variableSets, err := Collect(client.VariableSets().AllVariableSets(context.Background(), ""), 150)
+	if err != nil {
+		t.Fatalf("AllVariableSets failed: %v", err)
+	}
+
+	if len(variableSets) != 150 {
+		t.Fatalf("Expected 150 variable sets, got %d", len(variableSets))
+	}
+	if variableSets[149].ID != "vs-149" {
+		t.Errorf("Expected last variable set 'vs-149', got '%s'", variableSets[149].ID)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 page requests, got %d", requests)
+	}
+}
+
+func TestProjectService_AllProjects(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	const total = 120
+	mockServer.AddHandler("/v2/runners/projects/list", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		start, end := pageBounds(offset, pageSize, total)
+		projects := []Project{}
+		for i := start; i < end; i++ {
+			projects = append(projects, Project{ID: fmt.Sprintf("project-%d", i)})
+		}
+		json.NewEncoder(w).Encode(ProjectsListResponse{Data: projects, TotalCount: total})
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	projects, err := Collect(client.Projects().AllProjects(context.Background(), ""), 0)
+	if err != nil {
+		t.Fatalf("AllProjects failed: %v", err)
+	}
+
+	if len(projects) != total {
+		t.Errorf("Expected %d projects, got %d", total, len(projects))
+	}
+}
+
+func TestBackupAndDrService_All(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/backup-and-dr/policies", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("status") != "Active" {
+			http.Error(w, "Missing status filter", http.StatusBadRequest)
+			return
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		json.NewEncoder(w).Encode(PolicyListResponse{
+			Data:       []PolicyResponse{{PolicyID: fmt.Sprintf("policy-%d", page)}},
+			Pagination: Pagination{Page: page, PageSize: 1, Total: 3, HasNext: page < 3},
+		})
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	filters := &PolicyListFilters{Status: "Active", PageSize: 1}
+	policies, err := Collect(client.BackupAndDr().All(context.Background(), filters), 0)
+	if err != nil {
+		t.Fatalf("All failed: %v", err)
+	}
+
+	if len(policies) != 3 {
+		t.Fatalf("Expected 3 policies, got %d", len(policies))
+	}
+	if policies[0].PolicyID != "policy-1" || policies[2].PolicyID != "policy-3" {
+		t.Errorf("Unexpected policies: %+v", policies)
+	}
+	if filters.Page != 0 {
+		t.Errorf("Expected caller's filters to be left untouched, got page %d", filters.Page)
+	}
+}
+
+func TestGovernancePolicyService_All(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	const total = 5
+	mockServer.AddHandler("/v2/governance/insights", func(w http.ResponseWriter, r *http.Request) {
+		var listReq GovernancePolicyListRequest
+		if err := json.NewDecoder(r.Body).Decode(&listReq); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+
+		start, end := pageBounds((listReq.Page-1)*listReq.PageSize, listReq.PageSize, total)
+		hits := []GovernancePolicy{}
+		for i := start; i < end; i++ {
+			hits = append(hits, GovernancePolicy{ID: fmt.Sprintf("policy-%d", i)})
+		}
+		json.NewEncoder(w).Encode(GovernancePoliciesResponse{Hits: hits, Total: total, Page: listReq.Page, PageSize: listReq.PageSize})
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	policies, err := Collect(client.GovernancePolicies().All(context.Background(), &GovernancePolicyListRequest{PageSize: 2}), 0)
+	if err != nil {
+		t.Fatalf("All failed: %v", err)
+	}
+
+	if len(policies) != total {
+		t.Errorf("Expected %d policies, got %d", total, len(policies))
+	}
+}
+
+func TestGuardrailService_GetGuardrailBeyondFirstPage(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/guardrails/search", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		start, end := pageBounds(page*pageSize, pageSize, 150)
+		rules := []GuardrailRule{}
+		for i := start; i < end; i++ {
+			rules = append(rules, GuardrailRule{ID: fmt.Sprintf("rule-%d", i)})
+		}
+		json.NewEncoder(w).Encode(rules)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	rule, err := client.Guardrails().GetGuardrail(context.Background(), "rule-120")
+	if err != nil {
+		t.Fatalf("GetGuardrail failed: %v", err)
+	}
+	if rule.ID != "rule-120" {
+		t.Errorf("Expected rule 'rule-120', got '%s'", rule.ID)
+	}
+
+	_, err = client.Guardrails().GetGuardrail(context.Background(), "rule-999")
+	if !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+func TestPaginate_StopsOnError(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/workspaces/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			http.Error(w, "Bad request", http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(make([]Workspace, DefaultPageSize))
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	_, err := Collect(client.Workspaces().AllWorkspaces(context.Background(), &ListWorkspacesRequest{}), 0)
+	if StatusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected 400 error, got %v", err)
+	}
+}
+
+func TestFilter_StopsFetchingAtLimit(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var requests int32
+	mockServer.AddHandler("/v2/runners/variables/variable-sets", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+
+		variableSets := make([]VariableSet, pageSize)
+		for i := range variableSets {
+			variableSets[i] = VariableSet{ID: fmt.Sprintf("vs-%d", offset+i)}
+		}
+		json.NewEncoder(w).Encode(variableSets)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL())
+
+	// One variable set out of ten matches, 15 matches need two pages
+	matching := Filter(client.VariableSets().AllVariableSets(context.Background(), ""), func(v VariableSet) bool {
+		return strings.HasSuffix(v.ID, "0")
+	})
+	variableSets, err := Collect(matching, 15)
+	if err != nil {
+		t.Fatalf("Filter failed: %v", err)
+	}
+
+	if len(variableSets) != 15 || variableSets[14].ID != "vs-140" {
+		t.Fatalf("Expected 15 variable sets up to vs-140, got %d", len(variableSets))
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 page requests, got %d", requests)
+	}
+}
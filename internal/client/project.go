@@ -1,10 +1,14 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // ProjectService handles communication with the projects related methods of the Firefly API
@@ -77,9 +81,9 @@ type Member struct {
 }
 
 // CreateProject creates a new project
-func (s *ProjectService) CreateProject(req CreateProjectRequest) (*Project, error) {
+func (s *ProjectService) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPost, "/v2/runners/projects", req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/v2/runners/projects", req)
 	if err != nil {
 		return nil, err
 	}
@@ -93,8 +97,7 @@ func (s *ProjectService) CreateProject(req CreateProjectRequest) (*Project, erro
 
 	// Handle non-201 responses
 	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create project: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "create project")
 	}
 
 	// Parse the response
@@ -107,9 +110,9 @@ func (s *ProjectService) CreateProject(req CreateProjectRequest) (*Project, erro
 }
 
 // GetProject retrieves a project by ID
-func (s *ProjectService) GetProject(id string) (*Project, error) {
+func (s *ProjectService) GetProject(ctx context.Context, id string) (*Project, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodGet, fmt.Sprintf("/v2/runners/projects/%s", id), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/projects/%s", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -123,8 +126,7 @@ func (s *ProjectService) GetProject(id string) (*Project, error) {
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get project: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "get project")
 	}
 
 	// Parse the response
@@ -137,9 +139,9 @@ func (s *ProjectService) GetProject(id string) (*Project, error) {
 }
 
 // UpdateProject updates an existing project
-func (s *ProjectService) UpdateProject(id string, req UpdateProjectRequest) (*Project, error) {
+func (s *ProjectService) UpdateProject(ctx context.Context, id string, req UpdateProjectRequest) (*Project, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPatch, fmt.Sprintf("/v2/runners/projects/%s", id), req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPatch, fmt.Sprintf("/v2/runners/projects/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +155,7 @@ func (s *ProjectService) UpdateProject(id string, req UpdateProjectRequest) (*Pr
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update project: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "update project")
 	}
 
 	// Parse the response
@@ -167,9 +168,9 @@ func (s *ProjectService) UpdateProject(id string, req UpdateProjectRequest) (*Pr
 }
 
 // DeleteProject deletes a project and all its associated workspaces
-func (s *ProjectService) DeleteProject(id string) error {
+func (s *ProjectService) DeleteProject(ctx context.Context, id string) error {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/v2/runners/projects/%s", id), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/runners/projects/%s", id), nil)
 	if err != nil {
 		return err
 	}
@@ -183,17 +184,16 @@ func (s *ProjectService) DeleteProject(id string) error {
 
 	// Handle non-204 responses
 	if resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete project: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return newAPIError(resp, "delete project")
 	}
 
 	return nil
 }
 
 // ListProjectMembers retrieves all members of a project
-func (s *ProjectService) ListProjectMembers(projectID string) ([]Member, error) {
+func (s *ProjectService) ListProjectMembers(ctx context.Context, projectID string) ([]Member, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodGet, fmt.Sprintf("/v2/runners/projects/%s/members", projectID), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/projects/%s/members", projectID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +207,7 @@ func (s *ProjectService) ListProjectMembers(projectID string) ([]Member, error)
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list project members: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "list project members")
 	}
 
 	// Parse the response
@@ -221,9 +220,9 @@ func (s *ProjectService) ListProjectMembers(projectID string) ([]Member, error)
 }
 
 // AddProjectMembers adds new members to a project
-func (s *ProjectService) AddProjectMembers(projectID string, members []Member) ([]Member, error) {
+func (s *ProjectService) AddProjectMembers(ctx context.Context, projectID string, members []Member) ([]Member, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPost, fmt.Sprintf("/v2/runners/projects/%s/members", projectID), members)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/runners/projects/%s/members", projectID), members)
 	if err != nil {
 		return nil, err
 	}
@@ -237,8 +236,7 @@ func (s *ProjectService) AddProjectMembers(projectID string, members []Member) (
 
 	// Handle non-201 responses
 	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to add project members: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "add project members")
 	}
 
 	// Parse the response - try to decode as array first, fall back to success message
@@ -266,9 +264,9 @@ func (s *ProjectService) AddProjectMembers(projectID string, members []Member) (
 }
 
 // RemoveProjectMembers removes members from a project
-func (s *ProjectService) RemoveProjectMembers(projectID string, userIDs []string) error {
+func (s *ProjectService) RemoveProjectMembers(ctx context.Context, projectID string, userIDs []string) error {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/v2/runners/projects/%s/members", projectID), userIDs)
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/runners/projects/%s/members", projectID), userIDs)
 	if err != nil {
 		return err
 	}
@@ -282,16 +280,15 @@ func (s *ProjectService) RemoveProjectMembers(projectID string, userIDs []string
 
 	// Handle non-204 responses
 	if resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to remove project members: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return newAPIError(resp, "remove project members")
 	}
 
 	return nil
 }
 
 // GetProjectMember retrieves a specific member of a project
-func (s *ProjectService) GetProjectMember(projectID, userID string) (*Member, error) {
-	members, err := s.ListProjectMembers(projectID)
+func (s *ProjectService) GetProjectMember(ctx context.Context, projectID, userID string) (*Member, error) {
+	members, err := s.ListProjectMembers(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -302,13 +299,13 @@ func (s *ProjectService) GetProjectMember(projectID, userID string) (*Member, er
 		}
 	}
 
-	return nil, fmt.Errorf("member with user ID %s not found in project %s", userID, projectID)
+	return nil, fmt.Errorf("member with user ID %s in project %s: %w", userID, projectID, ErrNotFound)
 }
 
 // AddProjectMember adds a single member to a project
-func (s *ProjectService) AddProjectMember(projectID string, member Member) (*Member, error) {
+func (s *ProjectService) AddProjectMember(ctx context.Context, projectID string, member Member) (*Member, error) {
 	members := []Member{member}
-	addedMembers, err := s.AddProjectMembers(projectID, members)
+	addedMembers, err := s.AddProjectMembers(ctx, projectID, members)
 	if err != nil {
 		return nil, err
 	}
@@ -321,19 +318,147 @@ func (s *ProjectService) AddProjectMember(projectID string, member Member) (*Mem
 }
 
 // RemoveProjectMember removes a single member from a project
-func (s *ProjectService) RemoveProjectMember(projectID, userID string) error {
-	return s.RemoveProjectMembers(projectID, []string{userID})
+func (s *ProjectService) RemoveProjectMember(ctx context.Context, projectID, userID string) error {
+	return s.RemoveProjectMembers(ctx, projectID, []string{userID})
+}
+
+// MemberUpdateError is returned by UpdateProjectMember when the role of a member could not be
+// changed. It tells which role the member ended up with.
+type MemberUpdateError struct {
+	ProjectID string
+	UserID    string
+	// PreviousRole is the role of the member before the update
+	PreviousRole string
+	// Role is the requested role
+	Role string
+	// Removed is true when the member was removed from the project to be added back with the new role
+	Removed bool
+	// RolledBack is true when the member was added back with the previous role after the failure
+	RolledBack bool
+	// Err is the error that stopped the update
+	Err error
+	// RollbackErr is the error that stopped adding the member back with the previous role
+	RollbackErr error
 }
 
-// UpdateProjectMember updates a member's role in a project
-func (s *ProjectService) UpdateProjectMember(projectID string, member Member) (*Member, error) {
-	// First remove the member
-	if err := s.RemoveProjectMember(projectID, member.UserID); err != nil {
-		return nil, fmt.Errorf("failed to remove member for update: %w", err)
+// Error implements the error interface
+func (e *MemberUpdateError) Error() string {
+	msg := fmt.Sprintf("failed to change the role of user %s in project %s from %s to %s: %s", e.UserID, e.ProjectID, e.PreviousRole, e.Role, e.Err)
+	switch {
+	case e.MembershipLost():
+		return fmt.Sprintf("%s; restoring role %s failed, the user is no longer a member of the project: %s", msg, e.PreviousRole, e.RollbackErr)
+	case e.RolledBack:
+		return fmt.Sprintf("%s; the user was added back with role %s", msg, e.PreviousRole)
+	default:
+		return msg
 	}
+}
 
-	// Then add with the new role
-	return s.AddProjectMember(projectID, member)
+// Unwrap returns the error that stopped the update
+func (e *MemberUpdateError) Unwrap() error {
+	return e.Err
+}
+
+// MembershipLost reports whether the user was left without access to the project
+func (e *MemberUpdateError) MembershipLost() bool {
+	return e.Removed && !e.RolledBack
+}
+
+// UpdateProjectMember changes the role of a member. It uses the role update endpoint when the API
+// provides it, and otherwise removes the member and adds them back with the new role, restoring the
+// previous role if adding fails. Failures are returned as a *MemberUpdateError.
+//
+// The role update endpoint isn't in the API reference, which only documents adding and removing
+// members. It is tried first because it changes the role without a window where the user has no
+// access, and a 404, 405 or 501 answer falls back to the documented endpoints.
+func (s *ProjectService) UpdateProjectMember(ctx context.Context, projectID string, member Member) (*Member, error) {
+	current, err := s.GetProjectMember(ctx, projectID, member.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	updateErr := &MemberUpdateError{
+		ProjectID:    projectID,
+		UserID:       member.UserID,
+		PreviousRole: current.Role,
+		Role:         member.Role,
+	}
+
+	updated, err := s.updateProjectMemberRole(ctx, projectID, member)
+	if err == nil {
+		return updated, nil
+	}
+	if !isUnsupportedEndpoint(err) {
+		updateErr.Err = err
+		return nil, updateErr
+	}
+
+	tflog.Debug(ctx, "Role update endpoint not available, removing and adding the member back", map[string]interface{}{
+		"project_id": projectID,
+		"user_id":    member.UserID,
+	})
+
+	if err := s.RemoveProjectMember(ctx, projectID, member.UserID); err != nil {
+		updateErr.Err = err
+		return nil, updateErr
+	}
+	updateErr.Removed = true
+
+	added, err := s.AddProjectMember(ctx, projectID, member)
+	if err == nil {
+		return added, nil
+	}
+	updateErr.Err = err
+
+	// Restore the access even when the update was canceled
+	if _, rollbackErr := s.AddProjectMember(context.WithoutCancel(ctx), projectID, *current); rollbackErr != nil {
+		updateErr.RollbackErr = rollbackErr
+	} else {
+		updateErr.RolledBack = true
+	}
+
+	return nil, updateErr
+}
+
+// updateProjectMemberRole changes the role of a member with the undocumented role update endpoint
+func (s *ProjectService) updateProjectMemberRole(ctx context.Context, projectID string, member Member) (*Member, error) {
+	// Create the request
+	body := map[string]string{"role": member.Role}
+	httpReq, err := s.client.newRequest(ctx, http.MethodPatch, fmt.Sprintf("/v2/runners/projects/%s/members/%s", projectID, member.UserID), body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "update project member role")
+	}
+
+	// Parse the response
+	var updated Member
+	if err := json.NewDecoder(resp.Body).Decode(&updated); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// isUnsupportedEndpoint reports whether err means the API doesn't provide the endpoint. A not found
+// error counts, as the member was found just before.
+func isUnsupportedEndpoint(err error) bool {
+	switch StatusCode(err) {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	default:
+		return false
+	}
 }
 
 // ProjectsListResponse represents the response from listing projects
@@ -343,7 +468,7 @@ type ProjectsListResponse struct {
 }
 
 // ListProjects retrieves all projects with pagination support
-func (s *ProjectService) ListProjects(pageSize, offset int, searchQuery string) (*ProjectsListResponse, error) {
+func (s *ProjectService) ListProjects(ctx context.Context, pageSize, offset int, searchQuery string) (*ProjectsListResponse, error) {
 	// Create the request URL with query parameters
 	url := fmt.Sprintf("/v2/runners/projects/list?pageSize=%d&offset=%d", pageSize, offset)
 	if searchQuery != "" {
@@ -351,7 +476,7 @@ func (s *ProjectService) ListProjects(pageSize, offset int, searchQuery string)
 	}
 
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodGet, url, nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -365,8 +490,7 @@ func (s *ProjectService) ListProjects(pageSize, offset int, searchQuery string)
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list projects: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "list projects")
 	}
 
 	// Parse the response
@@ -376,4 +500,16 @@ func (s *ProjectService) ListProjects(pageSize, offset int, searchQuery string)
 	}
 
 	return &projectsResp, nil
-}
\ No newline at end of file
+}
+
+// AllProjects iterates over every project matching the search query, fetching pages as needed
+func (s *ProjectService) AllProjects(ctx context.Context, searchQuery string) iter.Seq2[Project, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]Project, bool, error) {
+		offset := page * DefaultPageSize
+		projectsResp, err := s.ListProjects(ctx, DefaultPageSize, offset, searchQuery)
+		if err != nil {
+			return nil, false, err
+		}
+		return projectsResp.Data, offset+len(projectsResp.Data) < projectsResp.TotalCount, nil
+	})
+}
@@ -1,7 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -35,7 +38,7 @@ func TestProjectService_ListProjects(t *testing.T) {
 					MembersCount: 5,
 				},
 				{
-					ID:           "project-2", 
+					ID:           "project-2",
 					Name:         "Test Project 2",
 					Description:  "Second test project",
 					Labels:       []string{"test", "project2"},
@@ -60,7 +63,7 @@ func TestProjectService_ListProjects(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	projects, err := client.Projects.ListProjects(10, 0, "")
+	projects, err := client.Projects().ListProjects(context.Background(), 10, 0, "")
 	if err != nil {
 		t.Fatalf("ListProjects failed: %v", err)
 	}
@@ -102,14 +105,14 @@ func TestProjectService_GetProject(t *testing.T) {
 		}
 
 		project := Project{
-			ID:              "test-project-id",
-			Name:            "Test Project",
-			Description:     "A test project for unit tests",
-			Labels:          []string{"test", "unit"},
-			AccountID:       "account-1",
-			MembersCount:    10,
-			WorkspaceCount:  5,
-			ParentID:        "parent-project-id",
+			ID:             "test-project-id",
+			Name:           "Test Project",
+			Description:    "A test project for unit tests",
+			Labels:         []string{"test", "unit"},
+			AccountID:      "account-1",
+			MembersCount:   10,
+			WorkspaceCount: 5,
+			ParentID:       "parent-project-id",
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -126,7 +129,7 @@ func TestProjectService_GetProject(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	project, err := client.Projects.GetProject("test-project-id")
+	project, err := client.Projects().GetProject(context.Background(), "test-project-id")
 	if err != nil {
 		t.Fatalf("GetProject failed: %v", err)
 	}
@@ -169,13 +172,13 @@ func TestProjectService_CreateProject(t *testing.T) {
 
 		// Create response project with generated ID
 		project := Project{
-			ID:          "generated-project-id",
-			Name:        createReq.Name,
-			Description: createReq.Description,
-			Labels:      createReq.Labels,
-			ParentID:    createReq.ParentID,
-			AccountID:   "account-1",
-			MembersCount: 1,
+			ID:             "generated-project-id",
+			Name:           createReq.Name,
+			Description:    createReq.Description,
+			Labels:         createReq.Labels,
+			ParentID:       createReq.ParentID,
+			AccountID:      "account-1",
+			MembersCount:   1,
 			WorkspaceCount: 0,
 		}
 
@@ -201,7 +204,7 @@ func TestProjectService_CreateProject(t *testing.T) {
 		ParentID:    "parent-project",
 	}
 
-	project, err := client.Projects.CreateProject(createReq)
+	project, err := client.Projects().CreateProject(context.Background(), createReq)
 	if err != nil {
 		t.Fatalf("CreateProject failed: %v", err)
 	}
@@ -250,12 +253,12 @@ func TestProjectService_UpdateProject(t *testing.T) {
 
 		// Return updated project
 		project := Project{
-			ID:          projectID,
-			Name:        updateReq.Name,
-			Description: updateReq.Description,
-			Labels:      updateReq.Labels,
-			AccountID:   "account-1",
-			MembersCount: 5,
+			ID:             projectID,
+			Name:           updateReq.Name,
+			Description:    updateReq.Description,
+			Labels:         updateReq.Labels,
+			AccountID:      "account-1",
+			MembersCount:   5,
 			WorkspaceCount: 3,
 		}
 
@@ -279,7 +282,7 @@ func TestProjectService_UpdateProject(t *testing.T) {
 		Labels:      []string{"updated", "test"},
 	}
 
-	project, err := client.Projects.UpdateProject("test-project-id", updateReq)
+	project, err := client.Projects().UpdateProject(context.Background(), "test-project-id", updateReq)
 	if err != nil {
 		t.Fatalf("UpdateProject failed: %v", err)
 	}
@@ -329,7 +332,7 @@ func TestProjectService_DeleteProject(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	err = client.Projects.DeleteProject("test-project-id")
+	err = client.Projects().DeleteProject(context.Background(), "test-project-id")
 	if err != nil {
 		t.Fatalf("DeleteProject failed: %v", err)
 	}
@@ -352,7 +355,7 @@ func TestProjectService_Error_NotFound(t *testing.T) {
 
 	client, err := NewClient(Config{
 		AccessKey: "test-access",
-		SecretKey: "test-secret", 
+		SecretKey: "test-secret",
 		APIURL:    mockServer.URL(),
 	})
 
@@ -360,7 +363,7 @@ func TestProjectService_Error_NotFound(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	_, err = client.Projects.GetProject("nonexistent-project")
+	_, err = client.Projects().GetProject(context.Background(), "nonexistent-project")
 	if err == nil {
 		t.Error("Expected error for nonexistent project")
 	}
@@ -410,7 +413,7 @@ func TestProjectService_ListProjectMembers(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	members, err := client.Projects.ListProjectMembers("test-project")
+	members, err := client.Projects().ListProjectMembers(context.Background(), "test-project")
 	if err != nil {
 		t.Fatalf("ListProjectMembers failed: %v", err)
 	}
@@ -476,7 +479,7 @@ func TestProjectService_AddProjectMember(t *testing.T) {
 		Role:   "member",
 	}
 
-	addedMember, err := client.Projects.AddProjectMember("test-project", member)
+	addedMember, err := client.Projects().AddProjectMember(context.Background(), "test-project", member)
 	if err != nil {
 		t.Fatalf("AddProjectMember failed: %v", err)
 	}
@@ -530,7 +533,7 @@ func TestProjectService_GetProjectMember(t *testing.T) {
 	}
 
 	// Test getting existing member
-	member, err := client.Projects.GetProjectMember("test-project", "user1")
+	member, err := client.Projects().GetProjectMember(context.Background(), "test-project", "user1")
 	if err != nil {
 		t.Fatalf("GetProjectMember failed: %v", err)
 	}
@@ -544,7 +547,7 @@ func TestProjectService_GetProjectMember(t *testing.T) {
 	}
 
 	// Test getting non-existent member
-	_, err = client.Projects.GetProjectMember("test-project", "nonexistent")
+	_, err = client.Projects().GetProjectMember(context.Background(), "test-project", "nonexistent")
 	if err == nil {
 		t.Error("Expected error for nonexistent member")
 	}
@@ -591,8 +594,168 @@ func TestProjectService_RemoveProjectMember(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	err = client.Projects.RemoveProjectMember("test-project", "user1")
+	err = client.Projects().RemoveProjectMember(context.Background(), "test-project", "user1")
 	if err != nil {
 		t.Fatalf("RemoveProjectMember failed: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+func TestProjectService_UpdateProjectMember(t *testing.T) {
+	tests := []struct {
+		name string
+		// patchStatus is the response of the role update endpoint
+		patchStatus int
+		// addStatus returns the response of adding the member with role
+		addStatus func(role string) int
+		// expectedCalls lists the requests after reading the member
+		expectedCalls string
+		expectErr     bool
+		removed       bool
+		rolledBack    bool
+	}{
+		{
+			name:          "role update endpoint",
+			patchStatus:   http.StatusOK,
+			expectedCalls: "[PATCH]",
+		},
+		{
+			name:          "remove and add back",
+			patchStatus:   http.StatusNotFound,
+			addStatus:     func(role string) int { return http.StatusCreated },
+			expectedCalls: "[PATCH DELETE POST:viewer]",
+		},
+		{
+			name:          "role update rejected",
+			patchStatus:   http.StatusForbidden,
+			expectedCalls: "[PATCH]",
+			expectErr:     true,
+		},
+		{
+			name:        "rolled back",
+			patchStatus: http.StatusMethodNotAllowed,
+			addStatus: func(role string) int {
+				if role == "viewer" {
+					return http.StatusBadRequest
+				}
+				return http.StatusCreated
+			},
+			expectedCalls: "[PATCH DELETE POST:viewer POST:admin]",
+			expectErr:     true,
+			removed:       true,
+			rolledBack:    true,
+		},
+		{
+			name:          "membership lost",
+			patchStatus:   http.StatusMethodNotAllowed,
+			addStatus:     func(role string) int { return http.StatusBadRequest },
+			expectedCalls: "[PATCH DELETE POST:viewer POST:admin]",
+			expectErr:     true,
+			removed:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockServer := NewMockServer()
+			defer mockServer.Close()
+
+			mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+				authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+				json.NewEncoder(w).Encode(authResp)
+			})
+
+			var calls []string
+			mockServer.AddHandler("/v2/runners/projects/test-project/members", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					json.NewEncoder(w).Encode([]Member{{UserID: "user1", Email: "user1@example.com", Role: "admin"}})
+				case http.MethodDelete:
+					calls = append(calls, r.Method)
+					w.WriteHeader(http.StatusNoContent)
+				case http.MethodPost:
+					var members []Member
+					json.NewDecoder(r.Body).Decode(&members)
+					calls = append(calls, "POST:"+members[0].Role)
+					w.WriteHeader(tt.addStatus(members[0].Role))
+					json.NewEncoder(w).Encode(members)
+				}
+			})
+			mockServer.AddHandler("/v2/runners/projects/test-project/members/user1", func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.patchStatus)
+				json.NewEncoder(w).Encode(Member{UserID: "user1", Role: "viewer"})
+			})
+
+			client, err := NewClient(Config{
+				AccessKey: "test-access",
+				SecretKey: "test-secret",
+				APIURL:    mockServer.URL(),
+			})
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			member, err := client.Projects().UpdateProjectMember(context.Background(), "test-project", Member{UserID: "user1", Role: "viewer"})
+			if got := fmt.Sprint(calls); got != tt.expectedCalls {
+				t.Errorf("Expected calls %s, got %s", tt.expectedCalls, got)
+			}
+
+			if !tt.expectErr {
+				if err != nil {
+					t.Fatalf("UpdateProjectMember failed: %v", err)
+				}
+				if member.Role != "viewer" {
+					t.Errorf("Expected role viewer, got %s", member.Role)
+				}
+				return
+			}
+
+			var updateErr *MemberUpdateError
+			if !errors.As(err, &updateErr) {
+				t.Fatalf("Expected a MemberUpdateError, got %v", err)
+			}
+			if updateErr.PreviousRole != "admin" || updateErr.Role != "viewer" {
+				t.Errorf("Unexpected roles in %+v", updateErr)
+			}
+			if updateErr.Removed != tt.removed || updateErr.RolledBack != tt.rolledBack {
+				t.Errorf("Expected removed %t and rolled back %t, got %+v", tt.removed, tt.rolledBack, updateErr)
+			}
+			if updateErr.MembershipLost() != (tt.removed && !tt.rolledBack) {
+				t.Errorf("Unexpected MembershipLost for %+v", updateErr)
+			}
+		})
+	}
+}
+
+func TestProjectService_UpdateProjectMember_NotMember(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+	mockServer.AddHandler("/v2/runners/projects/test-project/members", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Member{})
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, err = client.Projects().UpdateProjectMember(context.Background(), "test-project", Member{UserID: "user1", Role: "viewer"})
+	if !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries the provider configures when max_retries is not set
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the upper bound for a single wait between retries
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryMinWait is the base delay for the exponential backoff
+	retryMinWait = 1 * time.Second
+)
+
+// retryPolicy controls how failed requests are retried
+type retryPolicy struct {
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+// newRetryPolicy builds a retry policy from the client configuration
+func newRetryPolicy(config Config) retryPolicy {
+	policy := retryPolicy{
+		maxRetries: config.MaxRetries,
+		minWait:    retryMinWait,
+		maxWait:    config.RetryMaxWait,
+	}
+
+	if policy.maxRetries < 0 {
+		policy.maxRetries = 0
+	}
+
+	if policy.maxWait <= 0 {
+		policy.maxWait = DefaultRetryMaxWait
+	}
+
+	if policy.minWait > policy.maxWait {
+		policy.minWait = policy.maxWait
+	}
+
+	return policy
+}
+
+// retryableContextKey is the context key marking a POST request as safe to retry
+type retryableContextKey struct{}
+
+// markRetryable returns the request marked as safe to retry, for POST requests without side
+// effects such as searches. The mark stays in the client and isn't sent to the API.
+func markRetryable(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), retryableContextKey{}, true))
+}
+
+// isRetryableRequest reports whether the request can be sent more than once without side effects
+func isRetryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		retryable, _ := req.Context().Value(retryableContextKey{}).(bool)
+		return retryable
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus reports whether a response status is worth retrying
+func isRetryableStatus(statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode >= 500 && statusCode != http.StatusNotImplemented
+}
+
+// backoff returns how long to wait before the given retry attempt (starting at 0)
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > p.maxWait {
+				return p.maxWait
+			}
+			return wait
+		}
+	}
+
+	wait := time.Duration(float64(p.minWait) * math.Pow(2, float64(attempt)))
+	if wait <= 0 || wait > p.maxWait {
+		wait = p.maxWait
+	}
+
+	// Add jitter so parallel resources don't retry in lockstep
+	half := wait / 2
+	return half + time.Duration(mathrand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// doWithRetry executes the request, retrying 429 and 5xx responses for requests that are safe to repeat
+func (c *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	retryable := isRetryableRequest(req) && (req.Body == nil || req.GetBody != nil)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+
+		if !retryable || attempt >= c.retry.maxRetries || !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := c.retry.backoff(attempt, resp)
+
+		tflog.Warn(ctx, "Retrying Firefly API request", map[string]interface{}{
+			"method":      req.Method,
+			"path":        req.URL.Path,
+			"status_code": resp.StatusCode,
+			"attempt":     attempt + 1,
+			"max_retries": c.retry.maxRetries,
+			"wait":        wait.String(),
+		})
+
+		drainBody(resp)
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// withMaxRetries sets the number of retries of the test client
+func withMaxRetries(maxRetries int) func(*Config) {
+	return func(config *Config) {
+		config.MaxRetries = maxRetries
+	}
+}
+
+func TestRetry_GetRetriesServerErrors(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var attempts int32
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			http.Error(w, "Bad gateway", http.StatusBadGateway)
+			return
+		}
+		json.NewEncoder(w).Encode(Project{ID: "test-project-id", Name: "Test Project"})
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL(), withMaxRetries(3))
+
+	project, err := client.Projects().GetProject(context.Background(), "test-project-id")
+	if err != nil {
+		t.Fatalf("GetProject failed: %v", err)
+	}
+
+	if project.ID != "test-project-id" {
+		t.Errorf("Expected project ID 'test-project-id', got '%s'", project.ID)
+	}
+
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetry_GivesUpAfterMaxRetries(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var attempts int32
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "0")
+		http.Error(w, "Slow down", http.StatusTooManyRequests)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL(), withMaxRetries(2))
+
+	_, err := client.Projects().GetProject(context.Background(), "test-project-id")
+	if !IsRateLimited(err) {
+		t.Fatalf("Expected rate limited error, got %v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts (1 + 2 retries), got %d", attempts)
+	}
+}
+
+func TestRetry_UnmarkedPostIsNotRetried(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var attempts int32
+	mockServer.AddHandler("/v2/runners/projects", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, "Unavailable", http.StatusServiceUnavailable)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL(), withMaxRetries(3))
+
+	_, err := client.Projects().CreateProject(context.Background(), CreateProjectRequest{Name: "Test Project"})
+	if StatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503 error, got %v", err)
+	}
+
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetry_MarkedPostReplaysBody(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var attempts int32
+	var headers []http.Header
+	mockServer.AddHandler("/v2/guardrails/search", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var listReq ListGuardrailsRequest
+		if err := json.Unmarshal(body, &listReq); err != nil || listReq.SearchValue != "cost" {
+			http.Error(w, "Invalid body", http.StatusBadRequest)
+			return
+		}
+
+		headers = append(headers, r.Header.Clone())
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode([]GuardrailRule{{ID: "rule-1", Name: "Cost Rule"}})
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL(), withMaxRetries(3))
+
+	rules, err := client.Guardrails().ListGuardrails(context.Background(), &ListGuardrailsRequest{SearchValue: "cost"}, 0, 10)
+	if err != nil {
+		t.Fatalf("ListGuardrails failed: %v", err)
+	}
+
+	if len(rules) != 1 {
+		t.Fatalf("Expected 1 rule, got %d", len(rules))
+	}
+
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+
+	// The retry mark isn't sent to the API
+	for _, header := range headers {
+		if header.Get("Idempotency-Key") != "" {
+			t.Errorf("Unexpected Idempotency-Key header in %v", header)
+		}
+	}
+}
+
+func TestRetry_DisabledWithZeroMaxRetries(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	var attempts int32
+	mockServer.AddHandler("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, "Bad gateway", http.StatusBadGateway)
+	})
+
+	mockServer.AddLoginHandler()
+	client := newTestClient(t, mockServer.URL(), withMaxRetries(0))
+
+	if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err == nil {
+		t.Fatal("Expected error")
+	}
+
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		wantOK bool
+		want   time.Duration
+	}{
+		{name: "empty", value: "", wantOK: false},
+		{name: "seconds", value: "5", wantOK: true, want: 5 * time.Second},
+		{name: "negative", value: "-1", wantOK: false},
+		{name: "past date", value: "Mon, 02 Jan 2006 15:04:05 GMT", wantOK: true, want: 0},
+		{name: "garbage", value: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if ok != tt.wantOK {
+				t.Fatalf("parseRetryAfter(%q) ok = %v, want %v", tt.value, ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_BackoffIsCapped(t *testing.T) {
+	policy := newRetryPolicy(Config{MaxRetries: 10, RetryMaxWait: 2 * time.Second})
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := policy.backoff(attempt, nil)
+		if wait > 2*time.Second {
+			t.Errorf("attempt %d: wait %v exceeds max wait", attempt, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := policy.backoff(0, resp); wait != 2*time.Second {
+		t.Errorf("Expected Retry-After to be capped at 2s, got %v", wait)
+	}
+}
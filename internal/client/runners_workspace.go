@@ -1,9 +1,9 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
@@ -60,6 +60,11 @@ type UpdateRunnersWorkspaceRequest struct {
 	IacProvisioner          *IacProvisioner `json:"iacProvisioner,omitempty"`
 	Variables               []Variable      `json:"variables,omitempty"`
 	ConsumedVariableSets    []string        `json:"consumedVariableSets,omitempty"`
+	// A nil map is not sent and keeps the values stored by the API, a pointer to an empty map clears them
+	TerraformVariables          *map[string]interface{} `json:"terraformVariables,omitempty"`
+	TerraformSensitiveVariables *map[string]interface{} `json:"terraformSensitiveVariables,omitempty"`
+	ProvidersCredentials        *map[string]interface{} `json:"providersCredentials,omitempty"`
+	RunnerEnvironment           *map[string]interface{} `json:"runnerEnvironment,omitempty"`
 }
 
 // RunnersWorkspace represents a Firefly runners workspace
@@ -77,12 +82,22 @@ type RunnersWorkspace struct {
 	IacProvisioner       *IacProvisioner `json:"iacProvisioner"`
 	Labels               []string        `json:"labels"`
 	ProjectID            string          `json:"projectId,omitempty"`  // Added missing project ID field
+	// Sensitive variables and provider credentials are never returned by the API
+	TerraformVariables map[string]interface{} `json:"terraformVariables,omitempty"`
+	RunnerEnvironment  map[string]interface{} `json:"runnerEnvironment,omitempty"`
 }
 
 // TaskResponse represents the response from a task operation
 type TaskResponse struct {
-	TaskID string `json:"taskId"`
-	Status string `json:"status"`
+	TaskID      string `json:"taskId"`
+	Status      string `json:"status"`
+	RunID       string `json:"runId,omitempty"` // Set for plan and apply tasks
+	TaskType    string `json:"taskType,omitempty"`
+	WorkspaceID string `json:"workspaceId,omitempty"`
+	CreatedAt   string `json:"createdAt,omitempty"`
+	UpdatedAt   string `json:"updatedAt,omitempty"`
+	Message     string `json:"message,omitempty"` // Reason of a failure
+	Logs        string `json:"logs,omitempty"`    // Only returned by GetTask
 }
 
 // RunTaskRequest represents a request to run a task on a workspace
@@ -90,10 +105,79 @@ type RunTaskRequest struct {
 	TaskType string `json:"taskType"` // e.g., "destroy", "plan", "apply"
 }
 
+// Statuses of a task
+const (
+	TaskStatusQueued    = "queued"
+	TaskStatusRunning   = "running"
+	TaskStatusCompleted = "completed"
+	TaskStatusFailed    = "failed"
+	TaskStatusCancelled = "cancelled"
+)
+
+// IsTerminalTaskStatus reports whether a task with the given status has finished
+func IsTerminalTaskStatus(status string) bool {
+	return status == TaskStatusCompleted || status == TaskStatusFailed || status == TaskStatusCancelled
+}
+
+// TaskPoller returns a PollFunc reading the task of a runners workspace, for WaitFor
+func TaskPoller(api RunnersWorkspacesAPI, workspaceID, taskID string) PollFunc[*TaskResponse] {
+	return func(ctx context.Context) (*TaskResponse, bool, error) {
+		task, err := api.GetTask(ctx, workspaceID, taskID)
+		if err != nil {
+			return nil, false, err
+		}
+		return task, IsTerminalTaskStatus(task.Status), nil
+	}
+}
+
+// Task types accepted by RunWorkspaceTask
+const (
+	TaskTypePlan  = "plan"
+	TaskTypeApply = "apply"
+)
+
+// IsTerminalRunStatus reports whether a run of the given task type has reached a status it will
+// not leave on its own. A plan run ends once planned, while an apply run goes on to apply the
+// plan. A blocked run waits for a manual approval and is considered terminal.
+func IsTerminalRunStatus(taskType, status string) bool {
+	switch WorkspaceRunStatusEnum(status) {
+	case WorkspaceRunStatusInitError, WorkspaceRunStatusPlanError, WorkspaceRunStatusPlanNoChanges,
+		WorkspaceRunStatusApplyError, WorkspaceRunStatusApplySuccess, WorkspaceRunStatusApplyNoChanges,
+		WorkspaceRunStatusBlocked, WorkspaceRunStatusAcknowledged:
+		return true
+	case WorkspaceRunStatusPlanSuccess:
+		return taskType == TaskTypePlan
+	default:
+		return false
+	}
+}
+
+// WorkspaceRunPoller returns a PollFunc reading a run of a runners workspace started by a task of
+// the given type, for WaitFor
+func WorkspaceRunPoller(api RunnersWorkspacesAPI, workspaceID, runID, taskType string) PollFunc[*WorkspaceRun] {
+	return func(ctx context.Context) (*WorkspaceRun, bool, error) {
+		run, err := api.GetWorkspaceRun(ctx, workspaceID, runID)
+		if err != nil {
+			return nil, false, err
+		}
+		return run, IsTerminalRunStatus(taskType, run.Status), nil
+	}
+}
+
+// IsFailedRunStatus reports whether the status is the one of a failed run
+func IsFailedRunStatus(status string) bool {
+	switch WorkspaceRunStatusEnum(status) {
+	case WorkspaceRunStatusInitError, WorkspaceRunStatusPlanError, WorkspaceRunStatusApplyError:
+		return true
+	default:
+		return false
+	}
+}
+
 // CreateRunnersWorkspace creates a new runners workspace
-func (s *RunnersWorkspaceService) CreateRunnersWorkspace(req CreateRunnersWorkspaceRequest) (*RunnersWorkspace, error) {
+func (s *RunnersWorkspaceService) CreateRunnersWorkspace(ctx context.Context, req CreateRunnersWorkspaceRequest) (*RunnersWorkspace, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPost, "/v2/runners/workspaces", req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/v2/runners/workspaces", req)
 	if err != nil {
 		return nil, err
 	}
@@ -107,8 +191,7 @@ func (s *RunnersWorkspaceService) CreateRunnersWorkspace(req CreateRunnersWorksp
 
 	// Handle non-201 responses
 	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create runners workspace: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "create runners workspace")
 	}
 
 	// Parse the response
@@ -121,9 +204,9 @@ func (s *RunnersWorkspaceService) CreateRunnersWorkspace(req CreateRunnersWorksp
 }
 
 // GetRunnersWorkspace retrieves a runners workspace by ID
-func (s *RunnersWorkspaceService) GetRunnersWorkspace(id string) (*RunnersWorkspace, error) {
+func (s *RunnersWorkspaceService) GetRunnersWorkspace(ctx context.Context, id string) (*RunnersWorkspace, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodGet, fmt.Sprintf("/v2/runners/workspaces/%s", id), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/workspaces/%s", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -137,8 +220,7 @@ func (s *RunnersWorkspaceService) GetRunnersWorkspace(id string) (*RunnersWorksp
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get runners workspace: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "get runners workspace")
 	}
 
 	// Parse the response
@@ -151,9 +233,9 @@ func (s *RunnersWorkspaceService) GetRunnersWorkspace(id string) (*RunnersWorksp
 }
 
 // UpdateRunnersWorkspace updates an existing runners workspace
-func (s *RunnersWorkspaceService) UpdateRunnersWorkspace(id string, req UpdateRunnersWorkspaceRequest) (*RunnersWorkspace, error) {
+func (s *RunnersWorkspaceService) UpdateRunnersWorkspace(ctx context.Context, id string, req UpdateRunnersWorkspaceRequest) (*RunnersWorkspace, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPut, fmt.Sprintf("/v2/runners/workspaces/%s", id), req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("/v2/runners/workspaces/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +249,7 @@ func (s *RunnersWorkspaceService) UpdateRunnersWorkspace(id string, req UpdateRu
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update runners workspace: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "update runners workspace")
 	}
 
 	// Parse the response
@@ -181,9 +262,9 @@ func (s *RunnersWorkspaceService) UpdateRunnersWorkspace(id string, req UpdateRu
 }
 
 // DeleteRunnersWorkspace deletes a runners workspace
-func (s *RunnersWorkspaceService) DeleteRunnersWorkspace(id string) error {
+func (s *RunnersWorkspaceService) DeleteRunnersWorkspace(ctx context.Context, id string) error {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/v2/runners/workspaces/%s", id), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/runners/workspaces/%s", id), nil)
 	if err != nil {
 		return err
 	}
@@ -197,17 +278,45 @@ func (s *RunnersWorkspaceService) DeleteRunnersWorkspace(id string) error {
 
 	// Handle non-success responses (accept both 200 and 204)
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete runners workspace: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return newAPIError(resp, "delete runners workspace")
 	}
 
 	return nil
 }
 
 // DestroyWorkspaceResources initiates a destroy task to clean up cloud infrastructure resources
-func (s *RunnersWorkspaceService) DestroyWorkspaceResources(id string, req RunTaskRequest) (*TaskResponse, error) {
+func (s *RunnersWorkspaceService) DestroyWorkspaceResources(ctx context.Context, id string, req RunTaskRequest) (*TaskResponse, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/runners/workspaces/%s/tasks/destroy", id), req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "destroy workspace resources")
+	}
+
+	// Parse the response
+	var taskResp TaskResponse
+	if err := json.NewDecoder(resp.Body).Decode(&taskResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &taskResp, nil
+}
+
+// GetTask retrieves the current state of a task started on a runners workspace, including its logs
+func (s *RunnersWorkspaceService) GetTask(ctx context.Context, workspaceID, taskID string) (*TaskResponse, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPost, fmt.Sprintf("/v2/runners/workspaces/%s/tasks/destroy", id), req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/workspaces/%s/tasks/%s", workspaceID, taskID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -221,8 +330,7 @@ func (s *RunnersWorkspaceService) DestroyWorkspaceResources(id string, req RunTa
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to destroy workspace resources: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "get task")
 	}
 
 	// Parse the response
@@ -232,4 +340,96 @@ func (s *RunnersWorkspaceService) DestroyWorkspaceResources(id string, req RunTa
 	}
 
 	return &taskResp, nil
-}
\ No newline at end of file
+}
+
+// ListTasks lists the tasks started on a runners workspace, most recent first. Logs are not included.
+func (s *RunnersWorkspaceService) ListTasks(ctx context.Context, workspaceID string) ([]TaskResponse, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/workspaces/%s/tasks", workspaceID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "list tasks")
+	}
+
+	// Parse the response
+	var tasks []TaskResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tasks); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// RunWorkspaceTask starts a plan or apply task on a runners workspace. The response holds the ID
+// of the run, whose status can be followed with GetWorkspaceRun.
+func (s *RunnersWorkspaceService) RunWorkspaceTask(ctx context.Context, id string, req RunTaskRequest) (*TaskResponse, error) {
+	if req.TaskType != TaskTypePlan && req.TaskType != TaskTypeApply {
+		return nil, fmt.Errorf("unsupported task type %q, expected %q or %q", req.TaskType, TaskTypePlan, TaskTypeApply)
+	}
+
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/runners/workspaces/%s/tasks/%s", id, req.TaskType), req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "run workspace task")
+	}
+
+	// Parse the response
+	var taskResp TaskResponse
+	if err := json.NewDecoder(resp.Body).Decode(&taskResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &taskResp, nil
+}
+
+// GetWorkspaceRun retrieves a run of a runners workspace by ID
+func (s *RunnersWorkspaceService) GetWorkspaceRun(ctx context.Context, workspaceID, runID string) (*WorkspaceRun, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/workspaces/%s/runs/%s", workspaceID, runID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "get workspace run")
+	}
+
+	// Parse the response
+	var run WorkspaceRun
+	if err := json.NewDecoder(resp.Body).Decode(&run); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &run, nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -158,7 +159,7 @@ func TestRunnersWorkspaceService_CreateRunnersWorkspace(t *testing.T) {
 				t.Fatalf("Failed to create client: %v", err)
 			}
 
-			workspace, err := client.RunnersWorkspaces.CreateRunnersWorkspace(tt.request)
+			workspace, err := client.RunnersWorkspaces().CreateRunnersWorkspace(context.Background(), tt.request)
 			if err != nil {
 				t.Fatalf("CreateRunnersWorkspace failed: %v", err)
 			}
@@ -225,7 +226,7 @@ func TestRunnersWorkspaceService_GetRunnersWorkspace(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	workspace, err := client.RunnersWorkspaces.GetRunnersWorkspace("test-workspace-id")
+	workspace, err := client.RunnersWorkspaces().GetRunnersWorkspace(context.Background(), "test-workspace-id")
 	if err != nil {
 		t.Fatalf("GetRunnersWorkspace failed: %v", err)
 	}
@@ -354,7 +355,7 @@ func TestRunnersWorkspaceService_UpdateRunnersWorkspace(t *testing.T) {
 				t.Fatalf("Failed to create client: %v", err)
 			}
 
-			workspace, err := client.RunnersWorkspaces.UpdateRunnersWorkspace("test-workspace-id", tt.request)
+			workspace, err := client.RunnersWorkspaces().UpdateRunnersWorkspace(context.Background(), "test-workspace-id", tt.request)
 			if err != nil {
 				t.Fatalf("UpdateRunnersWorkspace failed: %v", err)
 			}
@@ -370,6 +371,64 @@ func TestRunnersWorkspaceService_UpdateRunnersWorkspace(t *testing.T) {
 	}
 }
 
+func TestRunnersWorkspaceService_UpdateRunnersWorkspace_Maps(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	var body map[string]json.RawMessage
+	mockServer.AddHandler("/v2/runners/workspaces/", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "test-workspace-id", "terraformVariables": {"region": "us-east-1", "replicas": 3, "zones": ["a", "b"]}}`))
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspace, err := client.RunnersWorkspaces().UpdateRunnersWorkspace(context.Background(), "test-workspace-id", UpdateRunnersWorkspaceRequest{
+		TerraformVariables:          &map[string]interface{}{"region": "us-east-1", "replicas": 3, "zones": []string{"a", "b"}},
+		TerraformSensitiveVariables: &map[string]interface{}{},
+		ProvidersCredentials:        &map[string]interface{}{"aws": map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/firefly"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateRunnersWorkspace failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"terraformVariables":          `{"region":"us-east-1","replicas":3,"zones":["a","b"]}`,
+		"terraformSensitiveVariables": `{}`,
+		"providersCredentials":        `{"aws":{"role_arn":"arn:aws:iam::123456789012:role/firefly"}}`,
+	}
+	for field, value := range expected {
+		if string(body[field]) != value {
+			t.Errorf("Expected %s to be sent as %s, got %s", field, value, body[field])
+		}
+	}
+	// A map that isn't set must not be sent, so the stored values are kept
+	if value, ok := body["runnerEnvironment"]; ok {
+		t.Errorf("Expected runnerEnvironment not to be sent, got %s", value)
+	}
+
+	if workspace.TerraformVariables["replicas"] != float64(3) || workspace.TerraformVariables["region"] != "us-east-1" {
+		t.Errorf("Unexpected terraform variables %v", workspace.TerraformVariables)
+	}
+}
+
 func TestRunnersWorkspaceService_DeleteRunnersWorkspace(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
@@ -406,7 +465,7 @@ func TestRunnersWorkspaceService_DeleteRunnersWorkspace(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	err = client.RunnersWorkspaces.DeleteRunnersWorkspace("test-workspace-id")
+	err = client.RunnersWorkspaces().DeleteRunnersWorkspace(context.Background(), "test-workspace-id")
 	if err != nil {
 		t.Fatalf("DeleteRunnersWorkspace failed: %v", err)
 	}
@@ -477,7 +536,7 @@ func TestRunnersWorkspaceService_DestroyWorkspaceResources(t *testing.T) {
 		TaskType: "destroy",
 	}
 
-	taskResp, err := client.RunnersWorkspaces.DestroyWorkspaceResources("test-workspace-id", destroyReq)
+	taskResp, err := client.RunnersWorkspaces().DestroyWorkspaceResources(context.Background(), "test-workspace-id", destroyReq)
 	if err != nil {
 		t.Fatalf("DestroyWorkspaceResources failed: %v", err)
 	}
@@ -491,6 +550,283 @@ func TestRunnersWorkspaceService_DestroyWorkspaceResources(t *testing.T) {
 	}
 }
 
+func TestRunnersWorkspaceService_GetTask(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// Mock login
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// Mock get task
+	mockServer.AddHandler("/v2/runners/workspaces/test-workspace-id/tasks/task-123", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TaskResponse{TaskID: "task-123", Status: TaskStatusCompleted})
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	task, err := client.RunnersWorkspaces().GetTask(context.Background(), "test-workspace-id", "task-123")
+	if err != nil {
+		t.Fatalf("GetTask failed: %v", err)
+	}
+
+	if task.Status != TaskStatusCompleted || !IsTerminalTaskStatus(task.Status) {
+		t.Errorf("Expected a completed task, got '%s'", task.Status)
+	}
+	if IsTerminalTaskStatus(TaskStatusRunning) {
+		t.Error("Expected a running task not to be terminal")
+	}
+}
+
+func TestRunnersWorkspaceService_ListTasks(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// Mock login
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// Mock list tasks
+	mockServer.AddHandler("/v2/runners/workspaces/test-workspace-id/tasks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]TaskResponse{
+			{TaskID: "task-2", Status: TaskStatusRunning, TaskType: "destroy"},
+			{TaskID: "task-1", Status: TaskStatusCompleted, TaskType: TaskTypeApply, RunID: "run-1"},
+		})
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	tasks, err := client.RunnersWorkspaces().ListTasks(context.Background(), "test-workspace-id")
+	if err != nil {
+		t.Fatalf("ListTasks failed: %v", err)
+	}
+
+	if len(tasks) != 2 {
+		t.Fatalf("Expected 2 tasks, got %d", len(tasks))
+	}
+	if tasks[0].TaskID != "task-2" || tasks[0].TaskType != "destroy" {
+		t.Errorf("Unexpected first task: %+v", tasks[0])
+	}
+	if tasks[1].RunID != "run-1" {
+		t.Errorf("Expected run ID 'run-1', got '%s'", tasks[1].RunID)
+	}
+}
+
+func TestTaskPoller(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// Mock login
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// The task completes on the third read
+	polls := 0
+	mockServer.AddHandler("/v2/runners/workspaces/test-workspace-id/tasks/task-123", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := TaskStatusRunning
+		if polls == 3 {
+			status = TaskStatusCompleted
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TaskResponse{TaskID: "task-123", Status: status, Logs: "Destroy complete!"})
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	task, err := WaitFor(context.Background(), TaskPoller(client.RunnersWorkspaces(), "test-workspace-id", "task-123"), fastWait)
+	if err != nil {
+		t.Fatalf("WaitFor failed: %v", err)
+	}
+	if task.Status != TaskStatusCompleted || polls != 3 {
+		t.Errorf("Expected a completed task after 3 polls, got %s after %d", task.Status, polls)
+	}
+	if task.Logs != "Destroy complete!" {
+		t.Errorf("Expected the task logs, got '%s'", task.Logs)
+	}
+}
+
+func TestRunnersWorkspaceService_RunWorkspaceTask(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// Mock login
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// Mock run task
+	mockServer.AddHandler("/v2/runners/workspaces/test-workspace-id/tasks/apply", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var taskReq RunTaskRequest
+		if err := json.NewDecoder(r.Body).Decode(&taskReq); err != nil {
+			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+			return
+		}
+		if taskReq.TaskType != TaskTypeApply {
+			http.Error(w, "Invalid task type", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TaskResponse{TaskID: "task-123", Status: "queued", RunID: "run-123"})
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	taskResp, err := client.RunnersWorkspaces().RunWorkspaceTask(context.Background(), "test-workspace-id", RunTaskRequest{TaskType: TaskTypeApply})
+	if err != nil {
+		t.Fatalf("RunWorkspaceTask failed: %v", err)
+	}
+
+	if taskResp.TaskID != "task-123" {
+		t.Errorf("Expected task ID 'task-123', got '%s'", taskResp.TaskID)
+	}
+	if taskResp.RunID != "run-123" {
+		t.Errorf("Expected run ID 'run-123', got '%s'", taskResp.RunID)
+	}
+
+	// Other task types have their own methods
+	if _, err := client.RunnersWorkspaces().RunWorkspaceTask(context.Background(), "test-workspace-id", RunTaskRequest{TaskType: "destroy"}); err == nil {
+		t.Error("Expected an error for the destroy task type")
+	}
+}
+
+func TestRunnersWorkspaceService_GetWorkspaceRun(t *testing.T) {
+	mockServer := NewMockServer()
+	defer mockServer.Close()
+
+	// Mock login
+	mockServer.AddHandler("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		authResp := AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()}
+		json.NewEncoder(w).Encode(authResp)
+	})
+
+	// Mock get run
+	mockServer.AddHandler("/v2/runners/workspaces/test-workspace-id/runs/run-123", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(WorkspaceRun{
+			ID:          "run-123",
+			WorkspaceID: "test-workspace-id",
+			RunID:       "run-123",
+			Status:      string(WorkspaceRunStatusApplySuccess),
+			CommitID:    "abc123",
+			BuildURL:    "https://ci.example.com/builds/1",
+		})
+	})
+
+	client, err := NewClient(Config{
+		AccessKey: "test-access",
+		SecretKey: "test-secret",
+		APIURL:    mockServer.URL(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	run, err := client.RunnersWorkspaces().GetWorkspaceRun(context.Background(), "test-workspace-id", "run-123")
+	if err != nil {
+		t.Fatalf("GetWorkspaceRun failed: %v", err)
+	}
+
+	if run.Status != string(WorkspaceRunStatusApplySuccess) {
+		t.Errorf("Expected status 'apply_success', got '%s'", run.Status)
+	}
+	if run.CommitID != "abc123" {
+		t.Errorf("Expected commit ID 'abc123', got '%s'", run.CommitID)
+	}
+	if run.BuildURL != "https://ci.example.com/builds/1" {
+		t.Errorf("Expected build URL 'https://ci.example.com/builds/1', got '%s'", run.BuildURL)
+	}
+}
+
+func TestIsTerminalRunStatus(t *testing.T) {
+	tests := []struct {
+		taskType string
+		status   WorkspaceRunStatusEnum
+		terminal bool
+		failed   bool
+	}{
+		{TaskTypePlan, WorkspaceRunStatusPlanning, false, false},
+		{TaskTypePlan, WorkspaceRunStatusPlanSuccess, true, false},
+		{TaskTypePlan, WorkspaceRunStatusPlanError, true, true},
+		{TaskTypeApply, WorkspaceRunStatusPlanSuccess, false, false},
+		{TaskTypeApply, WorkspaceRunStatusApplying, false, false},
+		{TaskTypeApply, WorkspaceRunStatusApplySuccess, true, false},
+		{TaskTypeApply, WorkspaceRunStatusApplyError, true, true},
+		{TaskTypeApply, WorkspaceRunStatusInitError, true, true},
+		{TaskTypeApply, WorkspaceRunStatusBlocked, true, false},
+		{TaskTypeApply, WorkspaceRunStatusPlanNoChanges, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.taskType+"/"+string(tt.status), func(t *testing.T) {
+			if got := IsTerminalRunStatus(tt.taskType, string(tt.status)); got != tt.terminal {
+				t.Errorf("IsTerminalRunStatus = %v, expected %v", got, tt.terminal)
+			}
+			if got := IsFailedRunStatus(string(tt.status)); got != tt.failed {
+				t.Errorf("IsFailedRunStatus = %v, expected %v", got, tt.failed)
+			}
+		})
+	}
+}
+
 func TestRunnersWorkspaceService_Error_NotFound(t *testing.T) {
 	mockServer := NewMockServer()
 	defer mockServer.Close()
@@ -516,7 +852,7 @@ func TestRunnersWorkspaceService_Error_NotFound(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	_, err = client.RunnersWorkspaces.GetRunnersWorkspace("nonexistent-workspace")
+	_, err = client.RunnersWorkspaces().GetRunnersWorkspace(context.Background(), "nonexistent-workspace")
 	if err == nil {
 		t.Error("Expected error for nonexistent workspace")
 	}
@@ -547,7 +883,7 @@ func TestRunnersWorkspaceService_Error_InvalidRequest(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	_, err = client.RunnersWorkspaces.CreateRunnersWorkspace(CreateRunnersWorkspaceRequest{})
+	_, err = client.RunnersWorkspaces().CreateRunnersWorkspace(context.Background(), CreateRunnersWorkspaceRequest{})
 	if err == nil {
 		t.Error("Expected error for invalid request")
 	}
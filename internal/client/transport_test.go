@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTLSTestServer starts a TLS server answering logins and project lookups
+func newTLSTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/login", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+	})
+	mux.HandleFunc("/v2/runners/projects/test-project-id", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Project{ID: "test-project-id"})
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+// withTransport makes the test client use an HTTP client built from config
+func withTransport(t *testing.T, config TransportConfig) func(*Config) {
+	t.Helper()
+
+	httpClient, err := NewHTTPClient(config)
+	if err != nil {
+		t.Fatalf("NewHTTPClient failed: %v", err)
+	}
+
+	return func(c *Config) {
+		c.HTTPClient = httpClient
+	}
+}
+
+func TestNewHTTPClient_CustomCA(t *testing.T) {
+	server := newTLSTestServer()
+	defer server.Close()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	client := newTestClient(t, server.URL, withTransport(t, TransportConfig{CACertPEM: caPEM}))
+	if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err != nil {
+		t.Fatalf("GetProject with custom CA failed: %v", err)
+	}
+
+	untrusted := newTestClient(t, server.URL, withTransport(t, TransportConfig{}))
+	if _, err := untrusted.Projects().GetProject(context.Background(), "test-project-id"); err == nil {
+		t.Fatal("Expected certificate verification to fail without the custom CA")
+	}
+}
+
+func TestNewHTTPClient_InsecureSkipVerify(t *testing.T) {
+	server := newTLSTestServer()
+	defer server.Close()
+
+	client := newTestClient(t, server.URL, withTransport(t, TransportConfig{InsecureSkipVerify: true}))
+	if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err != nil {
+		t.Fatalf("GetProject with insecure_skip_verify failed: %v", err)
+	}
+}
+
+func TestNewHTTPClient_Proxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A proxied request carries the absolute URL of the target
+		proxied = append(proxied, r.URL.String())
+		switch r.URL.Path {
+		case "/v2/login":
+			json.NewEncoder(w).Encode(AuthResponse{AccessToken: "test-token", ExpiresAt: time.Now().Add(time.Hour).Unix()})
+		default:
+			json.NewEncoder(w).Encode(Project{ID: "test-project-id"})
+		}
+	}))
+	defer proxy.Close()
+
+	client := newTestClient(t, "http://firefly.invalid", withTransport(t, TransportConfig{ProxyURL: proxy.URL}))
+	if _, err := client.Projects().GetProject(context.Background(), "test-project-id"); err != nil {
+		t.Fatalf("GetProject through proxy failed: %v", err)
+	}
+
+	if len(proxied) != 2 || !strings.HasPrefix(proxied[1], "http://firefly.invalid/") {
+		t.Errorf("Expected login and project requests to go through the proxy, got %v", proxied)
+	}
+}
+
+func TestNewHTTPClient_Timeout(t *testing.T) {
+	httpClient, err := NewHTTPClient(TransportConfig{})
+	if err != nil {
+		t.Fatalf("NewHTTPClient failed: %v", err)
+	}
+	if httpClient.Timeout != DefaultRequestTimeout {
+		t.Errorf("Expected default timeout %v, got %v", DefaultRequestTimeout, httpClient.Timeout)
+	}
+
+	httpClient, err = NewHTTPClient(TransportConfig{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf("NewHTTPClient failed: %v", err)
+	}
+	if httpClient.Timeout != 5*time.Second {
+		t.Errorf("Expected timeout 5s, got %v", httpClient.Timeout)
+	}
+}
+
+func TestNewHTTPClient_InvalidSettings(t *testing.T) {
+	tests := map[string]TransportConfig{
+		"proxy without scheme":       {ProxyURL: "proxy.internal:3128"},
+		"CA bundle without PEM":      {CACertPEM: []byte("not a certificate")},
+		"client certificate, no key": {ClientCertPEM: []byte("cert")},
+		"client key, no certificate": {ClientKeyPEM: []byte("key")},
+		"invalid client key pair":    {ClientCertPEM: []byte("cert"), ClientKeyPEM: []byte("key")},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewHTTPClient(config); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// UserService handles communication with the users related methods of the Firefly API
+type UserService struct {
+	client *Client
+}
+
+// User represents a user of the Firefly account
+type User struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"createdAt"`
+	LastLogin string `json:"lastLogin,omitempty"`
+}
+
+// ListUsersRequest filters the users of the account
+type ListUsersRequest struct {
+	// Email matches the users whose email contains it
+	Email string
+	// Name matches the users whose name contains it
+	Name string
+	// Role matches the users with exactly this account role
+	Role string
+}
+
+// UsersListResponse represents the response from listing users
+type UsersListResponse struct {
+	Data       []User `json:"data"`
+	TotalCount int    `json:"totalCount"`
+}
+
+// ListUsers retrieves a page of the users of the account matching the request
+func (s *UserService) ListUsers(ctx context.Context, request *ListUsersRequest, pageSize, offset int) (*UsersListResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Add("pageSize", strconv.Itoa(pageSize))
+	queryParams.Add("offset", strconv.Itoa(offset))
+	if request != nil {
+		if request.Email != "" {
+			queryParams.Add("email", request.Email)
+		}
+		if request.Name != "" {
+			queryParams.Add("name", request.Name)
+		}
+		if request.Role != "" {
+			queryParams.Add("role", request.Role)
+		}
+	}
+
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/v2/users?"+queryParams.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "list users")
+	}
+
+	// Parse the response
+	var usersResp UsersListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&usersResp); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &usersResp, nil
+}
+
+// AllUsers iterates over every user matching the request, fetching pages as needed
+func (s *UserService) AllUsers(ctx context.Context, request *ListUsersRequest) iter.Seq2[User, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]User, bool, error) {
+		offset := page * DefaultPageSize
+		usersResp, err := s.ListUsers(ctx, request, DefaultPageSize, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		return usersResp.Data, offset+len(usersResp.Data) < usersResp.TotalCount, nil
+	})
+}
+
+// GetUser retrieves a user by ID
+func (s *UserService) GetUser(ctx context.Context, id string) (*User, error) {
+	// Create the request
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/users/%s", url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the request
+	resp, err := s.client.doRequest(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Handle non-200 responses
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIError(resp, "get user")
+	}
+
+	// Parse the response
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetUserByEmail retrieves the user with the given email, compared case-insensitively.
+// It returns an error wrapping ErrNotFound when no user of the account has this email.
+func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	user, err := Find(s.AllUsers(ctx, &ListUsersRequest{Email: email}), func(u User) bool {
+		return strings.EqualFold(u.Email, email)
+	})
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, fmt.Errorf("user with email %s: %w", email, ErrNotFound)
+		}
+		return nil, err
+	}
+	return user, nil
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// newUsersTestClient returns a client for a mock server listing users
+func newUsersTestClient(t *testing.T, users []User) *Client {
+	t.Helper()
+
+	mockServer := NewMockServer()
+	t.Cleanup(mockServer.Close)
+
+	mockServer.AddLoginHandler()
+
+	// Mock list users, filtered by role
+	mockServer.AddHandler("/v2/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if r.URL.Query().Get("pageSize") == "" || r.URL.Query().Get("offset") == "" {
+			http.Error(w, "Missing pagination", http.StatusBadRequest)
+			return
+		}
+
+		role := r.URL.Query().Get("role")
+		matching := []User{}
+		for _, user := range users {
+			if role == "" || user.Role == role {
+				matching = append(matching, user)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(UsersListResponse{Data: matching, TotalCount: len(matching)})
+	})
+
+	// Mock get user
+	mockServer.AddHandler("/v2/users/user-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(users[0])
+	})
+
+	return newTestClient(t, mockServer.URL())
+}
+
+var testUsers = []User{
+	{ID: "user-1", Email: "alice@example.com", Name: "Alice", Role: "admin", Status: "active"},
+	{ID: "user-2", Email: "bob@example.com", Name: "Bob", Role: "viewer", Status: "active"},
+}
+
+func TestUserService_ListUsers(t *testing.T) {
+	client := newUsersTestClient(t, testUsers)
+
+	resp, err := client.Users().ListUsers(context.Background(), &ListUsersRequest{Role: "viewer"}, 10, 0)
+	if err != nil {
+		t.Fatalf("ListUsers failed: %v", err)
+	}
+	if resp.TotalCount != 1 || len(resp.Data) != 1 || resp.Data[0].ID != "user-2" {
+		t.Errorf("Unexpected users %+v", resp)
+	}
+}
+
+func TestUserService_GetUser(t *testing.T) {
+	client := newUsersTestClient(t, testUsers)
+
+	user, err := client.Users().GetUser(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if user.Email != "alice@example.com" || user.Role != "admin" {
+		t.Errorf("Unexpected user %+v", user)
+	}
+
+	if _, err := client.Users().GetUser(context.Background(), "unknown"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestUserService_GetUserByEmail(t *testing.T) {
+	client := newUsersTestClient(t, testUsers)
+
+	// The mock ignores the email filter, the match is checked by the client
+	user, err := client.Users().GetUserByEmail(context.Background(), "BOB@example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail failed: %v", err)
+	}
+	if user.ID != "user-2" {
+		t.Errorf("Expected user-2, got %s", user.ID)
+	}
+
+	if _, err := client.Users().GetUserByEmail(context.Background(), "carol@example.com"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
@@ -1,9 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 )
 
@@ -58,9 +59,9 @@ type DeleteVariablesRequest struct {
 }
 
 // CreateVariableSet creates a new variable set
-func (s *VariableSetService) CreateVariableSet(req CreateVariableSetRequest) (*CreateVariableSetResponse, error) {
+func (s *VariableSetService) CreateVariableSet(ctx context.Context, req CreateVariableSetRequest) (*CreateVariableSetResponse, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPost, "/v2/runners/variables/variable-sets", req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/v2/runners/variables/variable-sets", req)
 	if err != nil {
 		return nil, err
 	}
@@ -74,8 +75,7 @@ func (s *VariableSetService) CreateVariableSet(req CreateVariableSetRequest) (*C
 
 	// Handle non-201 responses
 	if resp.StatusCode != http.StatusCreated {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create variable set: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "create variable set")
 	}
 
 	// Parse the response
@@ -88,9 +88,9 @@ func (s *VariableSetService) CreateVariableSet(req CreateVariableSetRequest) (*C
 }
 
 // GetVariableSet retrieves a variable set by ID
-func (s *VariableSetService) GetVariableSet(id string) (*VariableSet, error) {
+func (s *VariableSetService) GetVariableSet(ctx context.Context, id string) (*VariableSet, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodGet, fmt.Sprintf("/v2/runners/variables/variable-sets/%s", id), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("/v2/runners/variables/variable-sets/%s", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -104,8 +104,7 @@ func (s *VariableSetService) GetVariableSet(id string) (*VariableSet, error) {
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get variable set: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "get variable set")
 	}
 
 	// Parse the response
@@ -118,9 +117,9 @@ func (s *VariableSetService) GetVariableSet(id string) (*VariableSet, error) {
 }
 
 // UpdateVariableSet updates an existing variable set
-func (s *VariableSetService) UpdateVariableSet(id string, req UpdateVariableSetRequest) (*VariableSet, error) {
+func (s *VariableSetService) UpdateVariableSet(ctx context.Context, id string, req UpdateVariableSetRequest) (*VariableSet, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPut, fmt.Sprintf("/v2/runners/variables/variable-sets/%s", id), req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("/v2/runners/variables/variable-sets/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -134,19 +133,18 @@ func (s *VariableSetService) UpdateVariableSet(id string, req UpdateVariableSetR
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update variable set: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "update variable set")
 	}
 
 	// The update API returns an empty string on success
 	// We need to fetch the updated variable set
-	return s.GetVariableSet(id)
+	return s.GetVariableSet(ctx, id)
 }
 
 // DeleteVariableSet deletes a variable set
-func (s *VariableSetService) DeleteVariableSet(id string) error {
+func (s *VariableSetService) DeleteVariableSet(ctx context.Context, id string) error {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/v2/runners/variables/variable-sets/%s", id), nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/runners/variables/variable-sets/%s", id), nil)
 	if err != nil {
 		return err
 	}
@@ -160,17 +158,16 @@ func (s *VariableSetService) DeleteVariableSet(id string) error {
 
 	// Handle non-204 responses
 	if resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete variable set: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return newAPIError(resp, "delete variable set")
 	}
 
 	return nil
 }
 
 // UpsertVariablesInSet creates or updates variables within a variable set
-func (s *VariableSetService) UpsertVariablesInSet(id string, req UpsertVariableSetVariablesRequest) ([]Variable, error) {
+func (s *VariableSetService) UpsertVariablesInSet(ctx context.Context, id string, req UpsertVariableSetVariablesRequest) ([]Variable, error) {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodPost, fmt.Sprintf("/v2/runners/variables/variable-sets/%s/variables", id), req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/runners/variables/variable-sets/%s/variables", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -184,8 +181,7 @@ func (s *VariableSetService) UpsertVariablesInSet(id string, req UpsertVariableS
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to upsert variables in set: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "upsert variables in set")
 	}
 
 	// Parse the response
@@ -198,9 +194,9 @@ func (s *VariableSetService) UpsertVariablesInSet(id string, req UpsertVariableS
 }
 
 // DeleteVariablesFromSet deletes variables from a variable set
-func (s *VariableSetService) DeleteVariablesFromSet(id string, req DeleteVariablesRequest) error {
+func (s *VariableSetService) DeleteVariablesFromSet(ctx context.Context, id string, req DeleteVariablesRequest) error {
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/v2/runners/variables/variable-sets/%s/variables", id), req)
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/v2/runners/variables/variable-sets/%s/variables", id), req)
 	if err != nil {
 		return err
 	}
@@ -214,15 +210,14 @@ func (s *VariableSetService) DeleteVariablesFromSet(id string, req DeleteVariabl
 
 	// Handle non-204 responses
 	if resp.StatusCode != http.StatusNoContent {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete variables from set: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return newAPIError(resp, "delete variables from set")
 	}
 
 	return nil
 }
 
 // ListVariableSets retrieves all variable sets with pagination support
-func (s *VariableSetService) ListVariableSets(pageSize, offset int, searchQuery string) ([]VariableSet, error) {
+func (s *VariableSetService) ListVariableSets(ctx context.Context, pageSize, offset int, searchQuery string) ([]VariableSet, error) {
 	// Create the request URL with query parameters
 	url := fmt.Sprintf("/v2/runners/variables/variable-sets?pageSize=%d&offset=%d", pageSize, offset)
 	if searchQuery != "" {
@@ -230,7 +225,7 @@ func (s *VariableSetService) ListVariableSets(pageSize, offset int, searchQuery
 	}
 
 	// Create the request
-	httpReq, err := s.client.newRequest(http.MethodGet, url, nil)
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +239,7 @@ func (s *VariableSetService) ListVariableSets(pageSize, offset int, searchQuery
 
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list variable sets: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "list variable sets")
 	}
 
 	// Parse the response
@@ -255,4 +249,12 @@ func (s *VariableSetService) ListVariableSets(pageSize, offset int, searchQuery
 	}
 
 	return variableSets, nil
-}
\ No newline at end of file
+}
+
+// AllVariableSets iterates over every variable set matching the search query, fetching pages as needed
+func (s *VariableSetService) AllVariableSets(ctx context.Context, searchQuery string) iter.Seq2[VariableSet, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]VariableSet, bool, error) {
+		variableSets, err := s.ListVariableSets(ctx, DefaultPageSize, page*DefaultPageSize, searchQuery)
+		return variableSets, len(variableSets) == DefaultPageSize, err
+	})
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -35,7 +36,7 @@ func TestVariableSetService_ListVariableSets(t *testing.T) {
 			},
 			{
 				ID:          "varset-2",
-				Name:        "Database Configuration", 
+				Name:        "Database Configuration",
 				Description: "Database connection variables",
 				Labels:      []string{"database", "config"},
 				Parents:     []string{"varset-1"},
@@ -57,7 +58,7 @@ func TestVariableSetService_ListVariableSets(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	variableSets, err := client.VariableSets.ListVariableSets(10, 0, "")
+	variableSets, err := client.VariableSets().ListVariableSets(context.Background(), 10, 0, "")
 	if err != nil {
 		t.Fatalf("ListVariableSets failed: %v", err)
 	}
@@ -121,7 +122,7 @@ func TestVariableSetService_GetVariableSet(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	variableSet, err := client.VariableSets.GetVariableSet("test-varset-id")
+	variableSet, err := client.VariableSets().GetVariableSet(context.Background(), "test-varset-id")
 	if err != nil {
 		t.Fatalf("GetVariableSet failed: %v", err)
 	}
@@ -213,7 +214,7 @@ func TestVariableSetService_CreateVariableSet(t *testing.T) {
 		},
 	}
 
-	variableSet, err := client.VariableSets.CreateVariableSet(createReq)
+	variableSet, err := client.VariableSets().CreateVariableSet(context.Background(), createReq)
 	if err != nil {
 		t.Fatalf("CreateVariableSet failed: %v", err)
 	}
@@ -244,7 +245,7 @@ func TestVariableSetService_UpdateVariableSet(t *testing.T) {
 			variableSet := VariableSet{
 				ID:          "test-varset-id",
 				Name:        "Updated Variable Set",
-				Description: "Updated variable set description", 
+				Description: "Updated variable set description",
 				Labels:      []string{"updated", "test"},
 				Parents:     []string{"new-parent-varset"},
 				Version:     2, // Version incremented
@@ -281,7 +282,7 @@ func TestVariableSetService_UpdateVariableSet(t *testing.T) {
 		},
 	}
 
-	variableSet, err := client.VariableSets.UpdateVariableSet("test-varset-id", updateReq)
+	variableSet, err := client.VariableSets().UpdateVariableSet(context.Background(), "test-varset-id", updateReq)
 	if err != nil {
 		t.Fatalf("UpdateVariableSet failed: %v", err)
 	}
@@ -335,8 +336,8 @@ func TestVariableSetService_DeleteVariableSet(t *testing.T) {
 		t.Fatalf("Failed to create client: %v", err)
 	}
 
-	err = client.VariableSets.DeleteVariableSet("test-varset-id")
+	err = client.VariableSets().DeleteVariableSet(context.Background(), "test-varset-id")
 	if err != nil {
 		t.Fatalf("DeleteVariableSet failed: %v", err)
 	}
-}
\ No newline at end of file
+}
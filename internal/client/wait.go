@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultWaitInitialInterval is the first delay between two polls of WaitFor
+	DefaultWaitInitialInterval = 2 * time.Second
+	// DefaultWaitMaxInterval is the upper bound for the delay between two polls of WaitFor
+	DefaultWaitMaxInterval = 30 * time.Second
+	// DefaultWaitMultiplier is the factor applied to the delay after every poll of WaitFor
+	DefaultWaitMultiplier = 1.5
+)
+
+// ErrWaitTimeout is returned by WaitFor when the operation didn't reach a terminal state in time
+var ErrWaitTimeout = errors.New("timed out waiting for the operation to finish")
+
+// WaitOptions controls how WaitFor polls an operation. Zero values use the defaults.
+type WaitOptions struct {
+	// Timeout bounds the whole wait, zero only relies on the deadline of the context
+	Timeout time.Duration
+	// InitialInterval is the first delay between two polls, defaults to DefaultWaitInitialInterval
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between two polls, defaults to DefaultWaitMaxInterval
+	MaxInterval time.Duration
+	// Multiplier grows the delay after every poll, defaults to DefaultWaitMultiplier
+	Multiplier float64
+}
+
+// withDefaults returns the options with the zero values replaced by the defaults
+func (o WaitOptions) withDefaults() WaitOptions {
+	if o.InitialInterval <= 0 {
+		o.InitialInterval = DefaultWaitInitialInterval
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = DefaultWaitMaxInterval
+	}
+	if o.InitialInterval > o.MaxInterval {
+		o.InitialInterval = o.MaxInterval
+	}
+	if o.Multiplier < 1 {
+		o.Multiplier = DefaultWaitMultiplier
+	}
+	return o
+}
+
+// PollFunc reads the current state of a long-running operation and reports whether it is terminal
+type PollFunc[T any] func(ctx context.Context) (value T, done bool, err error)
+
+// WaitFor calls poll until it reports a terminal state, waiting longer between two calls every
+// time. It stops on the first error returned by poll, and returns an error wrapping ErrWaitTimeout
+// when the timeout or the context deadline expires first. The last value read is always returned,
+// so callers can report how far the operation got.
+func WaitFor[T any](ctx context.Context, poll PollFunc[T], opts WaitOptions) (T, error) {
+	opts = opts.withDefaults()
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	var last T
+	interval := opts.InitialInterval
+	for attempt := 1; ; attempt++ {
+		value, done, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, waitTimeoutError(ctx)
+			}
+			return last, err
+		}
+		last = value
+		if done {
+			return value, nil
+		}
+
+		tflog.Debug(ctx, "Operation not finished, polling again", map[string]interface{}{
+			"attempt": attempt,
+			"wait":    interval.String(),
+		})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, waitTimeoutError(ctx)
+		case <-timer.C:
+		}
+
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// waitTimeoutError returns the error of a wait stopped because ctx is done
+func waitTimeoutError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return ctx.Err()
+	}
+	return fmt.Errorf("%w: %w", ErrWaitTimeout, ctx.Err())
+}
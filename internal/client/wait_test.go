@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fastWait polls every millisecond
+var fastWait = WaitOptions{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+func TestWaitFor_Done(t *testing.T) {
+	polls := 0
+	value, err := WaitFor(context.Background(), func(ctx context.Context) (int, bool, error) {
+		polls++
+		return polls, polls == 3, nil
+	}, fastWait)
+	if err != nil {
+		t.Fatalf("WaitFor failed: %v", err)
+	}
+	if value != 3 || polls != 3 {
+		t.Errorf("Expected 3 polls, got value %d after %d polls", value, polls)
+	}
+}
+
+func TestWaitFor_Error(t *testing.T) {
+	pollErr := errors.New("boom")
+	polls := 0
+	value, err := WaitFor(context.Background(), func(ctx context.Context) (int, bool, error) {
+		polls++
+		if polls == 2 {
+			return 0, false, pollErr
+		}
+		return polls, false, nil
+	}, fastWait)
+	if !errors.Is(err, pollErr) {
+		t.Errorf("Expected the poll error, got %v", err)
+	}
+	if value != 1 {
+		t.Errorf("Expected the last value read, got %d", value)
+	}
+	if polls != 2 {
+		t.Errorf("Expected to stop after 2 polls, got %d", polls)
+	}
+}
+
+func TestWaitFor_Timeout(t *testing.T) {
+	opts := fastWait
+	opts.Timeout = 20 * time.Millisecond
+
+	value, err := WaitFor(context.Background(), func(ctx context.Context) (string, bool, error) {
+		return "running", false, nil
+	}, opts)
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Fatalf("Expected ErrWaitTimeout, got %v", err)
+	}
+	if value != "running" {
+		t.Errorf("Expected the last value read, got %q", value)
+	}
+}
+
+func TestWaitFor_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := WaitFor(ctx, func(ctx context.Context) (int, bool, error) {
+		cancel()
+		return 0, false, nil
+	}, fastWait)
+	if !errors.Is(err, context.Canceled) || errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestWaitFor_ExponentialInterval(t *testing.T) {
+	opts := WaitOptions{InitialInterval: 2 * time.Millisecond, MaxInterval: 8 * time.Millisecond, Multiplier: 2}
+
+	var times []time.Time
+	_, err := WaitFor(context.Background(), func(ctx context.Context) (int, bool, error) {
+		times = append(times, time.Now())
+		return 0, len(times) == 5, nil
+	}, opts)
+	if err != nil {
+		t.Fatalf("WaitFor failed: %v", err)
+	}
+
+	// The delays are 2, 4, 8 and 8 milliseconds
+	minimum := []time.Duration{2, 4, 8, 8}
+	for i, expected := range minimum {
+		if elapsed := times[i+1].Sub(times[i]); elapsed < expected*time.Millisecond {
+			t.Errorf("Expected poll %d to wait at least %dms, waited %s", i+2, expected, elapsed)
+		}
+	}
+}
+
+func TestWaitOptions_Defaults(t *testing.T) {
+	opts := WaitOptions{}.withDefaults()
+	if opts.InitialInterval != DefaultWaitInitialInterval || opts.MaxInterval != DefaultWaitMaxInterval || opts.Multiplier != DefaultWaitMultiplier {
+		t.Errorf("Unexpected defaults: %+v", opts)
+	}
+
+	capped := WaitOptions{InitialInterval: time.Minute, MaxInterval: time.Second}.withDefaults()
+	if capped.InitialInterval != time.Second {
+		t.Errorf("Expected the initial interval to be capped, got %s", capped.InitialInterval)
+	}
+}
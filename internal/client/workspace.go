@@ -1,9 +1,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -119,17 +120,20 @@ type DeleteWorkspaceResponse struct {
 }
 
 // ListWorkspaces retrieves workspaces from Firefly
-func (s *WorkspaceService) ListWorkspaces(request *ListWorkspacesRequest, page, pageSize int) ([]Workspace, error) {
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context, request *ListWorkspacesRequest, page, pageSize int) ([]Workspace, error) {
 	// Construct query parameters
 	queryParams := url.Values{}
 	queryParams.Add("page", strconv.Itoa(page))
 	queryParams.Add("pageSize", strconv.Itoa(pageSize))
 	
 	// Create the request
-	req, err := s.client.newRequest(http.MethodPost, fmt.Sprintf("/workspaces/search?%s", queryParams.Encode()), request)
+	req, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/workspaces/search?%s", queryParams.Encode()), request)
 	if err != nil {
 		return nil, err
 	}
+
+	// Searching has no side effects, so the request is safe to retry
+	req = markRetryable(req)
 	
 	// Execute the request
 	resp, err := s.client.doRequest(req)
@@ -140,8 +144,7 @@ func (s *WorkspaceService) ListWorkspaces(request *ListWorkspacesRequest, page,
 	
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list workspaces: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "list workspaces")
 	}
 	
 	// Parse the response
@@ -153,10 +156,18 @@ func (s *WorkspaceService) ListWorkspaces(request *ListWorkspacesRequest, page,
 	return workspaces, nil
 }
 
+// AllWorkspaces iterates over every workspace matching the request, fetching pages as needed
+func (s *WorkspaceService) AllWorkspaces(ctx context.Context, request *ListWorkspacesRequest) iter.Seq2[Workspace, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]Workspace, bool, error) {
+		workspaces, err := s.ListWorkspaces(ctx, request, page, DefaultPageSize)
+		return workspaces, len(workspaces) == DefaultPageSize, err
+	})
+}
+
 // DeleteWorkspace deletes a workspace by ID
-func (s *WorkspaceService) DeleteWorkspace(workspaceID string) (*DeleteWorkspaceResponse, error) {
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, workspaceID string) (*DeleteWorkspaceResponse, error) {
 	// Create the request
-	req, err := s.client.newRequest(http.MethodDelete, fmt.Sprintf("/workspaces/%s", workspaceID), nil)
+	req, err := s.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("/workspaces/%s", workspaceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -170,8 +181,7 @@ func (s *WorkspaceService) DeleteWorkspace(workspaceID string) (*DeleteWorkspace
 	
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to delete workspace: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "delete workspace")
 	}
 	
 	// Parse the response
@@ -184,14 +194,14 @@ func (s *WorkspaceService) DeleteWorkspace(workspaceID string) (*DeleteWorkspace
 }
 
 // UpdateWorkspaceLabels updates the labels for a workspace
-func (s *WorkspaceService) UpdateWorkspaceLabels(workspaceID string, labels []string) (*UpdateWorkspaceLabelsResponse, error) {
+func (s *WorkspaceService) UpdateWorkspaceLabels(ctx context.Context, workspaceID string, labels []string) (*UpdateWorkspaceLabelsResponse, error) {
 	// Create the request body
 	reqBody := UpdateWorkspaceLabelsRequest{
 		Labels: labels,
 	}
 	
 	// Create the request
-	req, err := s.client.newRequest(http.MethodPut, fmt.Sprintf("/workspaces/%s/labels", workspaceID), reqBody)
+	req, err := s.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("/workspaces/%s/labels", workspaceID), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -205,8 +215,7 @@ func (s *WorkspaceService) UpdateWorkspaceLabels(workspaceID string, labels []st
 	
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update workspace labels: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "update workspace labels")
 	}
 	
 	// Parse the response
@@ -219,18 +228,21 @@ func (s *WorkspaceService) UpdateWorkspaceLabels(workspaceID string, labels []st
 }
 
 // ListWorkspaceRuns retrieves runs for a workspace
-func (s *WorkspaceService) ListWorkspaceRuns(workspaceID string, request *ListWorkspaceRunsRequest, page, pageSize int) ([]WorkspaceRun, error) {
+func (s *WorkspaceService) ListWorkspaceRuns(ctx context.Context, workspaceID string, request *ListWorkspaceRunsRequest, page, pageSize int) ([]WorkspaceRun, error) {
 	// Construct query parameters
 	queryParams := url.Values{}
 	queryParams.Add("page", strconv.Itoa(page))
 	queryParams.Add("pageSize", strconv.Itoa(pageSize))
 	
 	// Create the request
-	req, err := s.client.newRequest(http.MethodPost, fmt.Sprintf("/workspaces/%s/runs/search?%s", workspaceID, queryParams.Encode()), request)
+	req, err := s.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("/workspaces/%s/runs/search?%s", workspaceID, queryParams.Encode()), request)
 	if err != nil {
 		return nil, err
 	}
 	
+	// Searching has no side effects, so the request is safe to retry
+	req = markRetryable(req)
+	
 	// Execute the request
 	resp, err := s.client.doRequest(req)
 	if err != nil {
@@ -240,8 +252,7 @@ func (s *WorkspaceService) ListWorkspaceRuns(workspaceID string, request *ListWo
 	
 	// Handle non-200 responses
 	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to list workspace runs: %s (status code: %d)", string(bodyBytes), resp.StatusCode)
+		return nil, newAPIError(resp, "list workspace runs")
 	}
 	
 	// Parse the response
@@ -252,3 +263,11 @@ func (s *WorkspaceService) ListWorkspaceRuns(workspaceID string, request *ListWo
 	
 	return runs, nil
 }
+
+// AllWorkspaceRuns iterates over every run of a workspace matching the request, fetching pages as needed
+func (s *WorkspaceService) AllWorkspaceRuns(ctx context.Context, workspaceID string, request *ListWorkspaceRunsRequest) iter.Seq2[WorkspaceRun, error] {
+	return paginate(ctx, func(ctx context.Context, page int) ([]WorkspaceRun, bool, error) {
+		runs, err := s.ListWorkspaceRuns(ctx, workspaceID, request, page, DefaultPageSize)
+		return runs, len(runs) == DefaultPageSize, err
+	})
+}
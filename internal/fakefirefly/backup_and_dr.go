@@ -0,0 +1,208 @@
+package fakefirefly
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerBackupAndDrRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/backup-and-dr/policies", s.createBackupPolicy)
+	mux.HandleFunc("GET /v2/backup-and-dr/policies", s.listBackupPolicies)
+	mux.HandleFunc("GET /v2/backup-and-dr/policies/{id}", s.getBackupPolicy)
+	mux.HandleFunc("PUT /v2/backup-and-dr/policies/{id}", s.updateBackupPolicy)
+	mux.HandleFunc("DELETE /v2/backup-and-dr/policies/{id}", s.deleteBackupPolicy)
+}
+
+func (s *Server) createBackupPolicy(w http.ResponseWriter, r *http.Request) {
+	var req client.PolicyCreateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.PolicyName == "" || req.IntegrationID == "" || req.Region == "" || req.ProviderType == "" {
+		writeError(w, http.StatusBadRequest, "policy_name, integration_id, region and provider_type are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy := client.PolicyResponse{
+		PolicyID:            s.newID(),
+		AccountID:           DefaultAccountID,
+		PolicyName:          req.PolicyName,
+		IntegrationID:       req.IntegrationID,
+		Region:              req.Region,
+		ProviderType:        req.ProviderType,
+		Frequency:           req.Frequency,
+		Description:         req.Description,
+		Scope:               req.Scope,
+		NotificationID:      req.NotificationID,
+		VCS:                 req.VCS,
+		RestoreInstructions: req.RestoreInstructions,
+		Status:              "Active",
+		CreatedAt:           now(),
+		TargetAccount:       req.TargetAccount,
+		TargetRegion:        req.TargetRegion,
+		AutoCreatePR:        req.AutoCreatePR,
+		ResilienceEnabled:   req.ResilienceEnabled,
+	}
+	policy.UpdatedAt = policy.CreatedAt
+	s.backupPolicies.put(policy.PolicyID, policy)
+
+	writeJSON(w, http.StatusCreated, policy)
+}
+
+func (s *Server) getBackupPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, ok := s.backupPolicies.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup policy not found")
+		return
+	}
+	policy = s.completeRunningBackup(policy)
+
+	writeJSON(w, http.StatusOK, policy)
+}
+
+// updateBackupPolicy applies the fields set in the request
+func (s *Server) updateBackupPolicy(w http.ResponseWriter, r *http.Request) {
+	var req client.PolicyUpdateRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, ok := s.backupPolicies.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup policy not found")
+		return
+	}
+
+	setIfNotNil(&policy.PolicyName, req.PolicyName)
+	setIfNotNil(&policy.IntegrationID, req.IntegrationID)
+	setIfNotNil(&policy.Region, req.Region)
+	setIfNotNil(&policy.ProviderType, req.ProviderType)
+	setIfNotNil(&policy.Frequency, req.Frequency)
+	setIfNotNil(&policy.Description, req.Description)
+	setIfNotNil(&policy.NotificationID, req.NotificationID)
+	setIfNotNil(&policy.RestoreInstructions, req.RestoreInstructions)
+	setIfNotNil(&policy.TargetAccount, req.TargetAccount)
+	setIfNotNil(&policy.TargetRegion, req.TargetRegion)
+	setIfNotNil(&policy.AutoCreatePR, req.AutoCreatePR)
+	setIfNotNil(&policy.ResilienceEnabled, req.ResilienceEnabled)
+	if req.Scope != nil {
+		policy.Scope = req.Scope
+	}
+	if req.VCS != nil {
+		policy.VCS = req.VCS
+	}
+	policy.UpdatedAt = now()
+	s.backupPolicies.put(policy.PolicyID, policy)
+
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (s *Server) deleteBackupPolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.backupPolicies.delete(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "backup policy not found")
+		return
+	}
+	delete(s.backupSnapshots, r.PathValue("id"))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listBackupPolicies serves the one-based page list, filtered by the query parameters
+func (s *Server) listBackupPolicies(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page := queryInt(r, "page", 1)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := queryInt(r, "page_size", 20)
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policies := s.backupPolicies.list(func(p client.PolicyResponse) bool {
+		return matchesFilter(query.Get("status"), p.Status) &&
+			matchesFilter(query.Get("integration_id"), p.IntegrationID) &&
+			matchesFilter(query.Get("region"), p.Region) &&
+			matchesFilter(query.Get("provider_type"), p.ProviderType)
+	})
+
+	writeJSON(w, http.StatusOK, client.PolicyListResponse{
+		Data: pageOf(policies, (page-1)*pageSize, pageSize),
+		Pagination: client.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+			Total:    len(policies),
+			HasNext:  page*pageSize < len(policies),
+			HasPrev:  page > 1,
+		},
+		Facets: backupPolicyFacets(policies),
+	})
+}
+
+// backupPolicyFacetFields are the fields of the policies counted in the facets of the list
+var backupPolicyFacetFields = []struct {
+	name  string
+	value func(client.PolicyResponse) string
+}{
+	{"status", func(p client.PolicyResponse) string { return p.Status }},
+	{"region", func(p client.PolicyResponse) string { return p.Region }},
+	{"provider_type", func(p client.PolicyResponse) string { return p.ProviderType }},
+	{"integration_id", func(p client.PolicyResponse) string { return p.IntegrationID }},
+}
+
+// backupPolicyFacets counts the values of the facet fields of policies, most frequent first
+func backupPolicyFacets(policies []client.PolicyResponse) []client.Facet {
+	facets := make([]client.Facet, 0, len(backupPolicyFacetFields))
+	for _, field := range backupPolicyFacetFields {
+		counts := make(map[string]int)
+		for _, policy := range policies {
+			counts[field.value(policy)]++
+		}
+
+		values := make([]client.FacetValue, 0, len(counts))
+		for value, count := range counts {
+			values = append(values, client.FacetValue{Value: value, Count: count})
+		}
+		slices.SortFunc(values, func(a, b client.FacetValue) int {
+			return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+		})
+
+		facets = append(facets, client.Facet{
+			Field:      field.name,
+			Size:       len(values),
+			Pagination: client.Pagination{Page: 1, PageSize: len(values), Total: len(values)},
+			Values:     values,
+		})
+	}
+	return facets
+}
+
+// matchesFilter reports whether value matches an optional filter
+func matchesFilter(filter, value string) bool {
+	return filter == "" || filter == value
+}
+
+// setIfNotNil copies *value into field when value is set
+func setIfNotNil[T any](field *T, value *T) {
+	if value != nil {
+		*field = *value
+	}
+}
@@ -0,0 +1,97 @@
+package fakefirefly
+
+import (
+	"net/http"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerBackupRestoreRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/backup-and-dr/policies/{id}/restores", s.startBackupRestore)
+	mux.HandleFunc("GET /v2/backup-and-dr/policies/{id}/restores/{restoreId}", s.getBackupRestore)
+}
+
+// restoreStatusSteps lists the statuses a restore goes through, one per poll
+var restoreStatusSteps = []string{client.RestoreStatusPending, client.RestoreStatusRunning, client.RestoreStatusCompleted}
+
+func (s *Server) startBackupRestore(w http.ResponseWriter, r *http.Request) {
+	var req client.RestoreRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.SnapshotID == "" {
+		writeError(w, http.StatusBadRequest, "snapshot_id is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, ok := s.backupPolicies.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup policy not found")
+		return
+	}
+	if _, ok := s.findBackupSnapshot(policy.PolicyID, req.SnapshotID); !ok {
+		writeError(w, http.StatusNotFound, "snapshot not found")
+		return
+	}
+
+	// The restore lands where the policy says unless the request overrides it
+	restore := client.Restore{
+		RestoreID:     s.newID(),
+		PolicyID:      policy.PolicyID,
+		SnapshotID:    req.SnapshotID,
+		TargetAccount: req.TargetAccount,
+		TargetRegion:  req.TargetRegion,
+		AutoCreatePR:  policy.AutoCreatePR,
+		Status:        restoreStatusSteps[0],
+		CreatedAt:     now(),
+	}
+	if restore.TargetAccount == "" {
+		restore.TargetAccount = policy.TargetAccount
+	}
+	if restore.TargetAccount == "" {
+		restore.TargetAccount = policy.IntegrationID
+	}
+	if restore.TargetRegion == "" {
+		restore.TargetRegion = policy.TargetRegion
+	}
+	if restore.TargetRegion == "" {
+		restore.TargetRegion = policy.Region
+	}
+	if req.AutoCreatePR != nil {
+		restore.AutoCreatePR = *req.AutoCreatePR
+	}
+	s.backupRestores.put(restore.RestoreID, restore)
+
+	writeJSON(w, http.StatusCreated, restore)
+}
+
+// getBackupRestore serves a restore, moving it to its next status
+func (s *Server) getBackupRestore(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	restore, ok := s.backupRestores.get(r.PathValue("restoreId"))
+	if !ok || restore.PolicyID != r.PathValue("id") {
+		writeError(w, http.StatusNotFound, "restore not found")
+		return
+	}
+
+	for i, status := range restoreStatusSteps[:len(restoreStatusSteps)-1] {
+		if restore.Status == status {
+			restore.Status = restoreStatusSteps[i+1]
+			break
+		}
+	}
+	if restore.Status == client.RestoreStatusCompleted && restore.CompletedAt == "" {
+		restore.CompletedAt = now()
+		if restore.AutoCreatePR {
+			restore.PullRequestURL = "https://github.com/firefly-restores/infrastructure/pull/" + restore.RestoreID
+		}
+	}
+	s.backupRestores.put(restore.RestoreID, restore)
+
+	writeJSON(w, http.StatusOK, restore)
+}
@@ -0,0 +1,282 @@
+package fakefirefly
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+// SnapshotResource is a resource backed up in a snapshot, compared by ID between snapshots
+type SnapshotResource struct {
+	ID         string
+	Type       string
+	Name       string
+	Region     string
+	Attributes map[string]string
+}
+
+// backupSnapshot is a snapshot with the resources it backed up
+type backupSnapshot struct {
+	client.Snapshot
+	resources []SnapshotResource
+}
+
+func (s *Server) registerBackupSnapshotRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v2/backup-and-dr/policies/{id}/snapshots", s.listBackupSnapshots)
+	mux.HandleFunc("GET /v2/backup-and-dr/policies/{id}/snapshots/{snapshotId}/diff", s.diffBackupSnapshots)
+	mux.HandleFunc("POST /v2/backup-and-dr/policies/{id}/backup", s.triggerBackup)
+}
+
+// AddBackupPolicy stores a backup policy, as if created in the UI, and returns its ID. An ID is
+// generated when policy.PolicyID is empty, and the status defaults to Active.
+func (s *Server) AddBackupPolicy(policy client.PolicyResponse) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if policy.PolicyID == "" {
+		policy.PolicyID = s.newID()
+	}
+	if policy.AccountID == "" {
+		policy.AccountID = DefaultAccountID
+	}
+	if policy.Status == "" {
+		policy.Status = "Active"
+	}
+	if policy.CreatedAt == "" {
+		policy.CreatedAt = now()
+		policy.UpdatedAt = policy.CreatedAt
+	}
+	s.backupPolicies.put(policy.PolicyID, policy)
+
+	return policy.PolicyID
+}
+
+// AddSnapshot stores a snapshot of the backup policy holding resources and returns its ID, or false
+// when the policy doesn't exist. An ID is generated when snapshot.SnapshotID is empty, the status
+// defaults to completed and the resource count to the number of resources. The last backup of the
+// policy is updated.
+func (s *Server) AddSnapshot(policyID string, snapshot client.Snapshot, resources []SnapshotResource) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, ok := s.backupPolicies.get(policyID)
+	if !ok {
+		return "", false
+	}
+
+	if snapshot.SnapshotID == "" {
+		snapshot.SnapshotID = s.newID()
+	}
+	snapshot.PolicyID = policyID
+	if snapshot.Status == "" {
+		snapshot.Status = client.SnapshotStatusCompleted
+	}
+	if snapshot.Trigger == "" {
+		snapshot.Trigger = "scheduled"
+	}
+	if snapshot.CreatedAt == "" {
+		snapshot.CreatedAt = now()
+	}
+	if snapshot.CompletedAt == "" && snapshot.Status == client.SnapshotStatusCompleted {
+		snapshot.CompletedAt = snapshot.CreatedAt
+	}
+	if snapshot.ResourcesCount == 0 {
+		snapshot.ResourcesCount = len(resources)
+	}
+	s.backupSnapshots[policyID] = append(s.backupSnapshots[policyID], backupSnapshot{Snapshot: snapshot, resources: resources})
+
+	policy.SnapshotsCount = len(s.backupSnapshots[policyID])
+	policy.LastBackupSnapshotID = snapshot.SnapshotID
+	policy.LastBackupTime = snapshot.CreatedAt
+	policy.LastBackupStatus = snapshot.Status
+	s.backupPolicies.put(policyID, policy)
+
+	return snapshot.SnapshotID, true
+}
+
+// triggerBackup starts an on-demand backup holding the resources of the previous one. It
+// completes the next time the policy or its snapshots are read.
+func (s *Server) triggerBackup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy, ok := s.backupPolicies.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup policy not found")
+		return
+	}
+	if policy.LastBackupStatus == client.SnapshotStatusRunning {
+		writeError(w, http.StatusConflict, "a backup is already running")
+		return
+	}
+
+	var resources []SnapshotResource
+	if stored := s.backupSnapshots[policy.PolicyID]; len(stored) > 0 {
+		resources = stored[len(stored)-1].resources
+	}
+	snapshot := client.Snapshot{
+		SnapshotID:     s.newID(),
+		PolicyID:       policy.PolicyID,
+		Status:         client.SnapshotStatusRunning,
+		Trigger:        "on_demand",
+		CreatedAt:      now(),
+		ResourcesCount: len(resources),
+	}
+	s.backupSnapshots[policy.PolicyID] = append(s.backupSnapshots[policy.PolicyID], backupSnapshot{Snapshot: snapshot, resources: resources})
+
+	policy.SnapshotsCount = len(s.backupSnapshots[policy.PolicyID])
+	policy.LastBackupSnapshotID = snapshot.SnapshotID
+	policy.LastBackupTime = snapshot.CreatedAt
+	policy.LastBackupStatus = snapshot.Status
+	s.backupPolicies.put(policy.PolicyID, policy)
+
+	writeJSON(w, http.StatusCreated, snapshot)
+}
+
+// completeRunningBackup completes the last backup of the policy if it is running. The caller
+// must hold s.mu.
+func (s *Server) completeRunningBackup(policy client.PolicyResponse) client.PolicyResponse {
+	stored := s.backupSnapshots[policy.PolicyID]
+	if policy.LastBackupStatus != client.SnapshotStatusRunning || len(stored) == 0 {
+		return policy
+	}
+
+	last := &stored[len(stored)-1]
+	last.Status = client.SnapshotStatusCompleted
+	last.CompletedAt = now()
+
+	policy.LastBackupStatus = last.Status
+	s.backupPolicies.put(policy.PolicyID, policy)
+	return policy
+}
+
+// findBackupSnapshot returns the snapshot of the policy with the ID
+func (s *Server) findBackupSnapshot(policyID, snapshotID string) (backupSnapshot, bool) {
+	for _, snapshot := range s.backupSnapshots[policyID] {
+		if snapshot.SnapshotID == snapshotID {
+			return snapshot, true
+		}
+	}
+	return backupSnapshot{}, false
+}
+
+// listBackupSnapshots serves the one-based page list of the snapshots of a policy, most recent first
+func (s *Server) listBackupSnapshots(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	page := queryInt(r, "page", 1)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := queryInt(r, "page_size", 20)
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policyID := r.PathValue("id")
+	policy, ok := s.backupPolicies.get(policyID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "backup policy not found")
+		return
+	}
+	s.completeRunningBackup(policy)
+
+	stored := s.backupSnapshots[policyID]
+	snapshots := []client.Snapshot{}
+	for i := len(stored) - 1; i >= 0; i-- {
+		if matchesFilter(status, stored[i].Status) {
+			snapshots = append(snapshots, stored[i].Snapshot)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, client.SnapshotListResponse{
+		Data: pageOf(snapshots, (page-1)*pageSize, pageSize),
+		Pagination: client.Pagination{
+			Page:     page,
+			PageSize: pageSize,
+			Total:    len(snapshots),
+			HasNext:  page*pageSize < len(snapshots),
+			HasPrev:  page > 1,
+		},
+	})
+}
+
+// diffBackupSnapshots compares the resources of the snapshot with the base snapshot, matched by ID
+func (s *Server) diffBackupSnapshots(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policyID := r.PathValue("id")
+	target, ok := s.findBackupSnapshot(policyID, r.PathValue("snapshotId"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "snapshot not found")
+		return
+	}
+	base, ok := s.findBackupSnapshot(policyID, r.URL.Query().Get("base"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "base snapshot not found")
+		return
+	}
+
+	diff := client.SnapshotDiff{
+		BaseSnapshotID:   base.SnapshotID,
+		TargetSnapshotID: target.SnapshotID,
+		Added:            []client.SnapshotDiffResource{},
+		Removed:          []client.SnapshotDiffResource{},
+		Modified:         []client.SnapshotDiffResource{},
+	}
+
+	baseResources := make(map[string]SnapshotResource, len(base.resources))
+	for _, resource := range base.resources {
+		baseResources[resource.ID] = resource
+	}
+	for _, resource := range target.resources {
+		previous, ok := baseResources[resource.ID]
+		delete(baseResources, resource.ID)
+		if !ok {
+			diff.Added = append(diff.Added, diffResource(resource, nil))
+			continue
+		}
+		if changed := changedAttributes(previous, resource); len(changed) > 0 {
+			diff.Modified = append(diff.Modified, diffResource(resource, changed))
+		}
+	}
+	for _, resource := range base.resources {
+		if _, ok := baseResources[resource.ID]; ok {
+			diff.Removed = append(diff.Removed, diffResource(resource, nil))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, diff)
+}
+
+func diffResource(resource SnapshotResource, changed []string) client.SnapshotDiffResource {
+	return client.SnapshotDiffResource{
+		ResourceID:        resource.ID,
+		ResourceType:      resource.Type,
+		Name:              resource.Name,
+		Region:            resource.Region,
+		ChangedAttributes: changed,
+	}
+}
+
+// changedAttributes returns the sorted names of the attributes that differ between the two versions of a resource
+func changedAttributes(base, target SnapshotResource) []string {
+	var changed []string
+	for name := range maps.Keys(base.Attributes) {
+		if value, ok := target.Attributes[name]; !ok || value != base.Attributes[name] {
+			changed = append(changed, name)
+		}
+	}
+	for name := range maps.Keys(target.Attributes) {
+		if _, ok := base.Attributes[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
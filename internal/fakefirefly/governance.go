@@ -0,0 +1,272 @@
+package fakefirefly
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerGovernanceRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/governance/insights", s.listGovernancePolicies)
+	mux.HandleFunc("POST /v2/governance/insights/create", s.createGovernancePolicy)
+	mux.HandleFunc("PUT /v2/governance/insights/{id}", s.updateGovernancePolicy)
+	mux.HandleFunc("DELETE /v2/governance/insights/{id}", s.deleteGovernancePolicy)
+	mux.HandleFunc("POST /v2/governance/insights/{id}/exclusions", s.createGovernancePolicyExclusion)
+	mux.HandleFunc("GET /v2/governance/insights/{id}/exclusions/{exclusionId}", s.getGovernancePolicyExclusion)
+	mux.HandleFunc("PUT /v2/governance/insights/{id}/exclusions/{exclusionId}", s.updateGovernancePolicyExclusion)
+	mux.HandleFunc("DELETE /v2/governance/insights/{id}/exclusions/{exclusionId}", s.deleteGovernancePolicyExclusion)
+}
+
+// AddGovernancePolicy stores a governance policy and returns its ID. Policies that can't be
+// created through the API, like the built-in ones with IsDefault set, are added this way.
+func (s *Server) AddGovernancePolicy(policy client.GovernancePolicy) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if policy.ID == "" {
+		policy.ID = s.newID()
+	}
+	s.governance.put(policy.ID, policy)
+
+	return policy.ID
+}
+
+// governancePolicyFromRequest converts a create or update request to the stored policy
+func governancePolicyFromRequest(id string, req client.GovernancePolicyRequest) client.GovernancePolicy {
+	return client.GovernancePolicy{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+		Code:        req.Code,
+		Type:        req.Type,
+		ProviderIDs: req.ProviderIDs,
+		Labels:      req.Labels,
+		Severity:    req.Severity,
+		Category:    req.Category,
+		Frameworks:  req.Frameworks,
+	}
+}
+
+// listGovernancePolicies serves the one-based page search, filtered by the request fields
+func (s *Server) listGovernancePolicies(w http.ResponseWriter, r *http.Request) {
+	var req client.GovernancePolicyListRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.PageSize < 1 {
+		req.PageSize = 50
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policies := s.governance.list(func(p client.GovernancePolicy) bool {
+		if len(req.ID) > 0 && !slices.Contains(req.ID, p.ID) {
+			return false
+		}
+		if req.Query != "" && !containsFold(p.Name, req.Query) {
+			return false
+		}
+		if req.Category != "" && req.Category != p.Category {
+			return false
+		}
+		if len(req.Severity) > 0 && !slices.Contains(req.Severity, p.Severity) {
+			return false
+		}
+		if req.IsDefault != nil && *req.IsDefault != p.IsDefault {
+			return false
+		}
+		if !containsAny(p.Frameworks, req.Frameworks) || !containsAny(p.Type, req.Type) || !containsAny(p.ProviderIDs, req.Providers) {
+			return false
+		}
+		for _, label := range req.Labels {
+			if !slices.Contains(p.Labels, label) {
+				return false
+			}
+		}
+		return true
+	})
+
+	writeJSON(w, http.StatusOK, client.GovernancePoliciesResponse{
+		Hits:     pageOf(policies, (req.Page-1)*req.PageSize, req.PageSize),
+		Total:    len(policies),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+}
+
+func (s *Server) createGovernancePolicy(w http.ResponseWriter, r *http.Request) {
+	var req client.GovernancePolicyRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" || req.Code == "" {
+		writeError(w, http.StatusBadRequest, "name and code are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policy := governancePolicyFromRequest(s.newID(), req)
+	s.governance.put(policy.ID, policy)
+
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (s *Server) updateGovernancePolicy(w http.ResponseWriter, r *http.Request) {
+	var req client.GovernancePolicyRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.governance.get(id); !ok {
+		writeError(w, http.StatusNotFound, "governance policy not found")
+		return
+	}
+
+	policy := governancePolicyFromRequest(id, req)
+	s.governance.put(id, policy)
+
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (s *Server) deleteGovernancePolicy(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if !s.governance.delete(id) {
+		writeError(w, http.StatusNotFound, "governance policy not found")
+		return
+	}
+	for _, exclusion := range s.exclusions.list(func(e client.GovernancePolicyExclusion) bool { return e.PolicyID == id }) {
+		s.exclusions.delete(exclusion.ID)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// exclusionFromRequest validates a create or update request and converts it to the stored exclusion.
+// It writes the error and returns false when the request is invalid.
+func exclusionFromRequest(w http.ResponseWriter, exclusion *client.GovernancePolicyExclusion, req client.GovernancePolicyExclusionRequest) bool {
+	if req.Justification == "" {
+		writeError(w, http.StatusBadRequest, "justification is required")
+		return false
+	}
+	if len(req.Assets) == 0 && len(req.ResourceTypes) == 0 {
+		writeError(w, http.StatusBadRequest, "assets or resourceTypes are required")
+		return false
+	}
+	if req.ExpiresAt != "" {
+		if _, err := time.Parse(time.RFC3339, req.ExpiresAt); err != nil {
+			writeError(w, http.StatusBadRequest, "expiresAt must be an RFC 3339 timestamp")
+			return false
+		}
+	}
+
+	exclusion.Assets = req.Assets
+	exclusion.ResourceTypes = req.ResourceTypes
+	exclusion.ProviderAccounts = req.ProviderAccounts
+	exclusion.Justification = req.Justification
+	exclusion.ExpiresAt = req.ExpiresAt
+	exclusion.UpdatedAt = now()
+	return true
+}
+
+// findExclusion returns the exclusion of the request path, writing a 404 when the policy or the
+// exclusion doesn't exist
+func (s *Server) findExclusion(w http.ResponseWriter, r *http.Request) (client.GovernancePolicyExclusion, bool) {
+	exclusion, ok := s.exclusions.get(r.PathValue("exclusionId"))
+	if !ok || exclusion.PolicyID != r.PathValue("id") {
+		writeError(w, http.StatusNotFound, "governance policy exclusion not found")
+		return client.GovernancePolicyExclusion{}, false
+	}
+	return exclusion, true
+}
+
+func (s *Server) createGovernancePolicyExclusion(w http.ResponseWriter, r *http.Request) {
+	var req client.GovernancePolicyExclusionRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policyID := r.PathValue("id")
+	if _, ok := s.governance.get(policyID); !ok {
+		writeError(w, http.StatusNotFound, "governance policy not found")
+		return
+	}
+
+	exclusion := client.GovernancePolicyExclusion{ID: s.newID(), PolicyID: policyID, CreatedBy: s.accessKey, CreatedAt: now()}
+	if !exclusionFromRequest(w, &exclusion, req) {
+		return
+	}
+	s.exclusions.put(exclusion.ID, exclusion)
+
+	writeJSON(w, http.StatusCreated, exclusion)
+}
+
+func (s *Server) getGovernancePolicyExclusion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exclusion, ok := s.findExclusion(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, exclusion)
+}
+
+func (s *Server) updateGovernancePolicyExclusion(w http.ResponseWriter, r *http.Request) {
+	var req client.GovernancePolicyExclusionRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exclusion, ok := s.findExclusion(w, r)
+	if !ok || !exclusionFromRequest(w, &exclusion, req) {
+		return
+	}
+	s.exclusions.put(exclusion.ID, exclusion)
+
+	writeJSON(w, http.StatusOK, exclusion)
+}
+
+func (s *Server) deleteGovernancePolicyExclusion(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exclusion, ok := s.findExclusion(w, r)
+	if !ok {
+		return
+	}
+	s.exclusions.delete(exclusion.ID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// containsAny reports whether values holds one of wanted, or wanted is empty
+func containsAny(values, wanted []string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(wanted, func(value string) bool {
+		return slices.Contains(values, value)
+	})
+}
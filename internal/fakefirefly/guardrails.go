@@ -0,0 +1,109 @@
+package fakefirefly
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerGuardrailRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/guardrails/search", s.searchGuardrails)
+	mux.HandleFunc("POST /v2/guardrails", s.createGuardrail)
+	mux.HandleFunc("PATCH /v2/guardrails/{id}", s.updateGuardrail)
+	mux.HandleFunc("DELETE /v2/guardrails/{id}", s.deleteGuardrail)
+}
+
+// searchGuardrails serves the zero-based page search, filtered by name and type
+func (s *Server) searchGuardrails(w http.ResponseWriter, r *http.Request) {
+	var req client.ListGuardrailsRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	guardrails := s.guardrails.list(func(g client.GuardrailRule) bool {
+		if req.SearchValue != "" && !containsFold(g.Name, req.SearchValue) {
+			return false
+		}
+		if req.Filters != nil && len(req.Filters.Type) > 0 && !slices.Contains(req.Filters.Type, g.Type) {
+			return false
+		}
+		return true
+	})
+
+	pageSize := queryInt(r, "pageSize", 20)
+	writeJSON(w, http.StatusOK, pageOf(guardrails, queryInt(r, "page", 0)*pageSize, pageSize))
+}
+
+func (s *Server) createGuardrail(w http.ResponseWriter, r *http.Request) {
+	var guardrail client.GuardrailRule
+	if !decodeBody(w, r, &guardrail) {
+		return
+	}
+	if guardrail.Name == "" || guardrail.Type == "" {
+		writeError(w, http.StatusBadRequest, "name and type are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	guardrail.ID = s.newID()
+	guardrail.AccountID = DefaultAccountID
+	guardrail.CreatedAt = now()
+	guardrail.UpdatedAt = guardrail.CreatedAt
+	s.guardrails.put(guardrail.ID, guardrail)
+
+	writeJSON(w, http.StatusOK, client.CreateGuardrailResponse{
+		RuleID:         guardrail.ID,
+		NotificationID: guardrail.NotificationID,
+	})
+}
+
+func (s *Server) updateGuardrail(w http.ResponseWriter, r *http.Request) {
+	var update client.GuardrailRule
+	if !decodeBody(w, r, &update) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.guardrails.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "guardrail not found")
+		return
+	}
+
+	update.ID = existing.ID
+	update.AccountID = existing.AccountID
+	update.CreatedBy = existing.CreatedBy
+	update.CreatedAt = existing.CreatedAt
+	update.UpdatedAt = now()
+	s.guardrails.put(update.ID, update)
+
+	writeJSON(w, http.StatusOK, client.UpdateGuardrailResponse{
+		ID:        update.ID,
+		Name:      update.Name,
+		Enabled:   update.IsEnabled,
+		UpdatedAt: update.UpdatedAt,
+	})
+}
+
+func (s *Server) deleteGuardrail(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.guardrails.delete(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "guardrail not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, client.DeleteGuardrailResponse{
+		Status:  http.StatusOK,
+		Message: "guardrail deleted",
+	})
+}
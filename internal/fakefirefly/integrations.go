@@ -0,0 +1,90 @@
+package fakefirefly
+
+import (
+	"net/http"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerIntegrationRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v2/integrations/vcs", s.listVcsIntegrations)
+	mux.HandleFunc("GET /v2/integrations/cloud", s.listCloudIntegrations)
+}
+
+// AddVcsIntegration stores a VCS integration, as integrations are connected in the UI and the API
+// has no endpoint to create one, and returns its ID. An ID is generated when integration.ID is
+// empty, and the status defaults to active.
+func (s *Server) AddVcsIntegration(integration client.VcsIntegration) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if integration.ID == "" {
+		integration.ID = s.newID()
+	}
+	if integration.Status == "" {
+		integration.Status = "active"
+	}
+	if integration.CreatedAt == "" {
+		integration.CreatedAt = now()
+	}
+	s.vcsIntegrations.put(integration.ID, integration)
+
+	return integration.ID
+}
+
+// AddCloudIntegration stores a cloud integration and returns its ID, like AddVcsIntegration
+func (s *Server) AddCloudIntegration(integration client.CloudIntegration) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if integration.ID == "" {
+		integration.ID = s.newID()
+	}
+	if integration.Status == "" {
+		integration.Status = "active"
+	}
+	if integration.CreatedAt == "" {
+		integration.CreatedAt = now()
+	}
+	s.cloudIntegrations.put(integration.ID, integration)
+
+	return integration.ID
+}
+
+// listVcsIntegrations serves the offset based VCS integration list, filtered by name and type
+func (s *Server) listVcsIntegrations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	name, integrationType := query.Get("name"), query.Get("type")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	integrations := s.vcsIntegrations.list(func(i client.VcsIntegration) bool {
+		return (name == "" || containsFold(i.Name, name)) &&
+			(integrationType == "" || string(i.Type) == integrationType)
+	})
+
+	writeJSON(w, http.StatusOK, client.VcsIntegrationsListResponse{
+		Data:       pageOf(integrations, queryInt(r, "offset", 0), queryInt(r, "pageSize", 20)),
+		TotalCount: len(integrations),
+	})
+}
+
+// listCloudIntegrations serves the offset based cloud integration list, filtered by name and type
+func (s *Server) listCloudIntegrations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	name, integrationType := query.Get("name"), query.Get("type")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	integrations := s.cloudIntegrations.list(func(i client.CloudIntegration) bool {
+		return (name == "" || containsFold(i.Name, name)) &&
+			(integrationType == "" || string(i.Type) == integrationType)
+	})
+
+	writeJSON(w, http.StatusOK, client.CloudIntegrationsListResponse{
+		Data:       pageOf(integrations, queryInt(r, "offset", 0), queryInt(r, "pageSize", 20)),
+		TotalCount: len(integrations),
+	})
+}
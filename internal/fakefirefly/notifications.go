@@ -0,0 +1,141 @@
+package fakefirefly
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerNotificationRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/notifications", s.createNotification)
+	mux.HandleFunc("GET /v2/notifications", s.listNotifications)
+	mux.HandleFunc("GET /v2/notifications/{id}", s.getNotification)
+	mux.HandleFunc("PUT /v2/notifications/{id}", s.updateNotification)
+	mux.HandleFunc("DELETE /v2/notifications/{id}", s.deleteNotification)
+}
+
+// withoutSecrets returns the notification as the API answers it, without its webhook URL, token and keys
+func withoutSecrets(notification client.Notification) client.Notification {
+	notification.WebhookURL = ""
+	notification.Token = ""
+	notification.IntegrationKey = ""
+	notification.APIKey = ""
+	return notification
+}
+
+// validNotificationRequest writes a 400 response and returns false when the request has no name or an unknown type
+func validNotificationRequest(w http.ResponseWriter, req client.NotificationRequest) bool {
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return false
+	}
+	if !slices.Contains(client.NotificationTypes, req.Type) {
+		writeError(w, http.StatusBadRequest, "unsupported notification type "+req.Type)
+		return false
+	}
+	return true
+}
+
+// applyNotificationRequest replaces the settings of the notification with the request
+func applyNotificationRequest(notification *client.Notification, req client.NotificationRequest) {
+	notification.Name = req.Name
+	notification.Type = req.Type
+	notification.WebhookURL = req.WebhookURL
+	notification.Channel = req.Channel
+	notification.Emails = req.Emails
+	notification.Token = req.Token
+	notification.IntegrationKey = req.IntegrationKey
+	notification.APIKey = req.APIKey
+	notification.Region = req.Region
+}
+
+func (s *Server) createNotification(w http.ResponseWriter, r *http.Request) {
+	var req client.NotificationRequest
+	if !decodeBody(w, r, &req) || !validNotificationRequest(w, req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notification := client.Notification{ID: s.newID(), CreatedAt: now(), UpdatedAt: now()}
+	applyNotificationRequest(&notification, req)
+	s.notifications.put(notification.ID, notification)
+
+	writeJSON(w, http.StatusCreated, withoutSecrets(notification))
+}
+
+func (s *Server) getNotification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notification, ok := s.notifications.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "notification not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, withoutSecrets(notification))
+}
+
+// updateNotification replaces the settings of the channel, its type can't be changed
+func (s *Server) updateNotification(w http.ResponseWriter, r *http.Request) {
+	var req client.NotificationRequest
+	if !decodeBody(w, r, &req) || !validNotificationRequest(w, req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notification, ok := s.notifications.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "notification not found")
+		return
+	}
+	if req.Type != notification.Type {
+		writeError(w, http.StatusBadRequest, "the type of a notification can't be changed")
+		return
+	}
+
+	applyNotificationRequest(&notification, req)
+	notification.UpdatedAt = now()
+	s.notifications.put(notification.ID, notification)
+
+	writeJSON(w, http.StatusOK, withoutSecrets(notification))
+}
+
+func (s *Server) deleteNotification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.notifications.delete(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "notification not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listNotifications serves the offset based notification list, filtered by name and type
+func (s *Server) listNotifications(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	name, notificationType := query.Get("name"), query.Get("type")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	notifications := s.notifications.list(func(n client.Notification) bool {
+		return (name == "" || containsFold(n.Name, name)) &&
+			(notificationType == "" || n.Type == notificationType)
+	})
+	for i := range notifications {
+		notifications[i] = withoutSecrets(notifications[i])
+	}
+
+	writeJSON(w, http.StatusOK, client.NotificationsListResponse{
+		Data:       pageOf(notifications, queryInt(r, "offset", 0), queryInt(r, "pageSize", 20)),
+		TotalCount: len(notifications),
+	})
+}
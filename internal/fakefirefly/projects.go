@@ -0,0 +1,250 @@
+package fakefirefly
+
+import (
+	"net/http"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerProjectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/runners/projects", s.createProject)
+	mux.HandleFunc("GET /v2/runners/projects/list", s.listProjects)
+	mux.HandleFunc("GET /v2/runners/projects/{id}", s.getProject)
+	mux.HandleFunc("PATCH /v2/runners/projects/{id}", s.updateProject)
+	mux.HandleFunc("DELETE /v2/runners/projects/{id}", s.deleteProject)
+	mux.HandleFunc("GET /v2/runners/projects/{id}/members", s.listProjectMembers)
+	mux.HandleFunc("POST /v2/runners/projects/{id}/members", s.addProjectMembers)
+	mux.HandleFunc("DELETE /v2/runners/projects/{id}/members", s.removeProjectMembers)
+	if s.memberRoleEndpoint {
+		mux.HandleFunc("PATCH /v2/runners/projects/{id}/members/{userId}", s.updateProjectMemberRole)
+	}
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateProjectRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.ParentID != "" {
+		if _, ok := s.projects.get(req.ParentID); !ok {
+			writeError(w, http.StatusBadRequest, "parent project not found")
+			return
+		}
+	}
+
+	project := client.Project{
+		ID:                   s.newID(),
+		AccountID:            DefaultAccountID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Labels:               req.Labels,
+		CronExecutionPattern: req.CronExecutionPattern,
+		Variables:            req.Variables,
+		ParentID:             req.ParentID,
+	}
+	s.projects.put(project.ID, project)
+
+	writeJSON(w, http.StatusCreated, project)
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project, ok := s.projects.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	var req client.UpdateProjectRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project, ok := s.projects.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	if req.Name != "" {
+		project.Name = req.Name
+	}
+	project.Description = req.Description
+	project.Labels = req.Labels
+	project.CronExecutionPattern = req.CronExecutionPattern
+	project.Variables = req.Variables
+	s.projects.put(project.ID, project)
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if !s.projects.delete(id) {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	delete(s.projectMembers, id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listProjects serves the offset based project list, filtered by name with searchQuery
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	search := r.URL.Query().Get("searchQuery")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	projects := s.projects.list(func(p client.Project) bool {
+		return search == "" || containsFold(p.Name, search)
+	})
+
+	writeJSON(w, http.StatusOK, client.ProjectsListResponse{
+		Data:       pageOf(projects, queryInt(r, "offset", 0), queryInt(r, "pageSize", 20)),
+		TotalCount: len(projects),
+	})
+}
+
+func (s *Server) listProjectMembers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.projects.get(id); !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	members := s.projectMembers[id]
+	if members == nil {
+		members = []client.Member{}
+	}
+
+	writeJSON(w, http.StatusOK, members)
+}
+
+// addProjectMembers adds the members, replacing the role of the users that are already members
+func (s *Server) addProjectMembers(w http.ResponseWriter, r *http.Request) {
+	var req []client.Member
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	project, ok := s.projects.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	members := s.projectMembers[id]
+	for _, member := range req {
+		if member.UserID == "" || member.Role == "" {
+			writeError(w, http.StatusBadRequest, "userId and role are required")
+			return
+		}
+		replaced := false
+		for i := range members {
+			if members[i].UserID == member.UserID {
+				members[i] = member
+				replaced = true
+			}
+		}
+		if !replaced {
+			members = append(members, member)
+		}
+	}
+	s.projectMembers[id] = members
+
+	project.MembersCount = len(members)
+	s.projects.put(id, project)
+
+	writeJSON(w, http.StatusCreated, req)
+}
+
+// updateProjectMemberRole changes the role of a member, served with WithMemberRoleEndpoint
+func (s *Server) updateProjectMemberRole(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Role string `json:"role"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Role == "" {
+		writeError(w, http.StatusBadRequest, "role is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	members := s.projectMembers[r.PathValue("id")]
+	for i := range members {
+		if members[i].UserID == r.PathValue("userId") {
+			members[i].Role = req.Role
+			writeJSON(w, http.StatusOK, members[i])
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "member not found")
+}
+
+func (s *Server) removeProjectMembers(w http.ResponseWriter, r *http.Request) {
+	var userIDs []string
+	if !decodeBody(w, r, &userIDs) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	project, ok := s.projects.get(id)
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+
+	remove := make(map[string]bool, len(userIDs))
+	for _, userID := range userIDs {
+		remove[userID] = true
+	}
+
+	var members []client.Member
+	for _, member := range s.projectMembers[id] {
+		if !remove[member.UserID] {
+			members = append(members, member)
+		}
+	}
+	s.projectMembers[id] = members
+
+	project.MembersCount = len(members)
+	s.projects.put(id, project)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,336 @@
+package fakefirefly
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerRunnersWorkspaceRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/runners/workspaces", s.createRunnersWorkspace)
+	mux.HandleFunc("GET /v2/runners/workspaces/{id}", s.getRunnersWorkspace)
+	mux.HandleFunc("PUT /v2/runners/workspaces/{id}", s.updateRunnersWorkspace)
+	mux.HandleFunc("DELETE /v2/runners/workspaces/{id}", s.deleteRunnersWorkspace)
+	mux.HandleFunc("POST /v2/runners/workspaces/{id}/tasks/destroy", s.destroyRunnersWorkspace)
+	mux.HandleFunc("POST /v2/runners/workspaces/{id}/tasks/{taskType}", s.runRunnersWorkspaceTask)
+	mux.HandleFunc("GET /v2/runners/workspaces/{id}/tasks", s.listRunnersWorkspaceTasks)
+	mux.HandleFunc("GET /v2/runners/workspaces/{id}/tasks/{taskId}", s.getRunnersWorkspaceTask)
+	mux.HandleFunc("GET /v2/runners/workspaces/{id}/runs/{runId}", s.getRunnersWorkspaceRun)
+}
+
+func (s *Server) createRunnersWorkspace(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateRunnersWorkspaceRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.WorkspaceName == "" || req.Repo == "" || req.VcsID == "" {
+		writeError(w, http.StatusBadRequest, "workspaceName, repo and vcsId are required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspace := client.RunnersWorkspace{
+		ID:               s.newID(),
+		Name:             req.WorkspaceName,
+		Description:      req.Description,
+		AccountID:        DefaultAccountID,
+		Repository:       req.Repo,
+		WorkingDirectory: req.WorkDir,
+		VcsIntegrationID: req.VcsID,
+		Vcs:              req.VcsType,
+		DefaultBranch:    req.DefaultBranch,
+		IacProvisioner: &client.IacProvisioner{
+			Type:    req.IacType,
+			Version: req.Execution.TerraformVersion,
+		},
+		Labels:             req.Labels,
+		TerraformVariables: req.TerraformVariables,
+		RunnerEnvironment:  req.RunnerEnvironment,
+	}
+	if req.Project != nil {
+		if _, ok := s.projects.get(*req.Project); !ok {
+			writeError(w, http.StatusBadRequest, "project not found")
+			return
+		}
+		workspace.ProjectID = *req.Project
+	}
+	s.runnersWorkspaces.put(workspace.ID, workspace)
+
+	writeJSON(w, http.StatusCreated, workspace)
+}
+
+func (s *Server) getRunnersWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspace, ok := s.runnersWorkspaces.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, workspace)
+}
+
+func (s *Server) updateRunnersWorkspace(w http.ResponseWriter, r *http.Request) {
+	var req client.UpdateRunnersWorkspaceRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspace, ok := s.runnersWorkspaces.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	// Fields left empty in the request are not changed
+	if req.Name != "" {
+		workspace.Name = req.Name
+	}
+	if req.Description != "" {
+		workspace.Description = req.Description
+	}
+	if req.Labels != nil {
+		workspace.Labels = req.Labels
+	}
+	if req.VcsIntegrationID != "" {
+		workspace.VcsIntegrationID = req.VcsIntegrationID
+	}
+	if req.Repository != "" {
+		workspace.Repository = req.Repository
+	}
+	if req.DefaultBranch != "" {
+		workspace.DefaultBranch = req.DefaultBranch
+	}
+	if req.WorkingDirectory != "" {
+		workspace.WorkingDirectory = req.WorkingDirectory
+	}
+	if req.CronExecutionPattern != "" {
+		workspace.CronExecutionPattern = req.CronExecutionPattern
+	}
+	if req.IacProvisioner != nil {
+		workspace.IacProvisioner = req.IacProvisioner
+	}
+	// A map that isn't sent keeps the stored values, an empty map clears them
+	if req.TerraformVariables != nil {
+		workspace.TerraformVariables = *req.TerraformVariables
+	}
+	if req.RunnerEnvironment != nil {
+		workspace.RunnerEnvironment = *req.RunnerEnvironment
+	}
+	s.runnersWorkspaces.put(workspace.ID, workspace)
+
+	writeJSON(w, http.StatusOK, workspace)
+}
+
+func (s *Server) deleteRunnersWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.runnersWorkspaces.delete(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// destroyRunnersWorkspace queues a destroy task for the workspace
+func (s *Server) destroyRunnersWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspace, ok := s.runnersWorkspaces.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	task := s.newTask(workspace.ID, "destroy", "")
+	writeJSON(w, http.StatusOK, task)
+}
+
+// taskStatusSteps lists the statuses a task goes through, one per poll
+var taskStatusSteps = []string{client.TaskStatusQueued, client.TaskStatusRunning, client.TaskStatusCompleted}
+
+// newTask stores a new queued task of the workspace. The caller must hold s.mu.
+func (s *Server) newTask(workspaceID, taskType, runID string) client.TaskResponse {
+	task := client.TaskResponse{
+		TaskID:      s.newID(),
+		Status:      client.TaskStatusQueued,
+		RunID:       runID,
+		TaskType:    taskType,
+		WorkspaceID: workspaceID,
+		CreatedAt:   now(),
+		UpdatedAt:   now(),
+		Logs:        fmt.Sprintf("%s task queued\n", taskType),
+	}
+	s.tasks.put(task.TaskID, task)
+	return task
+}
+
+// setTaskStatus changes the status of a task and logs it. The caller must hold s.mu.
+func (s *Server) setTaskStatus(task client.TaskResponse, status string) client.TaskResponse {
+	task.Status = status
+	task.UpdatedAt = now()
+	task.Logs += fmt.Sprintf("%s task %s\n", task.TaskType, status)
+	s.tasks.put(task.TaskID, task)
+	return task
+}
+
+// listRunnersWorkspaceTasks returns the tasks of the workspace, most recent first and without logs
+func (s *Server) listRunnersWorkspaceTasks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspaceID := r.PathValue("id")
+	if _, ok := s.runnersWorkspaces.get(workspaceID); !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	tasks := s.tasks.list(func(task client.TaskResponse) bool { return task.WorkspaceID == workspaceID })
+	result := make([]client.TaskResponse, 0, len(tasks))
+	for i := len(tasks) - 1; i >= 0; i-- {
+		task := tasks[i]
+		task.Logs = ""
+		result = append(result, task)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// getRunnersWorkspaceTask returns a task, which moves on to its next status every time it is read
+func (s *Server) getRunnersWorkspaceTask(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasks.get(r.PathValue("taskId"))
+	if !ok || task.WorkspaceID != r.PathValue("id") {
+		writeError(w, http.StatusNotFound, "task not found")
+		return
+	}
+
+	for i, status := range taskStatusSteps[:len(taskStatusSteps)-1] {
+		if task.Status == status {
+			task = s.setTaskStatus(task, taskStatusSteps[i+1])
+			break
+		}
+	}
+
+	writeJSON(w, http.StatusOK, task)
+}
+
+// SetTaskStatus sets the status of a task, e.g. to make it fail. It reports whether the task exists.
+func (s *Server) SetTaskStatus(taskID, status string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	task, ok := s.tasks.get(taskID)
+	if !ok {
+		return false
+	}
+	s.setTaskStatus(task, status)
+	return true
+}
+
+// runnerRun is a run started by a plan or apply task
+type runnerRun struct {
+	client.WorkspaceRun
+	taskType string
+}
+
+// runStatusSteps lists the statuses a run goes through for each task type, one per poll
+var runStatusSteps = map[string][]client.WorkspaceRunStatusEnum{
+	client.TaskTypePlan:  {client.WorkspaceRunStatusPlanning, client.WorkspaceRunStatusPlanSuccess},
+	client.TaskTypeApply: {client.WorkspaceRunStatusPlanning, client.WorkspaceRunStatusApplying, client.WorkspaceRunStatusApplySuccess},
+}
+
+// runRunnersWorkspaceTask starts a plan or apply run on the workspace
+func (s *Server) runRunnersWorkspaceTask(w http.ResponseWriter, r *http.Request) {
+	taskType := r.PathValue("taskType")
+	steps, ok := runStatusSteps[taskType]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "unsupported task type")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspace, ok := s.runnersWorkspaces.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	runID := s.newID()
+	s.runnerRuns.put(runID, runnerRun{
+		WorkspaceRun: client.WorkspaceRun{
+			ID:            runID,
+			WorkspaceID:   workspace.ID,
+			WorkspaceName: workspace.Name,
+			RunID:         runID,
+			RunName:       taskType,
+			Status:        string(steps[0]),
+			CreatedAt:     now(),
+			UpdatedAt:     now(),
+			Branch:        workspace.DefaultBranch,
+			CommitID:      fmt.Sprintf("%040s", runID),
+			RunnerType:    "firefly",
+			BuildID:       runID,
+			BuildURL:      fmt.Sprintf("https://app.firefly.ai/workflows/runs/%s", runID),
+			Repo:          workspace.Repository,
+		},
+		taskType: taskType,
+	})
+
+	task := s.newTask(workspace.ID, taskType, runID)
+	writeJSON(w, http.StatusOK, task)
+}
+
+// getRunnersWorkspaceRun returns a run, which moves on to its next status every time it is read
+func (s *Server) getRunnersWorkspaceRun(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runnerRuns.get(r.PathValue("runId"))
+	if !ok || run.WorkspaceID != r.PathValue("id") {
+		writeError(w, http.StatusNotFound, "run not found")
+		return
+	}
+
+	steps := runStatusSteps[run.taskType]
+	for i, status := range steps[:len(steps)-1] {
+		if run.Status == string(status) {
+			run.Status = string(steps[i+1])
+			run.UpdatedAt = now()
+			s.runnerRuns.put(run.ID, run)
+			break
+		}
+	}
+
+	writeJSON(w, http.StatusOK, run.WorkspaceRun)
+}
+
+// SetRunStatus sets the status of a run started by a plan or apply task, e.g. to make it fail.
+// It reports whether the run exists.
+func (s *Server) SetRunStatus(runID string, status client.WorkspaceRunStatusEnum) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runnerRuns.get(runID)
+	if !ok {
+		return false
+	}
+	run.Status = string(status)
+	s.runnerRuns.put(runID, run)
+	return true
+}
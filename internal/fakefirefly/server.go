@@ -0,0 +1,434 @@
+// Package fakefirefly provides an in-memory implementation of the Firefly API for tests.
+//
+// The server implements the endpoints used by the client package with stateful storage,
+// the pagination styles of the real API and fault injection, so the client and the
+// provider acceptance tests can run without network access or a Firefly account.
+package fakefirefly
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+const (
+	// DefaultAccessKey and DefaultSecretKey are the credentials accepted by a server created without options
+	DefaultAccessKey = "fake-access-key"
+	DefaultSecretKey = "fake-secret-key"
+
+	// DefaultAccountID is the account that owns every object stored by the server
+	DefaultAccountID = "fake-account"
+
+	// RootProjectID is the ID of the root project of the account, which projects
+	// created without a parent are attached to by the provider
+	RootProjectID = "fake-root-project"
+
+	// defaultTokenTTL is the lifetime of the access tokens returned by the login endpoint
+	defaultTokenTTL = time.Hour
+)
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithCredentials sets the access and secret keys accepted by the login endpoint
+func WithCredentials(accessKey, secretKey string) Option {
+	return func(s *Server) {
+		s.accessKey = accessKey
+		s.secretKey = secretKey
+	}
+}
+
+// WithTokenTTL sets the lifetime of the issued access tokens
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		s.tokenTTL = ttl
+	}
+}
+
+// WithLatency delays every response by the given duration
+func WithLatency(latency time.Duration) Option {
+	return func(s *Server) {
+		s.latency = latency
+	}
+}
+
+// WithMemberRoleEndpoint serves PATCH /v2/runners/projects/{id}/members/{userId}, which changes the
+// role of a project member. It isn't part of the documented API, so by default the server answers
+// 404 and the client removes the member and adds them back instead.
+func WithMemberRoleEndpoint() Option {
+	return func(s *Server) {
+		s.memberRoleEndpoint = true
+	}
+}
+
+// Fault makes the server fail matching requests with the given status code
+type Fault struct {
+	// Method restricts the fault to one HTTP method, empty matches every method
+	Method string
+	// PathPrefix restricts the fault to the paths starting with it, empty matches every path
+	PathPrefix string
+	// StatusCode is the status returned instead of handling the request, e.g. 429 or 500
+	StatusCode int
+	// RetryAfter is sent in the Retry-After header when not empty
+	RetryAfter string
+	// Times is the number of requests to fail, zero fails every matching request until ClearFaults
+	Times int
+}
+
+// matches reports whether the fault applies to the request
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	return strings.HasPrefix(r.URL.Path, f.PathPrefix)
+}
+
+// Server is an in-memory Firefly API served over HTTP
+type Server struct {
+	server *httptest.Server
+
+	accessKey string
+	secretKey string
+	tokenTTL  time.Duration
+
+	memberRoleEndpoint bool
+
+	mu       sync.Mutex
+	latency  time.Duration
+	faults   []*Fault
+	requests map[string]int
+	tokens   map[string]time.Time
+	nextID   int
+
+	projects          *store[client.Project]
+	projectMembers    map[string][]client.Member
+	runnersWorkspaces *store[client.RunnersWorkspace]
+	tasks             *store[client.TaskResponse]
+	runnerRuns        *store[runnerRun]
+	variableSets      *store[client.VariableSet]
+	guardrails        *store[client.GuardrailRule]
+	workspaces        *store[client.Workspace]
+	workspaceRuns     map[string][]client.WorkspaceRun
+	governance        *store[client.GovernancePolicy]
+	exclusions        *store[client.GovernancePolicyExclusion]
+	backupPolicies    *store[client.PolicyResponse]
+	backupSnapshots   map[string][]backupSnapshot
+	backupRestores    *store[client.Restore]
+	users             *store[client.User]
+	notifications     *store[client.Notification]
+	vcsIntegrations   *store[client.VcsIntegration]
+	cloudIntegrations *store[client.CloudIntegration]
+}
+
+// NewServer starts a fake Firefly API server. Close must be called when it is no longer needed.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		accessKey:         DefaultAccessKey,
+		secretKey:         DefaultSecretKey,
+		tokenTTL:          defaultTokenTTL,
+		requests:          make(map[string]int),
+		tokens:            make(map[string]time.Time),
+		projects:          newStore[client.Project](),
+		projectMembers:    make(map[string][]client.Member),
+		runnersWorkspaces: newStore[client.RunnersWorkspace](),
+		tasks:             newStore[client.TaskResponse](),
+		runnerRuns:        newStore[runnerRun](),
+		variableSets:      newStore[client.VariableSet](),
+		guardrails:        newStore[client.GuardrailRule](),
+		workspaces:        newStore[client.Workspace](),
+		workspaceRuns:     make(map[string][]client.WorkspaceRun),
+		governance:        newStore[client.GovernancePolicy](),
+		exclusions:        newStore[client.GovernancePolicyExclusion](),
+		backupPolicies:    newStore[client.PolicyResponse](),
+		backupSnapshots:   make(map[string][]backupSnapshot),
+		backupRestores:    newStore[client.Restore](),
+		users:             newStore[client.User](),
+		notifications:     newStore[client.Notification](),
+		vcsIntegrations:   newStore[client.VcsIntegration](),
+		cloudIntegrations: newStore[client.CloudIntegration](),
+	}
+
+	s.projects.put(RootProjectID, client.Project{ID: RootProjectID, AccountID: DefaultAccountID, Name: "root"})
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v2/login", s.handleLogin)
+	s.registerProjectRoutes(mux)
+	s.registerRunnersWorkspaceRoutes(mux)
+	s.registerVariableSetRoutes(mux)
+	s.registerGuardrailRoutes(mux)
+	s.registerWorkspaceRoutes(mux)
+	s.registerGovernanceRoutes(mux)
+	s.registerBackupAndDrRoutes(mux)
+	s.registerBackupSnapshotRoutes(mux)
+	s.registerBackupRestoreRoutes(mux)
+	s.registerUserRoutes(mux)
+	s.registerNotificationRoutes(mux)
+	s.registerIntegrationRoutes(mux)
+
+	s.server = httptest.NewServer(s.middleware(mux))
+	return s
+}
+
+// URL returns the base URL of the server, to be used as the API URL of the client
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetLatency changes the delay added to every response
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = latency
+}
+
+// InjectFault makes the server fail the requests matching fault
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes every injected fault
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// RequestCount returns the number of requests received for the given method and path,
+// including the ones that failed because of an injected fault
+func (s *Server) RequestCount(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[method+" "+path]
+}
+
+// ExpireTokens invalidates every issued access token, so the next request gets a 401
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]time.Time)
+}
+
+// middleware records requests, applies latency and faults, and checks the access token
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.Method+" "+r.URL.Path]++
+		latency := s.latency
+		fault := s.takeFault(r)
+		s.mu.Unlock()
+
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if fault != nil {
+			if fault.RetryAfter != "" {
+				w.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			writeError(w, fault.StatusCode, fmt.Sprintf("injected fault: %s", http.StatusText(fault.StatusCode)))
+			return
+		}
+
+		if r.URL.Path != "/v2/login" && !s.authorized(r) {
+			writeError(w, http.StatusUnauthorized, "invalid or expired access token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// takeFault returns the first fault matching the request and consumes one of its occurrences.
+// The caller must hold s.mu.
+func (s *Server) takeFault(r *http.Request) *Fault {
+	for i, fault := range s.faults {
+		if !fault.matches(r) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// authorized reports whether the request carries a valid access token
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresAt, ok := s.tokens[token]
+	return ok && time.Now().Before(expiresAt)
+}
+
+// handleLogin exchanges the access and secret keys for an access token
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		AccessKey string `json:"accessKey"`
+		SecretKey string `json:"secretKey"`
+	}
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if req.AccessKey != s.accessKey || req.SecretKey != s.secretKey {
+		writeError(w, http.StatusUnauthorized, "invalid access key or secret key")
+		return
+	}
+
+	s.mu.Lock()
+	token := fmt.Sprintf("fake-token-%s", s.newID())
+	expiresAt := time.Now().Add(s.tokenTTL)
+	s.tokens[token] = expiresAt
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, client.AuthResponse{
+		AccessToken: token,
+		ExpiresAt:   expiresAt.Unix(),
+		TokenType:   "Bearer",
+	})
+}
+
+// newID returns a new identifier shaped like the object IDs of the real API. The caller must hold s.mu.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%024x", s.nextID)
+}
+
+// now returns the timestamp stored in the created and updated fields
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// writeJSON writes value as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if value != nil {
+		json.NewEncoder(w).Encode(value)
+	}
+}
+
+// writeError writes an error response in the format of the real API
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
+
+// decodeBody decodes the JSON request body into value, answering 400 when it is invalid
+func decodeBody(w http.ResponseWriter, r *http.Request, value interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(value); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// queryInt returns the integer query parameter name, or def when it is missing or invalid
+func queryInt(r *http.Request, name string, def int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// pageOf returns the items of items starting at offset, at most size of them
+func pageOf[T any](items []T, offset, size int) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := len(items)
+	if size > 0 && offset+size < end {
+		end = offset + size
+	}
+	return items[offset:end]
+}
+
+// containsFold reports whether substr is within s, ignoring case
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// store keeps the objects of one kind in insertion order
+type store[T any] struct {
+	ids   []string
+	items map[string]T
+}
+
+func newStore[T any]() *store[T] {
+	return &store[T]{items: make(map[string]T)}
+}
+
+// put inserts or replaces the object with the given ID
+func (s *store[T]) put(id string, item T) {
+	if _, ok := s.items[id]; !ok {
+		s.ids = append(s.ids, id)
+	}
+	s.items[id] = item
+}
+
+// get returns the object with the given ID
+func (s *store[T]) get(id string) (T, bool) {
+	item, ok := s.items[id]
+	return item, ok
+}
+
+// delete removes the object with the given ID and reports whether it existed
+func (s *store[T]) delete(id string) bool {
+	if _, ok := s.items[id]; !ok {
+		return false
+	}
+	delete(s.items, id)
+	for i, existing := range s.ids {
+		if existing == id {
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// list returns the objects matching keep, in insertion order. A nil keep matches every object.
+func (s *store[T]) list(keep func(T) bool) []T {
+	result := make([]T, 0, len(s.ids))
+	for _, id := range s.ids {
+		item := s.items[id]
+		if keep == nil || keep(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
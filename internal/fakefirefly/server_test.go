@@ -0,0 +1,772 @@
+package fakefirefly
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+// newTestClient creates a client talking to server, with short retry waits
+func newTestClient(t *testing.T, server *Server) *client.Client {
+	t.Helper()
+
+	c, err := client.NewClient(client.Config{
+		AccessKey:    DefaultAccessKey,
+		SecretKey:    DefaultSecretKey,
+		APIURL:       server.URL(),
+		MaxRetries:   client.DefaultMaxRetries,
+		RetryMaxWait: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestServer_Login(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	if err := newTestClient(t, server).Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate failed: %v", err)
+	}
+
+	wrong, err := client.NewClient(client.Config{AccessKey: "wrong", SecretKey: "wrong", APIURL: server.URL()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := wrong.Authenticate(context.Background()); err == nil {
+		t.Fatal("Expected authentication with wrong keys to fail")
+	}
+}
+
+func TestServer_ProjectLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	project, err := c.Projects().CreateProject(ctx, client.CreateProjectRequest{Name: "platform", Labels: []string{"team"}})
+	if err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+	if project.ID == "" || project.AccountID != DefaultAccountID {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+
+	root, err := client.Find(c.Projects().AllProjects(ctx, ""), func(p client.Project) bool { return p.ParentID == "" && p.ID != project.ID })
+	if err != nil || root.ID != RootProjectID {
+		t.Errorf("Expected the root project to be listed, got %+v (%v)", root, err)
+	}
+
+	updated, err := c.Projects().UpdateProject(ctx, project.ID, client.UpdateProjectRequest{Name: "platform-v2", Description: "updated"})
+	if err != nil {
+		t.Fatalf("UpdateProject failed: %v", err)
+	}
+	if updated.Name != "platform-v2" || updated.Description != "updated" {
+		t.Errorf("Unexpected updated project: %+v", updated)
+	}
+
+	if _, err := c.Projects().AddProjectMember(ctx, project.ID, client.Member{UserID: "user-1", Role: "admin"}); err != nil {
+		t.Fatalf("AddProjectMember failed: %v", err)
+	}
+	member, err := c.Projects().UpdateProjectMember(ctx, project.ID, client.Member{UserID: "user-1", Role: "viewer"})
+	if err != nil {
+		t.Fatalf("UpdateProjectMember failed: %v", err)
+	}
+	if member.Role != "viewer" {
+		t.Errorf("Expected role viewer, got %s", member.Role)
+	}
+
+	if err := c.Projects().DeleteProject(ctx, project.ID); err != nil {
+		t.Fatalf("DeleteProject failed: %v", err)
+	}
+	if _, err := c.Projects().GetProject(ctx, project.ID); !client.IsNotFound(err) {
+		t.Errorf("Expected not found after delete, got %v", err)
+	}
+}
+
+func TestServer_UpdateProjectMember(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		// expectPatch is whether the role update endpoint changes the role, rather than removing
+		// the member and adding them back
+		expectPatch bool
+	}{
+		{name: "role update endpoint", opts: []Option{WithMemberRoleEndpoint()}, expectPatch: true},
+		{name: "remove and add back"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(tt.opts...)
+			defer server.Close()
+			c := newTestClient(t, server)
+			ctx := context.Background()
+
+			if _, err := c.Projects().AddProjectMember(ctx, RootProjectID, client.Member{UserID: "user-1", Role: "admin"}); err != nil {
+				t.Fatalf("AddProjectMember failed: %v", err)
+			}
+			member, err := c.Projects().UpdateProjectMember(ctx, RootProjectID, client.Member{UserID: "user-1", Role: "viewer"})
+			if err != nil {
+				t.Fatalf("UpdateProjectMember failed: %v", err)
+			}
+			if member.Role != "viewer" {
+				t.Errorf("Expected role viewer, got %s", member.Role)
+			}
+
+			stored, err := c.Projects().GetProjectMember(ctx, RootProjectID, "user-1")
+			if err != nil || stored.Role != "viewer" {
+				t.Errorf("Expected the stored role to be viewer, got %+v (%v)", stored, err)
+			}
+
+			membersPath := "/v2/runners/projects/" + RootProjectID + "/members"
+			if got := server.RequestCount(http.MethodPatch, membersPath+"/user-1"); got != 1 {
+				t.Errorf("Expected the role update endpoint to be tried once, got %d", got)
+			}
+			removed := server.RequestCount(http.MethodDelete, membersPath)
+			if tt.expectPatch && removed != 0 {
+				t.Errorf("Expected the member not to be removed, got %d removals", removed)
+			}
+			if !tt.expectPatch && (removed != 1 || server.RequestCount(http.MethodPost, membersPath) != 2) {
+				t.Errorf("Expected the member to be removed and added back, got %d removals", removed)
+			}
+		})
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	const count = 2*client.DefaultPageSize + 5
+	for i := 0; i < count; i++ {
+		if _, err := c.Projects().CreateProject(ctx, client.CreateProjectRequest{Name: "project"}); err != nil {
+			t.Fatalf("CreateProject failed: %v", err)
+		}
+		if _, err := c.GovernancePolicies().Create(ctx, &client.GovernancePolicy{Name: "policy", Code: "Y29kZQ=="}); err != nil {
+			t.Fatalf("Create governance policy failed: %v", err)
+		}
+		server.AddWorkspace(client.Workspace{WorkspaceName: "workspace"})
+	}
+
+	// The root project is listed along with the created ones
+	projects, err := client.Collect(c.Projects().AllProjects(ctx, ""), 0)
+	if err != nil || len(projects) != count+1 {
+		t.Errorf("Expected %d projects, got %d (%v)", count+1, len(projects), err)
+	}
+
+	policies, err := client.Collect(c.GovernancePolicies().All(ctx, nil), 0)
+	if err != nil || len(policies) != count {
+		t.Errorf("Expected %d governance policies, got %d (%v)", count, len(policies), err)
+	}
+
+	workspaces, err := client.Collect(c.Workspaces().AllWorkspaces(ctx, nil), 0)
+	if err != nil || len(workspaces) != count {
+		t.Errorf("Expected %d workspaces, got %d (%v)", count, len(workspaces), err)
+	}
+
+	if got := server.RequestCount(http.MethodPost, "/workspaces/search"); got != 3 {
+		t.Errorf("Expected 3 workspace pages to be requested, got %d", got)
+	}
+}
+
+func TestServer_VariableSets(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	created, err := c.VariableSets().CreateVariableSet(ctx, client.CreateVariableSetRequest{
+		Name:      "shared",
+		Variables: []client.Variable{{Key: "REGION", Value: "us-east-1", Sensitivity: client.SensitivityString}},
+	})
+	if err != nil {
+		t.Fatalf("CreateVariableSet failed: %v", err)
+	}
+
+	variableSet, err := c.VariableSets().UpdateVariableSet(ctx, created.VariableSetID, client.UpdateVariableSetRequest{Name: "shared-v2"})
+	if err != nil {
+		t.Fatalf("UpdateVariableSet failed: %v", err)
+	}
+	if variableSet.Name != "shared-v2" || variableSet.Version != 2 {
+		t.Errorf("Unexpected variable set: %+v", variableSet)
+	}
+
+	if err := c.VariableSets().DeleteVariableSet(ctx, created.VariableSetID); err != nil {
+		t.Fatalf("DeleteVariableSet failed: %v", err)
+	}
+	if _, err := c.VariableSets().GetVariableSet(ctx, created.VariableSetID); !client.IsNotFound(err) {
+		t.Errorf("Expected not found after delete, got %v", err)
+	}
+}
+
+func TestServer_RunnersWorkspaceRuns(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	workspace, err := c.RunnersWorkspaces().CreateRunnersWorkspace(ctx, client.CreateRunnersWorkspaceRequest{
+		WorkspaceName: "network",
+		Repo:          "org/infra",
+		VcsID:         "vcs-1",
+		DefaultBranch: "main",
+	})
+	if err != nil {
+		t.Fatalf("CreateRunnersWorkspace failed: %v", err)
+	}
+
+	task, err := c.RunnersWorkspaces().RunWorkspaceTask(ctx, workspace.ID, client.RunTaskRequest{TaskType: client.TaskTypeApply})
+	if err != nil {
+		t.Fatalf("RunWorkspaceTask failed: %v", err)
+	}
+
+	// The run moves on to its next status every time it is read
+	var statuses []string
+	for i := 0; i < 3; i++ {
+		run, err := c.RunnersWorkspaces().GetWorkspaceRun(ctx, workspace.ID, task.RunID)
+		if err != nil {
+			t.Fatalf("GetWorkspaceRun failed: %v", err)
+		}
+		statuses = append(statuses, run.Status)
+	}
+	if statuses[0] != "applying" || statuses[1] != "apply_success" || statuses[2] != "apply_success" {
+		t.Errorf("Unexpected statuses: %v", statuses)
+	}
+
+	if !server.SetRunStatus(task.RunID, client.WorkspaceRunStatusApplyError) {
+		t.Fatal("SetRunStatus didn't find the run")
+	}
+	run, err := c.RunnersWorkspaces().GetWorkspaceRun(ctx, workspace.ID, task.RunID)
+	if err != nil {
+		t.Fatalf("GetWorkspaceRun failed: %v", err)
+	}
+	if run.Status != "apply_error" {
+		t.Errorf("Expected status apply_error, got %s", run.Status)
+	}
+
+	if _, err := c.RunnersWorkspaces().GetWorkspaceRun(ctx, "other-workspace", task.RunID); !client.IsNotFound(err) {
+		t.Errorf("Expected not found for a run of another workspace, got %v", err)
+	}
+}
+
+func TestServer_RunnersWorkspaceTasks(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	workspace, err := c.RunnersWorkspaces().CreateRunnersWorkspace(ctx, client.CreateRunnersWorkspaceRequest{
+		WorkspaceName: "ephemeral",
+		Repo:          "org/infra",
+		VcsID:         "vcs-1",
+	})
+	if err != nil {
+		t.Fatalf("CreateRunnersWorkspace failed: %v", err)
+	}
+
+	task, err := c.RunnersWorkspaces().DestroyWorkspaceResources(ctx, workspace.ID, client.RunTaskRequest{TaskType: "destroy"})
+	if err != nil {
+		t.Fatalf("DestroyWorkspaceResources failed: %v", err)
+	}
+	if task.Status != client.TaskStatusQueued {
+		t.Errorf("Expected a queued task, got %s", task.Status)
+	}
+
+	// The task moves on to its next status every time it is read
+	for _, expected := range []string{client.TaskStatusRunning, client.TaskStatusCompleted, client.TaskStatusCompleted} {
+		current, err := c.RunnersWorkspaces().GetTask(ctx, workspace.ID, task.TaskID)
+		if err != nil {
+			t.Fatalf("GetTask failed: %v", err)
+		}
+		if current.Status != expected {
+			t.Errorf("Expected status %s, got %s", expected, current.Status)
+		}
+	}
+
+	if !server.SetTaskStatus(task.TaskID, client.TaskStatusFailed) {
+		t.Fatal("SetTaskStatus didn't find the task")
+	}
+	current, err := c.RunnersWorkspaces().GetTask(ctx, workspace.ID, task.TaskID)
+	if err != nil {
+		t.Fatalf("GetTask failed: %v", err)
+	}
+	if current.Status != client.TaskStatusFailed {
+		t.Errorf("Expected status failed, got %s", current.Status)
+	}
+
+	if current.Logs == "" {
+		t.Error("Expected the task logs")
+	}
+
+	tasks, err := c.RunnersWorkspaces().ListTasks(ctx, workspace.ID)
+	if err != nil {
+		t.Fatalf("ListTasks failed: %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].TaskID != task.TaskID || tasks[0].TaskType != "destroy" || tasks[0].Logs != "" {
+		t.Errorf("Unexpected tasks: %+v", tasks)
+	}
+
+	if _, err := c.RunnersWorkspaces().GetTask(ctx, "other-workspace", task.TaskID); !client.IsNotFound(err) {
+		t.Errorf("Expected not found for a task of another workspace, got %v", err)
+	}
+}
+
+func TestServer_GuardrailsAndBackupPolicies(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	createResp, err := c.Guardrails().CreateGuardrail(ctx, &client.GuardrailRule{Name: "no-public-buckets", Type: "policy", IsEnabled: true})
+	if err != nil {
+		t.Fatalf("CreateGuardrail failed: %v", err)
+	}
+	guardrail, err := c.Guardrails().GetGuardrail(ctx, createResp.RuleID)
+	if err != nil || guardrail.Name != "no-public-buckets" {
+		t.Fatalf("GetGuardrail returned %+v, %v", guardrail, err)
+	}
+
+	policy, err := c.BackupAndDr().Create(ctx, &client.PolicyCreateRequest{
+		PolicyName:    "nightly",
+		IntegrationID: "integration-1",
+		Region:        "us-east-1",
+		ProviderType:  "aws",
+	})
+	if err != nil {
+		t.Fatalf("Create backup policy failed: %v", err)
+	}
+
+	description := "updated"
+	updated, err := c.BackupAndDr().Update(ctx, policy.PolicyID, &client.PolicyUpdateRequest{Description: &description})
+	if err != nil {
+		t.Fatalf("Update backup policy failed: %v", err)
+	}
+	if updated.Description != "updated" || updated.PolicyName != "nightly" {
+		t.Errorf("Unexpected backup policy: %+v", updated)
+	}
+
+	matching, err := client.Collect(c.BackupAndDr().All(ctx, &client.PolicyListFilters{Region: "eu-west-1"}), 0)
+	if err != nil || len(matching) != 0 {
+		t.Errorf("Expected no policy in eu-west-1, got %d (%v)", len(matching), err)
+	}
+}
+
+func TestServer_InjectedFaultsAreRetried(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	project, err := c.Projects().CreateProject(ctx, client.CreateProjectRequest{Name: "flaky"})
+	if err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+
+	path := "/v2/runners/projects/" + project.ID
+	server.InjectFault(Fault{Method: http.MethodGet, PathPrefix: path, StatusCode: http.StatusTooManyRequests, RetryAfter: "0", Times: 1})
+	server.InjectFault(Fault{Method: http.MethodGet, PathPrefix: path, StatusCode: http.StatusInternalServerError, Times: 1})
+
+	if _, err := c.Projects().GetProject(ctx, project.ID); err != nil {
+		t.Fatalf("Expected GetProject to succeed after retries, got %v", err)
+	}
+	if got := server.RequestCount(http.MethodGet, path); got != 3 {
+		t.Errorf("Expected 3 requests, got %d", got)
+	}
+
+	server.InjectFault(Fault{StatusCode: http.StatusInternalServerError})
+	_, err = c.Projects().GetProject(ctx, project.ID)
+	if client.StatusCode(err) != http.StatusInternalServerError {
+		t.Errorf("Expected a 500 error while the fault is active, got %v", err)
+	}
+
+	server.ClearFaults()
+	if _, err := c.Projects().GetProject(ctx, project.ID); err != nil {
+		t.Errorf("Expected GetProject to succeed once faults are cleared, got %v", err)
+	}
+}
+
+func TestServer_ExpiredTokenTriggersLogin(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	if _, err := c.Projects().CreateProject(ctx, client.CreateProjectRequest{Name: "before"}); err != nil {
+		t.Fatalf("CreateProject failed: %v", err)
+	}
+
+	server.ExpireTokens()
+
+	if _, err := c.Projects().CreateProject(ctx, client.CreateProjectRequest{Name: "after"}); err != nil {
+		t.Fatalf("CreateProject after token expiry failed: %v", err)
+	}
+	if got := server.RequestCount(http.MethodPost, "/v2/login"); got != 2 {
+		t.Errorf("Expected 2 logins, got %d", got)
+	}
+}
+
+func TestServer_Latency(t *testing.T) {
+	server := NewServer(WithLatency(time.Second))
+	defer server.Close()
+	c := newTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := c.Projects().GetProject(ctx, "any"); err == nil {
+		t.Fatal("Expected the request to time out")
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected the request to be cancelled before the response, took %v", elapsed)
+	}
+}
+
+func TestServer_Users(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	aliceID := server.AddUser(client.User{Email: "alice@example.com", Name: "Alice Smith", Role: "admin"})
+	server.AddUser(client.User{Email: "bob@example.com", Name: "Bob Smith", Role: "viewer"})
+	server.AddUser(client.User{Email: "carol@example.org", Name: "Carol Jones", Role: "viewer"})
+
+	users, err := client.Collect(c.Users().AllUsers(ctx, &client.ListUsersRequest{Name: "smith", Role: "viewer"}), 0)
+	if err != nil {
+		t.Fatalf("AllUsers failed: %v", err)
+	}
+	if len(users) != 1 || users[0].Email != "bob@example.com" {
+		t.Errorf("Expected only bob, got %+v", users)
+	}
+
+	user, err := c.Users().GetUserByEmail(ctx, "Alice@Example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail failed: %v", err)
+	}
+	if user.ID != aliceID || user.Status != "active" {
+		t.Errorf("Unexpected user %+v", user)
+	}
+
+	if _, err := c.Users().GetUser(ctx, aliceID); err != nil {
+		t.Errorf("GetUser failed: %v", err)
+	}
+	if _, err := c.Users().GetUserByEmail(ctx, "dave@example.com"); !client.IsNotFound(err) {
+		t.Errorf("Expected not found for an unknown email, got %v", err)
+	}
+}
+
+func TestServer_Notifications(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	created, err := c.Notifications().CreateNotification(ctx, client.NotificationRequest{
+		Name:           "On-call",
+		Type:           client.NotificationTypePagerDuty,
+		IntegrationKey: "secret-key",
+	})
+	if err != nil {
+		t.Fatalf("CreateNotification failed: %v", err)
+	}
+	if created.ID == "" || created.IntegrationKey != "" {
+		t.Errorf("Expected an ID and no integration key, got %+v", created)
+	}
+
+	if _, err := c.Notifications().CreateNotification(ctx, client.NotificationRequest{Name: "sms", Type: "sms"}); client.StatusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an unsupported type, got %v", err)
+	}
+	if _, err := c.Notifications().UpdateNotification(ctx, created.ID, client.NotificationRequest{Name: "On-call", Type: client.NotificationTypeOpsgenie}); client.StatusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected 400 when changing the type, got %v", err)
+	}
+
+	c.Notifications().CreateNotification(ctx, client.NotificationRequest{Name: "Team emails", Type: client.NotificationTypeEmail, Emails: []string{"team@example.com"}})
+
+	notifications, err := client.Collect(c.Notifications().AllNotifications(ctx, &client.ListNotificationsRequest{Name: "on-call"}), 0)
+	if err != nil {
+		t.Fatalf("AllNotifications failed: %v", err)
+	}
+	if len(notifications) != 1 || notifications[0].ID != created.ID {
+		t.Errorf("Expected only the on-call channel, got %+v", notifications)
+	}
+
+	if err := c.Notifications().DeleteNotification(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteNotification failed: %v", err)
+	}
+	if _, err := c.Notifications().GetNotification(ctx, created.ID); !client.IsNotFound(err) {
+		t.Errorf("Expected not found after delete, got %v", err)
+	}
+}
+
+func TestServer_Integrations(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	githubID := server.AddVcsIntegration(client.VcsIntegration{Name: "Main GitHub", Type: client.VcsTypeGithub})
+	server.AddVcsIntegration(client.VcsIntegration{Name: "Main GitLab", Type: client.VcsTypeGitlab})
+	server.AddCloudIntegration(client.CloudIntegration{Name: "production", Type: client.CloudTypeAWS, AccountID: "123456789012"})
+	server.AddCloudIntegration(client.CloudIntegration{Name: "production", Type: client.CloudTypeAzure, AccountID: "subscription-1"})
+
+	vcs, err := client.Collect(c.Integrations().AllVcsIntegrations(ctx, &client.ListIntegrationsRequest{Name: "main", Type: string(client.VcsTypeGithub)}), 0)
+	if err != nil {
+		t.Fatalf("AllVcsIntegrations failed: %v", err)
+	}
+	if len(vcs) != 1 || vcs[0].ID != githubID || vcs[0].Status != "active" {
+		t.Errorf("Expected only the GitHub integration, got %+v", vcs)
+	}
+
+	cloud, err := client.Collect(c.Integrations().AllCloudIntegrations(ctx, &client.ListIntegrationsRequest{Name: "PRODUCTION"}), 0)
+	if err != nil {
+		t.Fatalf("AllCloudIntegrations failed: %v", err)
+	}
+	if len(cloud) != 2 {
+		t.Errorf("Expected both production integrations, got %+v", cloud)
+	}
+}
+
+func TestServer_BackupSnapshots(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	policyID := server.AddBackupPolicy(client.PolicyResponse{PolicyName: "production", Region: "us-east-1", ProviderType: "aws"})
+	baseID, _ := server.AddSnapshot(policyID, client.Snapshot{}, []SnapshotResource{
+		{ID: "bucket", Type: "aws_s3_bucket", Attributes: map[string]string{"versioning": "false"}},
+		{ID: "queue", Type: "aws_sqs_queue"},
+	})
+	server.AddSnapshot(policyID, client.Snapshot{Status: "failed"}, nil)
+	targetID, _ := server.AddSnapshot(policyID, client.Snapshot{}, []SnapshotResource{
+		{ID: "bucket", Type: "aws_s3_bucket", Attributes: map[string]string{"versioning": "true", "tags": "env=prod"}},
+		{ID: "table", Type: "aws_dynamodb_table"},
+	})
+
+	snapshots, err := client.Collect(c.BackupAndDr().AllSnapshots(ctx, policyID, &client.SnapshotListFilters{Status: "completed", PageSize: 1}), 0)
+	if err != nil {
+		t.Fatalf("AllSnapshots failed: %v", err)
+	}
+	if len(snapshots) != 2 || snapshots[0].SnapshotID != targetID || snapshots[1].SnapshotID != baseID {
+		t.Errorf("Expected the completed snapshots, most recent first, got %+v", snapshots)
+	}
+
+	policy, err := c.BackupAndDr().Get(ctx, policyID)
+	if err != nil {
+		t.Fatalf("Get backup policy failed: %v", err)
+	}
+	if policy.SnapshotsCount != 3 || policy.LastBackupSnapshotID != targetID {
+		t.Errorf("Expected the last backup to be recorded, got %+v", policy)
+	}
+
+	diff, err := c.BackupAndDr().DiffSnapshots(ctx, policyID, baseID, targetID)
+	if err != nil {
+		t.Fatalf("DiffSnapshots failed: %v", err)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].ResourceID != "table" ||
+		len(diff.Removed) != 1 || diff.Removed[0].ResourceID != "queue" ||
+		len(diff.Modified) != 1 || strings.Join(diff.Modified[0].ChangedAttributes, ",") != "tags,versioning" {
+		t.Errorf("Unexpected diff %+v", diff)
+	}
+
+	if _, err := c.BackupAndDr().DiffSnapshots(ctx, policyID, "missing", targetID); !client.IsNotFound(err) {
+		t.Errorf("Expected a not found error for an unknown base snapshot, got %v", err)
+	}
+}
+
+func TestServer_BackupRestores(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	policyID := server.AddBackupPolicy(client.PolicyResponse{PolicyName: "production", IntegrationID: "integration-1", Region: "us-east-1", ProviderType: "aws", AutoCreatePR: true})
+	snapshotID, _ := server.AddSnapshot(policyID, client.Snapshot{}, nil)
+
+	if _, err := c.BackupAndDr().StartRestore(ctx, policyID, &client.RestoreRequest{SnapshotID: "missing"}); !client.IsNotFound(err) {
+		t.Errorf("Expected not found for an unknown snapshot, got %v", err)
+	}
+
+	restore, err := c.BackupAndDr().StartRestore(ctx, policyID, &client.RestoreRequest{SnapshotID: snapshotID, TargetRegion: "us-west-2"})
+	if err != nil {
+		t.Fatalf("StartRestore failed: %v", err)
+	}
+	if restore.TargetAccount != "integration-1" || restore.TargetRegion != "us-west-2" || !restore.AutoCreatePR {
+		t.Errorf("Expected the target account and auto PR of the policy, got %+v", restore)
+	}
+
+	// The restore moves on to its next status every time it is read
+	var statuses []string
+	for i := 0; i < 3; i++ {
+		restore, err = c.BackupAndDr().GetRestore(ctx, policyID, restore.RestoreID)
+		if err != nil {
+			t.Fatalf("GetRestore failed: %v", err)
+		}
+		statuses = append(statuses, restore.Status)
+	}
+	if statuses[0] != "running" || statuses[1] != "completed" || statuses[2] != "completed" {
+		t.Errorf("Unexpected statuses: %v", statuses)
+	}
+	if restore.PullRequestURL == "" || restore.CompletedAt == "" {
+		t.Errorf("Expected the completed restore to open a pull request, got %+v", restore)
+	}
+}
+
+func TestServer_TriggerBackup(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	policyID := server.AddBackupPolicy(client.PolicyResponse{PolicyName: "production", Region: "us-east-1", ProviderType: "aws"})
+	previousID, _ := server.AddSnapshot(policyID, client.Snapshot{}, []SnapshotResource{{ID: "bucket", Type: "aws_s3_bucket"}})
+
+	snapshot, err := c.BackupAndDr().TriggerBackup(ctx, policyID)
+	if err != nil {
+		t.Fatalf("TriggerBackup failed: %v", err)
+	}
+	if snapshot.Status != client.SnapshotStatusRunning || snapshot.Trigger != "on_demand" || snapshot.ResourcesCount != 1 {
+		t.Errorf("Unexpected snapshot %+v", snapshot)
+	}
+	if _, err := c.BackupAndDr().TriggerBackup(ctx, policyID); client.StatusCode(err) != http.StatusConflict {
+		t.Errorf("Expected a conflict while the backup runs, got %v", err)
+	}
+
+	// The backup completes the next time the policy is read
+	policy, err := c.BackupAndDr().Get(ctx, policyID)
+	if err != nil {
+		t.Fatalf("Get backup policy failed: %v", err)
+	}
+	if policy.LastBackupSnapshotID != snapshot.SnapshotID || policy.LastBackupStatus != client.SnapshotStatusCompleted || policy.SnapshotsCount != 2 {
+		t.Errorf("Expected the on-demand backup to be the completed last backup, got %+v", policy)
+	}
+
+	diff, err := c.BackupAndDr().DiffSnapshots(ctx, policyID, previousID, snapshot.SnapshotID)
+	if err != nil {
+		t.Fatalf("DiffSnapshots failed: %v", err)
+	}
+	if len(diff.Added)+len(diff.Removed)+len(diff.Modified) != 0 {
+		t.Errorf("Expected no change since the previous backup, got %+v", diff)
+	}
+}
+
+func TestServer_BackupPolicyFacets(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+
+	server.AddBackupPolicy(client.PolicyResponse{PolicyName: "a", IntegrationID: "integration-1", Region: "us-east-1", ProviderType: "aws"})
+	server.AddBackupPolicy(client.PolicyResponse{PolicyName: "b", IntegrationID: "integration-2", Region: "us-east-1", ProviderType: "aws"})
+	server.AddBackupPolicy(client.PolicyResponse{PolicyName: "c", IntegrationID: "integration-2", Region: "eu-west-1", ProviderType: "aws", Status: "Inactive"})
+
+	result, err := c.BackupAndDr().List(context.Background(), &client.PolicyListFilters{Status: "Active", PageSize: 1})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	// The facets count every matching policy, not only the page
+	facets := make(map[string][]client.FacetValue)
+	for _, facet := range result.Facets {
+		facets[facet.Field] = facet.Values
+	}
+	if regions := facets["region"]; len(regions) != 1 || regions[0] != (client.FacetValue{Value: "us-east-1", Count: 2}) {
+		t.Errorf("Unexpected region facet %+v", regions)
+	}
+	if integrations := facets["integration_id"]; len(integrations) != 2 || integrations[0].Value != "integration-1" {
+		t.Errorf("Unexpected integration_id facet %+v", integrations)
+	}
+}
+
+func TestServer_GovernancePolicyFilters(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	builtinID := server.AddGovernancePolicy(client.GovernancePolicy{Name: "S3 bucket without encryption", Type: []string{"aws_s3_bucket"}, ProviderIDs: []string{"aws_all"}, Frameworks: []string{"SOC2"}, Severity: 5, IsDefault: true})
+	server.AddGovernancePolicy(client.GovernancePolicy{Name: "Untagged bucket", Type: []string{"aws_s3_bucket"}, ProviderIDs: []string{"aws_all"}, Severity: 3})
+	server.AddGovernancePolicy(client.GovernancePolicy{Name: "Public storage account", Type: []string{"azurerm_storage_account"}, ProviderIDs: []string{"azurerm_all"}, Frameworks: []string{"SOC2"}, Severity: 5})
+
+	isDefault := true
+	tests := map[string]struct {
+		request client.GovernancePolicyListRequest
+		want    int
+	}{
+		"frameworks": {client.GovernancePolicyListRequest{Frameworks: []string{"SOC2", "PCI"}}, 2},
+		"type":       {client.GovernancePolicyListRequest{Type: []string{"aws_s3_bucket"}}, 2},
+		"providers":  {client.GovernancePolicyListRequest{Providers: []string{"azurerm_all"}}, 1},
+		"is default": {client.GovernancePolicyListRequest{IsDefault: &isDefault}, 1},
+		"combined":   {client.GovernancePolicyListRequest{Frameworks: []string{"SOC2"}, Severity: []int{5}, Providers: []string{"aws_all"}}, 1},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			policies, err := client.Collect(c.GovernancePolicies().All(ctx, &test.request), 0)
+			if err != nil {
+				t.Fatalf("List failed: %v", err)
+			}
+			if len(policies) != test.want {
+				t.Errorf("Expected %d policies, got %d (%+v)", test.want, len(policies), policies)
+			}
+		})
+	}
+
+	policy, err := c.GovernancePolicies().Get(ctx, builtinID)
+	if err != nil || !policy.IsDefault {
+		t.Errorf("Expected the built-in policy, got %+v (%v)", policy, err)
+	}
+}
+
+func TestServer_GovernancePolicyExclusions(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	ctx := context.Background()
+
+	policyID := server.AddGovernancePolicy(client.GovernancePolicy{Name: "S3 bucket without encryption", IsDefault: true})
+
+	if _, err := c.GovernancePolicies().CreateExclusion(ctx, policyID, &client.GovernancePolicyExclusionRequest{Assets: []string{"arn:aws:s3:::logs"}}); client.StatusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected a 400 without justification, got %v", err)
+	}
+
+	exclusion, err := c.GovernancePolicies().CreateExclusion(ctx, policyID, &client.GovernancePolicyExclusionRequest{
+		Assets:        []string{"arn:aws:s3:::logs"},
+		Justification: "Access logs are not sensitive",
+		ExpiresAt:     "2030-01-01T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("CreateExclusion failed: %v", err)
+	}
+	if exclusion.PolicyID != policyID || exclusion.CreatedBy != DefaultAccessKey {
+		t.Errorf("Unexpected exclusion %+v", exclusion)
+	}
+
+	updated, err := c.GovernancePolicies().UpdateExclusion(ctx, policyID, exclusion.ID, &client.GovernancePolicyExclusionRequest{
+		ResourceTypes: []string{"aws_s3_bucket"},
+		Justification: "Buckets are encrypted by default",
+	})
+	if err != nil || updated.ExpiresAt != "" || len(updated.Assets) != 0 || updated.CreatedAt != exclusion.CreatedAt {
+		t.Errorf("Expected the exclusion to be replaced, got %+v (%v)", updated, err)
+	}
+
+	if _, err := c.GovernancePolicies().GetExclusion(ctx, "other-policy", exclusion.ID); !client.IsNotFound(err) {
+		t.Errorf("Expected the exclusion to belong to its policy only, got %v", err)
+	}
+
+	// Deleting the policy deletes its exclusions
+	if err := c.GovernancePolicies().Delete(ctx, policyID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := c.GovernancePolicies().GetExclusion(ctx, policyID, exclusion.ID); !client.IsNotFound(err) {
+		t.Errorf("Expected the exclusion to be deleted, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package fakefirefly
+
+import (
+	"net/http"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerUserRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v2/users", s.listUsers)
+	mux.HandleFunc("GET /v2/users/{id}", s.getUser)
+}
+
+// AddUser stores a user of the account, as users join by invitation and the API has no endpoint
+// to create one, and returns its ID. An ID is generated when user.ID is empty, and the status
+// defaults to active.
+func (s *Server) AddUser(user client.User) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if user.ID == "" {
+		user.ID = s.newID()
+	}
+	if user.Status == "" {
+		user.Status = "active"
+	}
+	s.users.put(user.ID, user)
+
+	return user.ID
+}
+
+// listUsers serves the offset based user list, filtered by email, name and role
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	email, name, role := query.Get("email"), query.Get("name"), query.Get("role")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := s.users.list(func(u client.User) bool {
+		return (email == "" || containsFold(u.Email, email)) &&
+			(name == "" || containsFold(u.Name, name)) &&
+			(role == "" || u.Role == role)
+	})
+
+	writeJSON(w, http.StatusOK, client.UsersListResponse{
+		Data:       pageOf(users, queryInt(r, "offset", 0), queryInt(r, "pageSize", 20)),
+		TotalCount: len(users),
+	})
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
+}
@@ -0,0 +1,186 @@
+package fakefirefly
+
+import (
+	"net/http"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerVariableSetRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v2/runners/variables/variable-sets", s.createVariableSet)
+	mux.HandleFunc("GET /v2/runners/variables/variable-sets", s.listVariableSets)
+	mux.HandleFunc("GET /v2/runners/variables/variable-sets/{id}", s.getVariableSet)
+	mux.HandleFunc("PUT /v2/runners/variables/variable-sets/{id}", s.updateVariableSet)
+	mux.HandleFunc("DELETE /v2/runners/variables/variable-sets/{id}", s.deleteVariableSet)
+	mux.HandleFunc("POST /v2/runners/variables/variable-sets/{id}/variables", s.upsertVariables)
+	mux.HandleFunc("DELETE /v2/runners/variables/variable-sets/{id}/variables", s.deleteVariables)
+}
+
+func (s *Server) createVariableSet(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateVariableSetRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, parentID := range req.Parents {
+		if _, ok := s.variableSets.get(parentID); !ok {
+			writeError(w, http.StatusBadRequest, "parent variable set not found")
+			return
+		}
+	}
+
+	variableSet := client.VariableSet{
+		ID:          s.newID(),
+		Version:     1,
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Parents:     req.Parents,
+		Variables:   req.Variables,
+	}
+	s.variableSets.put(variableSet.ID, variableSet)
+
+	writeJSON(w, http.StatusCreated, client.CreateVariableSetResponse{VariableSetID: variableSet.ID})
+}
+
+func (s *Server) getVariableSet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variableSet, ok := s.variableSets.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "variable set not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, variableSet)
+}
+
+// updateVariableSet replaces the variable set, the real API answers with an empty body
+func (s *Server) updateVariableSet(w http.ResponseWriter, r *http.Request) {
+	var req client.UpdateVariableSetRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variableSet, ok := s.variableSets.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "variable set not found")
+		return
+	}
+
+	if req.Name != "" {
+		variableSet.Name = req.Name
+	}
+	variableSet.Description = req.Description
+	variableSet.Labels = req.Labels
+	variableSet.Parents = req.Parents
+	variableSet.Variables = req.Variables
+	variableSet.Version++
+	s.variableSets.put(variableSet.ID, variableSet)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) deleteVariableSet(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.variableSets.delete(r.PathValue("id")) {
+		writeError(w, http.StatusNotFound, "variable set not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listVariableSets serves the offset based variable set list, filtered by name with searchQuery
+func (s *Server) listVariableSets(w http.ResponseWriter, r *http.Request) {
+	search := r.URL.Query().Get("searchQuery")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variableSets := s.variableSets.list(func(v client.VariableSet) bool {
+		return search == "" || containsFold(v.Name, search)
+	})
+	writeJSON(w, http.StatusOK, pageOf(variableSets, queryInt(r, "offset", 0), queryInt(r, "pageSize", 20)))
+}
+
+// upsertVariables creates or replaces the variables of the set, matched by key
+func (s *Server) upsertVariables(w http.ResponseWriter, r *http.Request) {
+	var req client.UpsertVariableSetVariablesRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variableSet, ok := s.variableSets.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "variable set not found")
+		return
+	}
+
+	for _, variable := range req.Variables {
+		replaced := false
+		for i := range variableSet.Variables {
+			if variableSet.Variables[i].Key == variable.Key {
+				variableSet.Variables[i] = variable
+				replaced = true
+			}
+		}
+		if !replaced {
+			variableSet.Variables = append(variableSet.Variables, variable)
+		}
+	}
+	variableSet.Version++
+	s.variableSets.put(variableSet.ID, variableSet)
+
+	writeJSON(w, http.StatusOK, variableSet.Variables)
+}
+
+// deleteVariables removes the variables of the set, identified by key
+func (s *Server) deleteVariables(w http.ResponseWriter, r *http.Request) {
+	var req client.DeleteVariablesRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variableSet, ok := s.variableSets.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "variable set not found")
+		return
+	}
+
+	remove := make(map[string]bool, len(req.VariableIDs))
+	for _, id := range req.VariableIDs {
+		remove[id] = true
+	}
+
+	var variables []client.Variable
+	for _, variable := range variableSet.Variables {
+		if !remove[variable.Key] {
+			variables = append(variables, variable)
+		}
+	}
+	variableSet.Variables = variables
+	variableSet.Version++
+	s.variableSets.put(variableSet.ID, variableSet)
+
+	w.WriteHeader(http.StatusNoContent)
+}
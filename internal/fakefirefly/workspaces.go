@@ -0,0 +1,150 @@
+package fakefirefly
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+func (s *Server) registerWorkspaceRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /workspaces/search", s.searchWorkspaces)
+	mux.HandleFunc("DELETE /workspaces/{id}", s.deleteWorkspace)
+	mux.HandleFunc("PUT /workspaces/{id}/labels", s.updateWorkspaceLabels)
+	mux.HandleFunc("POST /workspaces/{id}/runs/search", s.searchWorkspaceRuns)
+}
+
+// AddWorkspace stores a workspace, as the API has no endpoint to create one, and returns its ID.
+// An ID is generated when workspace.ID is empty, and WorkspaceID defaults to the ID.
+func (s *Server) AddWorkspace(workspace client.Workspace) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if workspace.ID == "" {
+		workspace.ID = s.newID()
+	}
+	if workspace.WorkspaceID == "" {
+		workspace.WorkspaceID = workspace.ID
+	}
+	if workspace.AccountID == "" {
+		workspace.AccountID = DefaultAccountID
+	}
+	s.workspaces.put(workspace.ID, workspace)
+
+	return workspace.ID
+}
+
+// AddWorkspaceRun stores a run of the workspace with the given ID
+func (s *Server) AddWorkspaceRun(workspaceID string, run client.WorkspaceRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if run.ID == "" {
+		run.ID = s.newID()
+	}
+	run.WorkspaceID = workspaceID
+	s.workspaceRuns[workspaceID] = append(s.workspaceRuns[workspaceID], run)
+}
+
+// searchWorkspaces serves the zero-based page search, filtered by name and labels
+func (s *Server) searchWorkspaces(w http.ResponseWriter, r *http.Request) {
+	var req client.ListWorkspacesRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspaces := s.workspaces.list(func(ws client.Workspace) bool {
+		if req.SearchValue != "" && !containsFold(ws.WorkspaceName, req.SearchValue) {
+			return false
+		}
+		if req.Filters == nil {
+			return true
+		}
+		if len(req.Filters.WorkspaceName) > 0 && !slices.Contains(req.Filters.WorkspaceName, ws.WorkspaceName) {
+			return false
+		}
+		for _, label := range req.Filters.Labels {
+			if !slices.Contains(ws.Labels, label) {
+				return false
+			}
+		}
+		return true
+	})
+
+	pageSize := queryInt(r, "pageSize", 20)
+	writeJSON(w, http.StatusOK, pageOf(workspaces, queryInt(r, "page", 0)*pageSize, pageSize))
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if !s.workspaces.delete(id) {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+	delete(s.workspaceRuns, id)
+
+	resp := client.DeleteWorkspaceResponse{Status: http.StatusOK}
+	resp.Data.Message = "workspace deleted"
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) updateWorkspaceLabels(w http.ResponseWriter, r *http.Request) {
+	var req client.UpdateWorkspaceLabelsRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	workspace, ok := s.workspaces.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	workspace.Labels = req.Labels
+	workspace.UpdatedAt = now()
+	s.workspaces.put(workspace.ID, workspace)
+
+	writeJSON(w, http.StatusOK, client.UpdateWorkspaceLabelsResponse{
+		ID:            workspace.ID,
+		WorkspaceName: workspace.WorkspaceName,
+		Labels:        workspace.Labels,
+		UpdatedAt:     workspace.UpdatedAt,
+	})
+}
+
+// searchWorkspaceRuns serves the zero-based page search of the runs of a workspace, filtered by status
+func (s *Server) searchWorkspaceRuns(w http.ResponseWriter, r *http.Request) {
+	var req client.ListWorkspaceRunsRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.workspaces.get(id); !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	runs := []client.WorkspaceRun{}
+	for _, run := range s.workspaceRuns[id] {
+		if req.Filters != nil && len(req.Filters.Status) > 0 && !slices.Contains(req.Filters.Status, run.Status) {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	pageSize := queryInt(r, "pageSize", 20)
+	writeJSON(w, http.StatusOK, pageOf(runs, queryInt(r, "page", 0)*pageSize, pageSize))
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultCredentialsProfile is the profile used when none is configured
+	defaultCredentialsProfile = "default"
+
+	envAccessKey       = "FIREFLY_ACCESS_KEY"
+	envSecretKey       = "FIREFLY_SECRET_KEY"
+	envAPIURL          = "FIREFLY_API_URL"
+	envProfile         = "FIREFLY_PROFILE"
+	envCredentialsFile = "FIREFLY_CREDENTIALS_FILE"
+)
+
+// defaultCredentialsFile is the shared credentials file location, relative to the home directory
+var defaultCredentialsFile = filepath.Join("~", ".firefly", "credentials")
+
+// credentialsProfile holds the settings of a single profile of the shared credentials file
+type credentialsProfile struct {
+	AccessKey string
+	SecretKey string
+	APIURL    string
+}
+
+// providerCredentials is the outcome of resolving the provider credentials from
+// the configuration, the environment and the shared credentials file
+type providerCredentials struct {
+	AccessKey string
+	SecretKey string
+	APIURL    string
+
+	// AccessKeySource and SecretKeySource describe where each key was found, for diagnostics
+	AccessKeySource string
+	SecretKeySource string
+}
+
+// loadCredentialsFile parses a shared credentials file made of INI style profiles:
+//
+//	[default]
+//	access_key = ...
+//	secret_key = ...
+//	api_url    = ...
+func loadCredentialsFile(path string) (map[string]credentialsProfile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := map[string]credentialsProfile{}
+	current := ""
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if current == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, lineNumber)
+			}
+			if _, ok := profiles[current]; !ok {
+				profiles[current] = credentialsProfile{}
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, lineNumber)
+		}
+		if current == "" {
+			return nil, fmt.Errorf("%s:%d: setting outside of a profile section", path, lineNumber)
+		}
+
+		profile := profiles[current]
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "access_key":
+			profile.AccessKey = value
+		case "secret_key":
+			profile.SecretKey = value
+		case "api_url":
+			profile.APIURL = value
+		}
+		profiles[current] = profile
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// expandHome replaces a leading "~" with the user's home directory
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not determine home directory: %w", err)
+	}
+
+	return filepath.Join(home, path[1:]), nil
+}
+
+// stringSetting returns the configured value, falling back to the environment variable
+func stringSetting(value types.String, env string) (string, string) {
+	if !value.IsNull() && value.ValueString() != "" {
+		return value.ValueString(), "provider configuration"
+	}
+	if v := os.Getenv(env); v != "" {
+		return v, fmt.Sprintf("%s environment variable", env)
+	}
+	return "", ""
+}
+
+// resolveCredentials determines the credentials to use, in order of precedence:
+// the provider configuration, FIREFLY_* environment variables, then the
+// selected profile of the shared credentials file.
+func resolveCredentials(config *FireflyProviderModel) (*providerCredentials, error) {
+	creds := &providerCredentials{}
+	creds.AccessKey, creds.AccessKeySource = stringSetting(config.AccessKey, envAccessKey)
+	creds.SecretKey, creds.SecretKeySource = stringSetting(config.SecretKey, envSecretKey)
+	creds.APIURL, _ = stringSetting(config.APIURL, envAPIURL)
+
+	profileName, _ := stringSetting(config.Profile, envProfile)
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		profileName = defaultCredentialsProfile
+	}
+
+	credentialsFile, _ := stringSetting(config.CredentialsFile, envCredentialsFile)
+	explicitFile := credentialsFile != ""
+	if !explicitFile {
+		credentialsFile = defaultCredentialsFile
+	}
+
+	// The credentials file is only needed if something is still missing
+	if creds.AccessKey != "" && creds.SecretKey != "" && creds.APIURL != "" && !explicitProfile {
+		return creds, nil
+	}
+
+	path, err := expandHome(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := loadCredentialsFile(path)
+	if err != nil {
+		// A missing default file is fine, the keys may be reported missing later
+		if os.IsNotExist(err) && !explicitProfile && !explicitFile {
+			return creds, nil
+		}
+		return nil, fmt.Errorf("could not read credentials file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[profileName]
+	if !ok {
+		if !explicitProfile {
+			return creds, nil
+		}
+		return nil, fmt.Errorf("profile %q not found in credentials file %s", profileName, path)
+	}
+
+	source := fmt.Sprintf("profile %q in %s", profileName, path)
+	if creds.AccessKey == "" && profile.AccessKey != "" {
+		creds.AccessKey, creds.AccessKeySource = profile.AccessKey, source
+	}
+	if creds.SecretKey == "" && profile.SecretKey != "" {
+		creds.SecretKey, creds.SecretKeySource = profile.SecretKey, source
+	}
+	if creds.APIURL == "" {
+		creds.APIURL = profile.APIURL
+	}
+
+	return creds, nil
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentialsFile = `# Firefly credentials
+[default]
+access_key = default-access
+secret_key = default-secret
+
+[staging]
+access_key = "staging-access"
+secret_key = staging-secret
+api_url    = https://staging.firefly.example
+`
+
+// writeCredentialsFile writes content to a credentials file in a temporary directory
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write credentials file: %v", err)
+	}
+	return path
+}
+
+// clearFireflyEnv makes sure the tests don't pick up credentials from the environment
+func clearFireflyEnv(t *testing.T) {
+	t.Helper()
+
+	for _, env := range []string{envAccessKey, envSecretKey, envAPIURL, envProfile, envCredentialsFile} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("HOME", t.TempDir())
+}
+
+func TestLoadCredentialsFile(t *testing.T) {
+	path := writeCredentialsFile(t, testCredentialsFile)
+
+	profiles, err := loadCredentialsFile(path)
+	if err != nil {
+		t.Fatalf("loadCredentialsFile failed: %v", err)
+	}
+
+	if len(profiles) != 2 {
+		t.Fatalf("Expected 2 profiles, got %d", len(profiles))
+	}
+
+	staging := profiles["staging"]
+	if staging.AccessKey != "staging-access" {
+		t.Errorf("Expected quotes to be trimmed, got '%s'", staging.AccessKey)
+	}
+	if staging.APIURL != "https://staging.firefly.example" {
+		t.Errorf("Expected staging API URL, got '%s'", staging.APIURL)
+	}
+}
+
+func TestLoadCredentialsFile_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"setting outside profile": "access_key = x\n",
+		"missing equals":          "[default]\naccess_key\n",
+		"empty profile name":      "[ ]\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := loadCredentialsFile(writeCredentialsFile(t, content)); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestResolveCredentials_Precedence(t *testing.T) {
+	clearFireflyEnv(t)
+	t.Setenv(envCredentialsFile, writeCredentialsFile(t, testCredentialsFile))
+	t.Setenv(envSecretKey, "env-secret")
+
+	config := &FireflyProviderModel{
+		AccessKey: types.StringValue("config-access"),
+	}
+
+	creds, err := resolveCredentials(config)
+	if err != nil {
+		t.Fatalf("resolveCredentials failed: %v", err)
+	}
+
+	if creds.AccessKey != "config-access" || creds.AccessKeySource != "provider configuration" {
+		t.Errorf("Expected access key from the configuration, got '%s' from %s", creds.AccessKey, creds.AccessKeySource)
+	}
+	if creds.SecretKey != "env-secret" || !strings.Contains(creds.SecretKeySource, envSecretKey) {
+		t.Errorf("Expected secret key from the environment, got '%s' from %s", creds.SecretKey, creds.SecretKeySource)
+	}
+}
+
+func TestResolveCredentials_Profile(t *testing.T) {
+	clearFireflyEnv(t)
+	path := writeCredentialsFile(t, testCredentialsFile)
+
+	config := &FireflyProviderModel{
+		Profile:         types.StringValue("staging"),
+		CredentialsFile: types.StringValue(path),
+	}
+
+	creds, err := resolveCredentials(config)
+	if err != nil {
+		t.Fatalf("resolveCredentials failed: %v", err)
+	}
+
+	if creds.AccessKey != "staging-access" || creds.SecretKey != "staging-secret" {
+		t.Errorf("Expected staging keys, got '%s'/'%s'", creds.AccessKey, creds.SecretKey)
+	}
+	if creds.APIURL != "https://staging.firefly.example" {
+		t.Errorf("Expected staging API URL, got '%s'", creds.APIURL)
+	}
+	if !strings.Contains(creds.AccessKeySource, `profile "staging"`) {
+		t.Errorf("Expected source to name the profile, got %s", creds.AccessKeySource)
+	}
+}
+
+func TestResolveCredentials_ProfileFromEnvironment(t *testing.T) {
+	clearFireflyEnv(t)
+	t.Setenv(envCredentialsFile, writeCredentialsFile(t, testCredentialsFile))
+	t.Setenv(envProfile, "staging")
+
+	creds, err := resolveCredentials(&FireflyProviderModel{})
+	if err != nil {
+		t.Fatalf("resolveCredentials failed: %v", err)
+	}
+
+	if creds.AccessKey != "staging-access" {
+		t.Errorf("Expected staging access key, got '%s'", creds.AccessKey)
+	}
+}
+
+func TestResolveCredentials_DefaultProfileInHome(t *testing.T) {
+	clearFireflyEnv(t)
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.MkdirAll(filepath.Join(home, ".firefly"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".firefly", "credentials"), []byte(testCredentialsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := resolveCredentials(&FireflyProviderModel{})
+	if err != nil {
+		t.Fatalf("resolveCredentials failed: %v", err)
+	}
+
+	if creds.AccessKey != "default-access" || creds.SecretKey != "default-secret" {
+		t.Errorf("Expected default profile keys, got '%s'/'%s'", creds.AccessKey, creds.SecretKey)
+	}
+}
+
+func TestResolveCredentials_MissingDefaultFileIsIgnored(t *testing.T) {
+	clearFireflyEnv(t)
+
+	creds, err := resolveCredentials(&FireflyProviderModel{})
+	if err != nil {
+		t.Fatalf("resolveCredentials failed: %v", err)
+	}
+
+	if creds.AccessKey != "" || creds.SecretKey != "" {
+		t.Errorf("Expected no credentials, got '%s'/'%s'", creds.AccessKey, creds.SecretKey)
+	}
+}
+
+func TestResolveCredentials_UnknownProfile(t *testing.T) {
+	clearFireflyEnv(t)
+
+	config := &FireflyProviderModel{
+		Profile:         types.StringValue("production"),
+		CredentialsFile: types.StringValue(writeCredentialsFile(t, testCredentialsFile)),
+	}
+
+	_, err := resolveCredentials(config)
+	if err == nil || !strings.Contains(err.Error(), `profile "production" not found`) {
+		t.Errorf("Expected profile not found error, got %v", err)
+	}
+}
+
+func TestResolveCredentials_MissingExplicitFile(t *testing.T) {
+	clearFireflyEnv(t)
+
+	config := &FireflyProviderModel{
+		CredentialsFile: types.StringValue(filepath.Join(t.TempDir(), "missing")),
+	}
+
+	if _, err := resolveCredentials(config); err == nil {
+		t.Error("Expected error for a missing credentials file")
+	}
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BackupAndDrApplicationDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &BackupAndDrApplicationDataSource{}
+
+// NewBackupAndDrApplicationDataSource creates a new backup and DR application data source
+func NewBackupAndDrApplicationDataSource() datasource.DataSource {
+	return &BackupAndDrApplicationDataSource{}
+}
+
+// BackupAndDrApplicationDataSource defines the data source implementation
+type BackupAndDrApplicationDataSource struct {
+	client client.API
+}
+
+// BackupAndDrApplicationDetailsDataSourceModel describes a single application with its scope and VCS settings
+type BackupAndDrApplicationDetailsDataSourceModel struct {
+	BackupAndDrApplicationDataSourceModel
+	Scope []ScopeModel `tfsdk:"scope"`
+	VCS   *VCSModel    `tfsdk:"vcs"`
+}
+
+func (d *BackupAndDrApplicationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_and_dr_application"
+}
+
+func (d *BackupAndDrApplicationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := backupAndDrApplicationAttributes()
+	attributes["application_id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique identifier of the backup application",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["application_name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the backup application (alternative to `application_id`), it must match exactly one application",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["scope"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Resource scope configurations for backup targeting",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Scope type (tags, resource_group, asset_types, excluded_asset_types, selected_resources, excluded_resources)",
+					Computed:            true,
+				},
+				"value": schema.ListAttribute{
+					MarkdownDescription: "List of values for this scope type",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+	attributes["vcs"] = schema.SingleNestedAttribute{
+		MarkdownDescription: "VCS integration configuration for backup artifacts",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"vcs_integration_id": schema.StringAttribute{
+				MarkdownDescription: "VCS integration ID",
+				Computed:            true,
+			},
+			"repo_id": schema.StringAttribute{
+				MarkdownDescription: "Repository ID for storing backups",
+				Computed:            true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for retrieving a single Firefly Backup & DR application by ID or name, including its scope and VCS settings",
+		Attributes:          attributes,
+	}
+}
+
+func (d *BackupAndDrApplicationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("application_id"),
+			path.MatchRoot("application_name"),
+		),
+	}
+}
+
+func (d *BackupAndDrApplicationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupAndDrApplicationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupAndDrApplicationDetailsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *client.PolicyResponse
+	var err error
+	if applicationID := data.ApplicationID.ValueString(); applicationID != "" {
+		tflog.Debug(ctx, "Reading backup application by ID", map[string]interface{}{"application_id": applicationID})
+		policy, err = d.client.BackupAndDr().Get(ctx, applicationID)
+	} else {
+		tflog.Debug(ctx, "Reading backup application by name", map[string]interface{}{"application_name": data.ApplicationName.ValueString()})
+		policy, err = d.findApplicationByName(ctx, data.ApplicationName.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Backup application not found", fmt.Sprintf("No backup application matches %s", describeBackupAndDrApplicationLookup(data)))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading backup application", fmt.Sprintf("Could not read backup application %s: %s", describeBackupAndDrApplicationLookup(data), err))
+		return
+	}
+
+	data.BackupAndDrApplicationDataSourceModel = newBackupAndDrApplicationDataSourceModel(*policy)
+
+	// The resource model holds the same scope and VCS settings
+	var details BackupAndDrApplicationResourceModel
+	if err := mapAPIResponseToModel(policy, &details); err != nil {
+		resp.Diagnostics.AddError("Error reading backup application", fmt.Sprintf("Could not convert backup application %s: %s", policy.PolicyID, err))
+		return
+	}
+	data.Scope = details.Scope
+	data.VCS = details.VCS
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findApplicationByName returns the only application named name, the API doesn't filter by name
+func (d *BackupAndDrApplicationDataSource) findApplicationByName(ctx context.Context, name string) (*client.PolicyResponse, error) {
+	policies, err := client.Collect(client.Filter(d.client.BackupAndDr().All(ctx, nil), func(policy client.PolicyResponse) bool {
+		return policy.PolicyName == name
+	}), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(policies) {
+	case 0:
+		return nil, client.ErrNotFound
+	case 1:
+		return &policies[0], nil
+	default:
+		return nil, fmt.Errorf("several applications are named %q, use application_id instead", name)
+	}
+}
+
+// describeBackupAndDrApplicationLookup returns the ID or the name an application is looked up with, for messages
+func describeBackupAndDrApplicationLookup(data BackupAndDrApplicationDetailsDataSourceModel) string {
+	if data.ApplicationID.ValueString() != "" {
+		return "ID " + data.ApplicationID.ValueString()
+	}
+	return "name " + data.ApplicationName.ValueString()
+}
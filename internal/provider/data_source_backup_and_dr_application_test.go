@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupAndDrApplicationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "firefly_backup_and_dr_application" "by_id" {
+  application_id = %q
+}
+
+data "firefly_backup_and_dr_application" "by_name" {
+  application_name = data.firefly_backup_and_dr_application.by_id.application_name
+}
+`, testAccBackupApplicationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_application.by_id", "application_name", "Test Backup Application"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_application.by_id", "last_backup_snapshot_id", testAccTargetSnapshotID),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_application.by_name", "application_id", testAccBackupApplicationID),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupAndDrApplicationDataSource_ByName(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.all = func(ctx context.Context, filters *client.PolicyListFilters) iter.Seq2[client.PolicyResponse, error] {
+		return sliceSeq(
+			client.PolicyResponse{PolicyID: "application-1", PolicyName: "production-eu"},
+			client.PolicyResponse{
+				PolicyID:   "application-2",
+				PolicyName: "production",
+				Scope:      []client.ScopeConfig{{Type: "tags", Value: []string{"env:production"}}},
+				VCS:        &client.VCSConfig{VCSIntegrationID: "vcs-1", RepoID: "acme/backups"},
+			},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewBackupAndDrApplicationDataSource(), api, &BackupAndDrApplicationDetailsDataSourceModel{
+		BackupAndDrApplicationDataSourceModel: BackupAndDrApplicationDataSourceModel{
+			ApplicationName: types.StringValue("production"),
+		},
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data BackupAndDrApplicationDetailsDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if data.ApplicationID.ValueString() != "application-2" {
+		t.Errorf("Expected application-2, got %s", data.ApplicationID)
+	}
+	if len(data.Scope) != 1 || data.Scope[0].Type.ValueString() != "tags" || len(data.Scope[0].Value.Elements()) != 1 {
+		t.Errorf("Unexpected scope %+v", data.Scope)
+	}
+	if data.VCS == nil || data.VCS.RepoID.ValueString() != "acme/backups" {
+		t.Errorf("Unexpected vcs %+v", data.VCS)
+	}
+}
+
+func TestBackupAndDrApplicationDataSource_AmbiguousName(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.all = func(ctx context.Context, filters *client.PolicyListFilters) iter.Seq2[client.PolicyResponse, error] {
+		return sliceSeq(
+			client.PolicyResponse{PolicyID: "application-1", PolicyName: "production"},
+			client.PolicyResponse{PolicyID: "application-2", PolicyName: "production"},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewBackupAndDrApplicationDataSource(), api, &BackupAndDrApplicationDetailsDataSourceModel{
+		BackupAndDrApplicationDataSourceModel: BackupAndDrApplicationDataSourceModel{
+			ApplicationName: types.StringValue("production"),
+		},
+	})
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "application_id") {
+		t.Errorf("Expected an error suggesting application_id, got %v", resp.Diagnostics)
+	}
+}
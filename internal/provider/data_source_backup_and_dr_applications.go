@@ -22,7 +22,7 @@ func NewBackupAndDrApplicationsDataSource() datasource.DataSource {
 
 // BackupAndDrApplicationsDataSource defines the data source implementation
 type BackupAndDrApplicationsDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 func (d *BackupAndDrApplicationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,6 +34,7 @@ func (d *BackupAndDrApplicationsDataSource) Schema(ctx context.Context, req data
 		MarkdownDescription: "Data source for retrieving Firefly Backup & DR applications",
 
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("applications"),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The data source identifier",
 				Computed:            true,
@@ -61,95 +62,34 @@ func (d *BackupAndDrApplicationsDataSource) Schema(ctx context.Context, req data
 			"applications": schema.ListNestedAttribute{
 				MarkdownDescription: "List of backup applications",
 				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: backupAndDrApplicationAttributes(),
+				},
+			},
+			"facets": schema.ListNestedAttribute{
+				MarkdownDescription: "The values of the `status`, `region`, `provider_type` and `integration_id` fields of the applications matching the filters, with the number of applications having each value",
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"application_id": schema.StringAttribute{
-							MarkdownDescription: "The unique identifier of the backup application",
-							Computed:            true,
-						},
-						"account_id": schema.StringAttribute{
-							MarkdownDescription: "The account ID",
-							Computed:            true,
-						},
-						"application_name": schema.StringAttribute{
-							MarkdownDescription: "The name of the backup application",
-							Computed:            true,
-						},
-						"integration_id": schema.StringAttribute{
-							MarkdownDescription: "The integration ID",
-							Computed:            true,
-						},
-						"region": schema.StringAttribute{
-							MarkdownDescription: "The cloud region",
-							Computed:            true,
-						},
-						"provider_type": schema.StringAttribute{
-							MarkdownDescription: "The cloud provider type",
-							Computed:            true,
-						},
-						"description": schema.StringAttribute{
-							MarkdownDescription: "Description of the backup application",
-							Computed:            true,
-						},
-						"frequency": schema.Int64Attribute{
-							MarkdownDescription: "Hours between scheduled backups (4, 8, 16, or 24)",
-							Computed:            true,
-						},
-						"notification_id": schema.StringAttribute{
-							MarkdownDescription: "Notification channel ID",
-							Computed:            true,
-						},
-						"restore_instructions": schema.StringAttribute{
-							MarkdownDescription: "Restore instructions",
+						"field": schema.StringAttribute{
+							MarkdownDescription: "The name of the field",
 							Computed:            true,
 						},
-						"target_account": schema.StringAttribute{
-							MarkdownDescription: "Target account/integration ID where the restore should land",
-							Computed:            true,
-						},
-						"target_region": schema.StringAttribute{
-							MarkdownDescription: "Target region where the restore should land",
-							Computed:            true,
-						},
-						"auto_create_pr": schema.BoolAttribute{
-							MarkdownDescription: "If true, the restore flow automatically opens a VCS pull request",
-							Computed:            true,
-						},
-						"resilience_enabled": schema.BoolAttribute{
-							MarkdownDescription: "When true, DR scheduling applies",
-							Computed:            true,
-						},
-						"status": schema.StringAttribute{
-							MarkdownDescription: "Current status of the application",
-							Computed:            true,
-						},
-						"snapshots_count": schema.Int64Attribute{
-							MarkdownDescription: "Number of snapshots",
-							Computed:            true,
-						},
-						"last_backup_snapshot_id": schema.StringAttribute{
-							MarkdownDescription: "ID of the last backup snapshot",
-							Computed:            true,
-						},
-						"last_backup_time": schema.StringAttribute{
-							MarkdownDescription: "Timestamp of the last backup",
-							Computed:            true,
-						},
-						"last_backup_status": schema.StringAttribute{
-							MarkdownDescription: "Status of the last backup",
-							Computed:            true,
-						},
-						"next_backup_time": schema.StringAttribute{
-							MarkdownDescription: "Timestamp of the next backup",
-							Computed:            true,
-						},
-						"created_at": schema.StringAttribute{
-							MarkdownDescription: "Creation timestamp",
-							Computed:            true,
-						},
-						"updated_at": schema.StringAttribute{
-							MarkdownDescription: "Last update timestamp",
+						"values": schema.ListNestedAttribute{
+							MarkdownDescription: "The values of the field, with their number of applications",
 							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value of the field",
+										Computed:            true,
+									},
+									"count": schema.Int64Attribute{
+										MarkdownDescription: "The number of applications with this value",
+										Computed:            true,
+									},
+								},
+							},
 						},
 					},
 				},
@@ -158,17 +98,112 @@ func (d *BackupAndDrApplicationsDataSource) Schema(ctx context.Context, req data
 	}
 }
 
+// backupAndDrApplicationAttributes returns the computed attributes of an application, shared by
+// the single and plural data sources
+func backupAndDrApplicationAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"application_id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the backup application",
+			Computed:            true,
+		},
+		"account_id": schema.StringAttribute{
+			MarkdownDescription: "The account ID",
+			Computed:            true,
+		},
+		"application_name": schema.StringAttribute{
+			MarkdownDescription: "The name of the backup application",
+			Computed:            true,
+		},
+		"integration_id": schema.StringAttribute{
+			MarkdownDescription: "The integration ID",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "The cloud region",
+			Computed:            true,
+		},
+		"provider_type": schema.StringAttribute{
+			MarkdownDescription: "The cloud provider type",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the backup application",
+			Computed:            true,
+		},
+		"frequency": schema.Int64Attribute{
+			MarkdownDescription: "Hours between scheduled backups (4, 8, 16, or 24)",
+			Computed:            true,
+		},
+		"notification_id": schema.StringAttribute{
+			MarkdownDescription: "Notification channel ID",
+			Computed:            true,
+		},
+		"restore_instructions": schema.StringAttribute{
+			MarkdownDescription: "Restore instructions",
+			Computed:            true,
+		},
+		"target_account": schema.StringAttribute{
+			MarkdownDescription: "Target account/integration ID where the restore should land",
+			Computed:            true,
+		},
+		"target_region": schema.StringAttribute{
+			MarkdownDescription: "Target region where the restore should land",
+			Computed:            true,
+		},
+		"auto_create_pr": schema.BoolAttribute{
+			MarkdownDescription: "If true, the restore flow automatically opens a VCS pull request",
+			Computed:            true,
+		},
+		"resilience_enabled": schema.BoolAttribute{
+			MarkdownDescription: "When true, DR scheduling applies",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Current status of the application",
+			Computed:            true,
+		},
+		"snapshots_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of snapshots",
+			Computed:            true,
+		},
+		"last_backup_snapshot_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the last backup snapshot",
+			Computed:            true,
+		},
+		"last_backup_time": schema.StringAttribute{
+			MarkdownDescription: "Timestamp of the last backup",
+			Computed:            true,
+		},
+		"last_backup_status": schema.StringAttribute{
+			MarkdownDescription: "Status of the last backup",
+			Computed:            true,
+		},
+		"next_backup_time": schema.StringAttribute{
+			MarkdownDescription: "Timestamp of the next backup",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Creation timestamp",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Last update timestamp",
+			Computed:            true,
+		},
+	}
+}
+
 func (d *BackupAndDrApplicationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -215,7 +250,7 @@ func (d *BackupAndDrApplicationsDataSource) Read(ctx context.Context, req dataso
 	})
 
 	// Get policies
-	policies, err := d.client.BackupAndDr.List(filters)
+	policies, err := client.Collect(d.client.BackupAndDr().All(ctx, filters), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading backup applications",
@@ -225,36 +260,24 @@ func (d *BackupAndDrApplicationsDataSource) Read(ctx context.Context, req dataso
 	}
 
 	// Map applications to data source model
-	data.Applications = make([]BackupAndDrApplicationDataSourceModel, len(policies.Data))
-	for i, policy := range policies.Data {
-		policyModel := BackupAndDrApplicationDataSourceModel{
-			ApplicationID:   types.StringValue(policy.PolicyID),
-			AccountID:       types.StringValue(policy.AccountID),
-			ApplicationName: types.StringValue(policy.PolicyName),
-			IntegrationID:   types.StringValue(policy.IntegrationID),
-			Region:          types.StringValue(policy.Region),
-			ProviderType:    types.StringValue(policy.ProviderType),
-			Frequency:       types.Int64Value(int64(policy.Frequency)),
-			AutoCreatePR:    types.BoolValue(policy.AutoCreatePR),
-			ResilienceEnabled: types.BoolValue(policy.ResilienceEnabled),
-			Status:          types.StringValue(policy.Status),
-			SnapshotsCount:  types.Int64Value(int64(policy.SnapshotsCount)),
-			CreatedAt:       types.StringValue(policy.CreatedAt),
-			UpdatedAt:       types.StringValue(policy.UpdatedAt),
-		}
+	data.Applications = make([]BackupAndDrApplicationDataSourceModel, len(policies))
+	for i, policy := range policies {
+		data.Applications[i] = newBackupAndDrApplicationDataSourceModel(policy)
+	}
 
-		policyModel.Description = StringValueOrNull(policy.Description)
-		policyModel.NotificationID = StringValueOrNull(policy.NotificationID)
-		policyModel.RestoreInstructions = StringValueOrNull(policy.RestoreInstructions)
-		policyModel.TargetAccount = StringValueOrNull(policy.TargetAccount)
-		policyModel.TargetRegion = StringValueOrNull(policy.TargetRegion)
-		policyModel.LastBackupSnapshotID = StringValueOrNull(policy.LastBackupSnapshotID)
-		policyModel.LastBackupTime = StringValueOrNull(policy.LastBackupTime)
-		policyModel.LastBackupStatus = StringValueOrNull(policy.LastBackupStatus)
-		policyModel.NextBackupTime = StringValueOrNull(policy.NextBackupTime)
-
-		data.Applications[i] = policyModel
+	// Every page of the list holds the facets of all the matching applications, the first one is enough
+	facetFilters := *filters
+	facetFilters.Page = 1
+	facetFilters.PageSize = 1
+	firstPage, err := d.client.BackupAndDr().List(ctx, &facetFilters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading backup application facets",
+			fmt.Sprintf("Could not read backup application facets: %s", err),
+		)
+		return
 	}
+	data.Facets = newBackupAndDrFacetModels(firstPage.Facets)
 
 	// Generate unique ID based on account_id and filters
 	idStr := fmt.Sprintf("backup-dr-apps-%s-%s-%s-%s-%s",
@@ -273,3 +296,53 @@ func (d *BackupAndDrApplicationsDataSource) Read(ctx context.Context, req dataso
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// newBackupAndDrApplicationDataSourceModel converts an application returned by the API to the data source model
+func newBackupAndDrApplicationDataSourceModel(policy client.PolicyResponse) BackupAndDrApplicationDataSourceModel {
+	policyModel := BackupAndDrApplicationDataSourceModel{
+		ApplicationID:     types.StringValue(policy.PolicyID),
+		AccountID:         types.StringValue(policy.AccountID),
+		ApplicationName:   types.StringValue(policy.PolicyName),
+		IntegrationID:     types.StringValue(policy.IntegrationID),
+		Region:            types.StringValue(policy.Region),
+		ProviderType:      types.StringValue(policy.ProviderType),
+		Frequency:         types.Int64Value(int64(policy.Frequency)),
+		AutoCreatePR:      types.BoolValue(policy.AutoCreatePR),
+		ResilienceEnabled: types.BoolValue(policy.ResilienceEnabled),
+		Status:            types.StringValue(policy.Status),
+		SnapshotsCount:    types.Int64Value(int64(policy.SnapshotsCount)),
+		CreatedAt:         types.StringValue(policy.CreatedAt),
+		UpdatedAt:         types.StringValue(policy.UpdatedAt),
+	}
+
+	policyModel.Description = StringValueOrNull(policy.Description)
+	policyModel.NotificationID = StringValueOrNull(policy.NotificationID)
+	policyModel.RestoreInstructions = StringValueOrNull(policy.RestoreInstructions)
+	policyModel.TargetAccount = StringValueOrNull(policy.TargetAccount)
+	policyModel.TargetRegion = StringValueOrNull(policy.TargetRegion)
+	policyModel.LastBackupSnapshotID = StringValueOrNull(policy.LastBackupSnapshotID)
+	policyModel.LastBackupTime = StringValueOrNull(policy.LastBackupTime)
+	policyModel.LastBackupStatus = StringValueOrNull(policy.LastBackupStatus)
+	policyModel.NextBackupTime = StringValueOrNull(policy.NextBackupTime)
+
+	return policyModel
+}
+
+// newBackupAndDrFacetModels converts the facets returned by the API to the data source model
+func newBackupAndDrFacetModels(facets []client.Facet) []BackupAndDrFacetModel {
+	models := make([]BackupAndDrFacetModel, len(facets))
+	for i, facet := range facets {
+		values := make([]BackupAndDrFacetValueModel, len(facet.Values))
+		for j, value := range facet.Values {
+			values[j] = BackupAndDrFacetValueModel{
+				Value: types.StringValue(value.Value),
+				Count: types.Int64Value(int64(value.Count)),
+			}
+		}
+		models[i] = BackupAndDrFacetModel{
+			Field:  types.StringValue(facet.Field),
+			Values: values,
+		}
+	}
+	return models
+}
@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"iter"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -77,6 +82,72 @@ func TestAccBackupAndDrApplicationsDataSource_MultipleFilters(t *testing.T) {
 	})
 }
 
+func TestAccBackupAndDrApplicationsDataSource_Facets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "firefly_backup_and_dr_applications" "test" {
+  account_id = "test-account-id"
+  region     = "us-east-1"
+}
+
+output "application_id" {
+  value = one([for application in data.firefly_backup_and_dr_applications.test.applications : application.application_id if application.application_name == %q])
+}
+`, "Test Backup Application"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_applications.test", "facets.#", "4"),
+					resource.TestCheckOutput("application_id", testAccBackupApplicationID),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupAndDrApplicationsDataSource_Facets(t *testing.T) {
+	var facetFilters *client.PolicyListFilters
+	api := &mockAPI{}
+	api.backupAndDr.all = func(ctx context.Context, filters *client.PolicyListFilters) iter.Seq2[client.PolicyResponse, error] {
+		return sliceSeq(client.PolicyResponse{PolicyID: "application-1", PolicyName: "production", Region: "us-east-1"})
+	}
+	api.backupAndDr.list = func(ctx context.Context, filters *client.PolicyListFilters) (*client.PolicyListResponse, error) {
+		facetFilters = filters
+		return &client.PolicyListResponse{
+			Facets: []client.Facet{
+				{Field: "region", Values: []client.FacetValue{{Value: "us-east-1", Count: 1}}},
+				{Field: "status", Values: []client.FacetValue{{Value: "Active", Count: 1}}},
+			},
+		}, nil
+	}
+
+	resp := runDataSourceRead(t, NewBackupAndDrApplicationsDataSource(), api, &BackupAndDrApplicationsDataSourceModel{
+		ID:            types.StringUnknown(),
+		AccountID:     types.StringValue("account-1"),
+		Status:        types.StringNull(),
+		IntegrationID: types.StringNull(),
+		Region:        types.StringValue("us-east-1"),
+		ProviderType:  types.StringNull(),
+		Limit:         types.Int64Null(),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data BackupAndDrApplicationsDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if facetFilters == nil || facetFilters.Region != "us-east-1" || facetFilters.PageSize != 1 {
+		t.Errorf("Expected the facets of the filtered applications from a single item page, got %+v", facetFilters)
+	}
+	if len(data.Facets) != 2 || data.Facets[0].Field.ValueString() != "region" || data.Facets[0].Values[0].Count.ValueInt64() != 1 {
+		t.Errorf("Unexpected facets %+v", data.Facets)
+	}
+}
+
 // Test configuration functions
 
 func testAccBackupAndDrApplicationsDataSourceConfig() string {
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BackupAndDrSnapshotDiffDataSource{}
+
+// NewBackupAndDrSnapshotDiffDataSource creates a new backup and DR snapshot diff data source
+func NewBackupAndDrSnapshotDiffDataSource() datasource.DataSource {
+	return &BackupAndDrSnapshotDiffDataSource{}
+}
+
+// BackupAndDrSnapshotDiffDataSource defines the data source implementation
+type BackupAndDrSnapshotDiffDataSource struct {
+	client client.API
+}
+
+// BackupAndDrSnapshotDiffDataSourceModel describes the data source data model
+type BackupAndDrSnapshotDiffDataSourceModel struct {
+	ApplicationID    types.String                       `tfsdk:"application_id"`
+	BaseSnapshotID   types.String                       `tfsdk:"base_snapshot_id"`
+	TargetSnapshotID types.String                       `tfsdk:"target_snapshot_id"`
+	Added            []BackupAndDrSnapshotDiffDataModel `tfsdk:"added"`
+	Removed          []BackupAndDrSnapshotDiffDataModel `tfsdk:"removed"`
+	Modified         []BackupAndDrSnapshotDiffDataModel `tfsdk:"modified"`
+	HasChanges       types.Bool                         `tfsdk:"has_changes"`
+}
+
+// BackupAndDrSnapshotDiffDataModel describes a single resource that differs between the snapshots
+type BackupAndDrSnapshotDiffDataModel struct {
+	ResourceID        types.String `tfsdk:"resource_id"`
+	ResourceType      types.String `tfsdk:"resource_type"`
+	Name              types.String `tfsdk:"name"`
+	Region            types.String `tfsdk:"region"`
+	ChangedAttributes types.List   `tfsdk:"changed_attributes"`
+}
+
+func (d *BackupAndDrSnapshotDiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_and_dr_snapshot_diff"
+}
+
+func (d *BackupAndDrSnapshotDiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	diffResource := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				MarkdownDescription: "The cloud identifier of the resource",
+				Computed:            true,
+			},
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "The Terraform type of the resource, such as `aws_s3_bucket`",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the resource",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The cloud region of the resource",
+				Computed:            true,
+			},
+			"changed_attributes": schema.ListAttribute{
+				MarkdownDescription: "The configuration attributes that changed, only set for modified resources",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for comparing the resources of two snapshots of a Firefly Backup & DR application",
+
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the backup application the snapshots belong to",
+				Required:            true,
+			},
+			"base_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot to compare from, usually the older one",
+				Required:            true,
+			},
+			"target_snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot to compare to, usually the newer one",
+				Required:            true,
+			},
+			"added": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources in the target snapshot but not in the base snapshot",
+				Computed:            true,
+				NestedObject:        diffResource,
+			},
+			"removed": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources in the base snapshot but not in the target snapshot",
+				Computed:            true,
+				NestedObject:        diffResource,
+			},
+			"modified": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources in both snapshots whose configuration changed",
+				Computed:            true,
+				NestedObject:        diffResource,
+			},
+			"has_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether any resource was added, removed or modified",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BackupAndDrSnapshotDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupAndDrSnapshotDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupAndDrSnapshotDiffDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := data.ApplicationID.ValueString()
+	baseSnapshotID := data.BaseSnapshotID.ValueString()
+	targetSnapshotID := data.TargetSnapshotID.ValueString()
+
+	tflog.Debug(ctx, "Reading backup snapshot diff", map[string]interface{}{
+		"application_id":     applicationID,
+		"base_snapshot_id":   baseSnapshotID,
+		"target_snapshot_id": targetSnapshotID,
+	})
+
+	diff, err := d.client.BackupAndDr().DiffSnapshots(ctx, applicationID, baseSnapshotID, targetSnapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading backup snapshot diff",
+			fmt.Sprintf("Could not compare snapshot %s to snapshot %s of backup application %s: %s", targetSnapshotID, baseSnapshotID, applicationID, err),
+		)
+		return
+	}
+
+	data.Added = mapSnapshotDiffResources(ctx, diff.Added, &resp.Diagnostics)
+	data.Removed = mapSnapshotDiffResources(ctx, diff.Removed, &resp.Diagnostics)
+	data.Modified = mapSnapshotDiffResources(ctx, diff.Modified, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.HasChanges = types.BoolValue(len(diff.Added)+len(diff.Removed)+len(diff.Modified) > 0)
+
+	tflog.Debug(ctx, "Read backup snapshot diff", map[string]interface{}{
+		"added":    len(data.Added),
+		"removed":  len(data.Removed),
+		"modified": len(data.Modified),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapSnapshotDiffResources converts the resources of a snapshot diff to the data source model
+func mapSnapshotDiffResources(ctx context.Context, resources []client.SnapshotDiffResource, diags *diag.Diagnostics) []BackupAndDrSnapshotDiffDataModel {
+	models := make([]BackupAndDrSnapshotDiffDataModel, len(resources))
+	for i, resource := range resources {
+		changedAttributes, d := types.ListValueFrom(ctx, types.StringType, resource.ChangedAttributes)
+		diags.Append(d...)
+		if resource.ChangedAttributes == nil {
+			changedAttributes = types.ListNull(types.StringType)
+		}
+
+		models[i] = BackupAndDrSnapshotDiffDataModel{
+			ResourceID:        types.StringValue(resource.ResourceID),
+			ResourceType:      types.StringValue(resource.ResourceType),
+			Name:              StringValueOrNull(resource.Name),
+			Region:            StringValueOrNull(resource.Region),
+			ChangedAttributes: changedAttributes,
+		}
+	}
+	return models
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupAndDrSnapshotDiffDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "firefly_backup_and_dr_snapshot_diff" "test" {
+  application_id     = %q
+  base_snapshot_id   = %q
+  target_snapshot_id = %q
+}
+`, testAccBackupApplicationID, testAccBaseSnapshotID, testAccTargetSnapshotID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshot_diff.test", "has_changes", "true"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshot_diff.test", "added.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshot_diff.test", "added.0.resource_type", "aws_sqs_queue"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshot_diff.test", "removed.0.resource_id", "test-topic"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshot_diff.test", "modified.0.changed_attributes.0", "versioning"),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupAndDrSnapshotDiffDataSource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.diffSnapshots = func(ctx context.Context, policyID, baseSnapshotID, targetSnapshotID string) (*client.SnapshotDiff, error) {
+		if baseSnapshotID != "snapshot-1" || targetSnapshotID != "snapshot-2" {
+			t.Errorf("Unexpected snapshots %s and %s", baseSnapshotID, targetSnapshotID)
+		}
+		return &client.SnapshotDiff{
+			BaseSnapshotID:   baseSnapshotID,
+			TargetSnapshotID: targetSnapshotID,
+			Modified: []client.SnapshotDiffResource{
+				{ResourceID: "bucket", ResourceType: "aws_s3_bucket", ChangedAttributes: []string{"tags", "versioning"}},
+			},
+		}, nil
+	}
+
+	resp := runDataSourceRead(t, NewBackupAndDrSnapshotDiffDataSource(), api, &BackupAndDrSnapshotDiffDataSourceModel{
+		ApplicationID:    types.StringValue("application-1"),
+		BaseSnapshotID:   types.StringValue("snapshot-1"),
+		TargetSnapshotID: types.StringValue("snapshot-2"),
+		HasChanges:       types.BoolUnknown(),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data BackupAndDrSnapshotDiffDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if !data.HasChanges.ValueBool() || len(data.Added) != 0 || len(data.Removed) != 0 || len(data.Modified) != 1 {
+		t.Fatalf("Expected a single modified resource, got %+v", data)
+	}
+	if len(data.Modified[0].ChangedAttributes.Elements()) != 2 || !data.Modified[0].Name.IsNull() {
+		t.Errorf("Unexpected modified resource %+v", data.Modified[0])
+	}
+}
+
+func TestBackupAndDrSnapshotDiffDataSource_SnapshotNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.diffSnapshots = func(ctx context.Context, policyID, baseSnapshotID, targetSnapshotID string) (*client.SnapshotDiff, error) {
+		return nil, notFoundError()
+	}
+
+	resp := runDataSourceRead(t, NewBackupAndDrSnapshotDiffDataSource(), api, &BackupAndDrSnapshotDiffDataSourceModel{
+		ApplicationID:    types.StringValue("application-1"),
+		BaseSnapshotID:   types.StringValue("deleted"),
+		TargetSnapshotID: types.StringValue("snapshot-2"),
+		HasChanges:       types.BoolUnknown(),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for an unknown snapshot")
+	}
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &BackupAndDrSnapshotsDataSource{}
+
+// NewBackupAndDrSnapshotsDataSource creates a new backup and DR snapshots data source
+func NewBackupAndDrSnapshotsDataSource() datasource.DataSource {
+	return &BackupAndDrSnapshotsDataSource{}
+}
+
+// BackupAndDrSnapshotsDataSource defines the data source implementation
+type BackupAndDrSnapshotsDataSource struct {
+	client client.API
+}
+
+// BackupAndDrSnapshotsDataSourceModel describes the data source data model
+type BackupAndDrSnapshotsDataSourceModel struct {
+	ApplicationID types.String                   `tfsdk:"application_id"`
+	Status        types.String                   `tfsdk:"status"`
+	Limit         types.Int64                    `tfsdk:"limit"`
+	Snapshots     []BackupAndDrSnapshotDataModel `tfsdk:"snapshots"`
+}
+
+// BackupAndDrSnapshotDataModel describes a single snapshot in the data source
+type BackupAndDrSnapshotDataModel struct {
+	SnapshotID           types.String `tfsdk:"snapshot_id"`
+	Status               types.String `tfsdk:"status"`
+	Trigger              types.String `tfsdk:"trigger"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	CompletedAt          types.String `tfsdk:"completed_at"`
+	ResourcesCount       types.Int64  `tfsdk:"resources_count"`
+	FailedResourcesCount types.Int64  `tfsdk:"failed_resources_count"`
+}
+
+func (d *BackupAndDrSnapshotsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_and_dr_snapshots"
+}
+
+func (d *BackupAndDrSnapshotsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for retrieving the snapshots of a Firefly Backup & DR application, most recent first",
+
+		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("snapshots"),
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the backup application to list snapshots for",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Filter by snapshot status, such as `completed` or `failed`",
+				Optional:            true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				MarkdownDescription: "List of snapshots, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"snapshot_id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the snapshot",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the snapshot",
+							Computed:            true,
+						},
+						"trigger": schema.StringAttribute{
+							MarkdownDescription: "What started the backup, such as `scheduled` or `on_demand`",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp the backup started",
+							Computed:            true,
+						},
+						"completed_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp the backup completed, null while it is running",
+							Computed:            true,
+						},
+						"resources_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of resources backed up",
+							Computed:            true,
+						},
+						"failed_resources_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of resources that could not be backed up",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupAndDrSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupAndDrSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupAndDrSnapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := data.ApplicationID.ValueString()
+	filters := &client.SnapshotListFilters{
+		Status: data.Status.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Reading backup snapshots", map[string]interface{}{
+		"application_id": applicationID,
+		"status":         filters.Status,
+	})
+
+	snapshots, err := client.Collect(d.client.BackupAndDr().AllSnapshots(ctx, applicationID, filters), limitValue(data.Limit))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading backup snapshots",
+			fmt.Sprintf("Could not read the snapshots of backup application %s: %s", applicationID, err),
+		)
+		return
+	}
+
+	data.Snapshots = make([]BackupAndDrSnapshotDataModel, len(snapshots))
+	for i, snapshot := range snapshots {
+		data.Snapshots[i] = BackupAndDrSnapshotDataModel{
+			SnapshotID:           types.StringValue(snapshot.SnapshotID),
+			Status:               types.StringValue(snapshot.Status),
+			Trigger:              StringValueOrNull(snapshot.Trigger),
+			CreatedAt:            types.StringValue(snapshot.CreatedAt),
+			CompletedAt:          StringValueOrNull(snapshot.CompletedAt),
+			ResourcesCount:       types.Int64Value(int64(snapshot.ResourcesCount)),
+			FailedResourcesCount: types.Int64Value(int64(snapshot.FailedResourcesCount)),
+		}
+	}
+
+	tflog.Debug(ctx, "Read backup snapshots", map[string]interface{}{
+		"count": len(data.Snapshots),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupAndDrSnapshotsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "firefly_backup_and_dr_snapshots" "test" {
+  application_id = %q
+  status         = "completed"
+}
+`, testAccBackupApplicationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshots.test", "snapshots.#", "2"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshots.test", "snapshots.0.snapshot_id", testAccTargetSnapshotID),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshots.test", "snapshots.0.resources_count", "2"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshots.test", "snapshots.1.snapshot_id", testAccBaseSnapshotID),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupAndDrSnapshotsDataSource_Read(t *testing.T) {
+	var received *client.SnapshotListFilters
+	api := &mockAPI{}
+	api.backupAndDr.allSnapshots = func(ctx context.Context, policyID string, filters *client.SnapshotListFilters) iter.Seq2[client.Snapshot, error] {
+		received = filters
+		return sliceSeq(
+			client.Snapshot{SnapshotID: "snapshot-2", PolicyID: policyID, Status: "running", CreatedAt: "2026-01-02T00:00:00Z", ResourcesCount: 10},
+			client.Snapshot{SnapshotID: "snapshot-1", PolicyID: policyID, Status: "completed", CreatedAt: "2026-01-01T00:00:00Z", CompletedAt: "2026-01-01T00:05:00Z"},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewBackupAndDrSnapshotsDataSource(), api, &BackupAndDrSnapshotsDataSourceModel{
+		ApplicationID: types.StringValue("application-1"),
+		Status:        types.StringNull(),
+		Limit:         types.Int64Value(1),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data BackupAndDrSnapshotsDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if received == nil || received.Status != "" {
+		t.Errorf("Expected no status filter, got %+v", received)
+	}
+	if len(data.Snapshots) != 1 || data.Snapshots[0].SnapshotID.ValueString() != "snapshot-2" {
+		t.Fatalf("Expected only the latest snapshot, got %+v", data.Snapshots)
+	}
+	if !data.Snapshots[0].CompletedAt.IsNull() || data.Snapshots[0].ResourcesCount.ValueInt64() != 10 {
+		t.Errorf("Unexpected snapshot %+v", data.Snapshots[0])
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &cloudIntegrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &cloudIntegrationsDataSource{}
+)
+
+// cloudTypes are the types of cloud integrations
+var cloudTypes = []string{
+	string(client.CloudTypeAWS),
+	string(client.CloudTypeGCP),
+	string(client.CloudTypeAzure),
+	string(client.CloudTypeKubernetes),
+}
+
+// NewCloudIntegrationsDataSource is a helper function to simplify the provider implementation
+func NewCloudIntegrationsDataSource() datasource.DataSource {
+	return &cloudIntegrationsDataSource{}
+}
+
+// cloudIntegrationsDataSource is the data source implementation
+type cloudIntegrationsDataSource struct {
+	client client.API
+}
+
+// CloudIntegrationsDataSourceModel describes the data source data model
+type CloudIntegrationsDataSourceModel struct {
+	Name         types.String                `tfsdk:"name"`
+	ExactName    types.Bool                  `tfsdk:"exact_name"`
+	Type         types.String                `tfsdk:"type"`
+	Limit        types.Int64                 `tfsdk:"limit"`
+	Integrations []CloudIntegrationDataModel `tfsdk:"integrations"`
+}
+
+// CloudIntegrationDataModel describes a single cloud integration in the data source
+type CloudIntegrationDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	AccountID types.String `tfsdk:"account_id"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// Metadata returns the data source type name
+func (d *cloudIntegrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_integrations"
+}
+
+// Schema defines the schema for the data source
+func (d *cloudIntegrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := integrationFilterAttributes(cloudTypes)
+	attributes["integrations"] = schema.ListNestedAttribute{
+		Description: "List of cloud integrations",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the integration, e.g. to use as integration_id",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the integration",
+					Computed:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "The type of cloud provider",
+					Computed:    true,
+				},
+				"account_id": schema.StringAttribute{
+					Description: "The AWS account ID, GCP project ID, Azure subscription ID or Kubernetes cluster ID of the integration",
+					Computed:    true,
+				},
+				"status": schema.StringAttribute{
+					Description: "The status of the integration",
+					Computed:    true,
+				},
+				"created_at": schema.StringAttribute{
+					Description: "Timestamp when the integration was connected",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the cloud integrations of the Firefly account, optionally filtered by name or type",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *cloudIntegrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *cloudIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CloudIntegrationsDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &client.ListIntegrationsRequest{
+		Name: data.Name.ValueString(),
+		Type: data.Type.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Reading cloud integrations", map[string]interface{}{
+		"name":       request.Name,
+		"exact_name": data.ExactName.ValueBool(),
+		"type":       request.Type,
+	})
+
+	integrations := d.client.Integrations().AllCloudIntegrations(ctx, request)
+	if data.ExactName.ValueBool() {
+		integrations = client.Filter(integrations, func(i client.CloudIntegration) bool {
+			return strings.EqualFold(i.Name, request.Name)
+		})
+	}
+
+	integrationList, err := client.Collect(integrations, limitValue(data.Limit))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Cloud Integrations", fmt.Sprintf("Could not read cloud integrations: %s", err))
+		return
+	}
+
+	// Map response to model
+	data.Integrations = make([]CloudIntegrationDataModel, len(integrationList))
+	for i, integration := range integrationList {
+		data.Integrations[i] = CloudIntegrationDataModel{
+			ID:        types.StringValue(integration.ID),
+			Name:      types.StringValue(integration.Name),
+			Type:      types.StringValue(string(integration.Type)),
+			AccountID: types.StringValue(integration.AccountID),
+			Status:    types.StringValue(integration.Status),
+			CreatedAt: types.StringValue(integration.CreatedAt),
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
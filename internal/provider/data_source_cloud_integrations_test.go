@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudIntegrationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudIntegrationsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_cloud_integrations.all", "integrations.#", "2"),
+					resource.TestCheckResourceAttr("data.firefly_cloud_integrations.aws", "integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_cloud_integrations.aws", "integrations.0.id", "test-integration-id"),
+					resource.TestCheckResourceAttr("data.firefly_cloud_integrations.aws", "integrations.0.account_id", "123456789012"),
+					resource.TestCheckResourceAttr("data.firefly_cloud_integrations.limited", "integrations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCloudIntegrationsDataSourceConfig = `
+data "firefly_cloud_integrations" "all" {}
+
+data "firefly_cloud_integrations" "aws" {
+  name = "aws"
+  type = "aws"
+}
+
+data "firefly_cloud_integrations" "limited" {
+  limit = 1
+}
+`
+
+func TestCloudIntegrationsDataSource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.integrations.allCloudIntegrations = func(ctx context.Context, request *client.ListIntegrationsRequest) iter.Seq2[client.CloudIntegration, error] {
+		return sliceSeq(client.CloudIntegration{ID: "cloud-1", Name: "production", Type: client.CloudTypeAzure, AccountID: "subscription-1", Status: "active"})
+	}
+
+	resp := runDataSourceRead(t, NewCloudIntegrationsDataSource(), api, &CloudIntegrationsDataSourceModel{
+		Name:      types.StringNull(),
+		ExactName: types.BoolNull(),
+		Type:      types.StringNull(),
+		Limit:     types.Int64Null(),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data CloudIntegrationsDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if len(data.Integrations) != 1 || data.Integrations[0].AccountID.ValueString() != "subscription-1" || data.Integrations[0].Type.ValueString() != "azure" {
+		t.Errorf("Unexpected integrations %+v", data.Integrations)
+	}
+}
@@ -6,8 +6,12 @@ import (
 	"fmt"
 
 	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,7 +26,7 @@ func NewGovernancePoliciesDataSource() datasource.DataSource {
 
 // GovernancePoliciesDataSource defines the data source implementation
 type GovernancePoliciesDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 func (d *GovernancePoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -32,8 +36,9 @@ func (d *GovernancePoliciesDataSource) Metadata(ctx context.Context, req datasou
 func (d *GovernancePoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Data source for retrieving Firefly governance policies",
-		
+
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("policies"),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The data source identifier",
 				Computed:            true,
@@ -51,128 +56,214 @@ func (d *GovernancePoliciesDataSource) Schema(ctx context.Context, req datasourc
 				MarkdownDescription: "Category filter for policies",
 				Optional:            true,
 			},
+			"frameworks": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return policies related to one of these compliance frameworks",
+				Optional:            true,
+			},
+			"severity": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return policies with one of these severity levels (trace, info, low, medium, high, critical)",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("trace", "info", "low", "medium", "high", "critical")),
+				},
+			},
+			"type": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return policies applying to one of these resource types",
+				Optional:            true,
+			},
+			"providers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return policies applying to one of these provider IDs",
+				Optional:            true,
+			},
+			"integrations": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only return policies evaluated on one of these cloud integration IDs",
+				Optional:            true,
+			},
+			"only_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only return enabled policies",
+				Optional:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "When true, only return Firefly's built-in policies. When false, only return custom policies",
+				Optional:            true,
+			},
+			"sorting": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Sort order of the policies, passed to the Firefly API as is",
+				Optional:            true,
+			},
 			"policies": schema.ListNestedAttribute{
 				MarkdownDescription: "List of governance policies",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "The unique identifier of the governance policy",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The name of the governance policy",
-							Computed:            true,
-						},
-						"description": schema.StringAttribute{
-							MarkdownDescription: "The description of the governance policy",
-							Computed:            true,
-						},
-						"code": schema.StringAttribute{
-							MarkdownDescription: "The Rego code for the policy rule",
-							Computed:            true,
-						},
-						"type": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "List of resource types this policy applies to",
-							Computed:            true,
-						},
-						"provider_ids": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "List of provider IDs this policy applies to",
-							Computed:            true,
-						},
-						"labels": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "List of labels for categorizing the policy",
-							Computed:            true,
-						},
-						"severity": schema.StringAttribute{
-							MarkdownDescription: "The severity level of the policy",
-							Computed:            true,
-						},
-						"category": schema.StringAttribute{
-							MarkdownDescription: "The category of the policy",
-							Computed:            true,
-						},
-						"frameworks": schema.ListAttribute{
-							ElementType:         types.StringType,
-							MarkdownDescription: "List of compliance frameworks this policy relates to",
-							Computed:            true,
-						},
-					},
+					Attributes: governancePolicyAttributes(),
 				},
 			},
 		},
 	}
 }
 
+// governancePolicyAttributes returns the computed attributes describing a governance policy
+func governancePolicyAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the governance policy",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the governance policy",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the governance policy",
+			Computed:            true,
+		},
+		"code": schema.StringAttribute{
+			MarkdownDescription: "The Rego code for the policy rule",
+			Computed:            true,
+		},
+		"type": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "List of resource types this policy applies to",
+			Computed:            true,
+		},
+		"provider_ids": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "List of provider IDs this policy applies to",
+			Computed:            true,
+		},
+		"labels": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "List of labels for categorizing the policy",
+			Computed:            true,
+		},
+		"severity": schema.StringAttribute{
+			MarkdownDescription: "The severity level of the policy",
+			Computed:            true,
+		},
+		"category": schema.StringAttribute{
+			MarkdownDescription: "The category of the policy",
+			Computed:            true,
+		},
+		"frameworks": schema.ListAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "List of compliance frameworks this policy relates to",
+			Computed:            true,
+		},
+		"is_default": schema.BoolAttribute{
+			MarkdownDescription: "Whether the policy is one of Firefly's built-in policies",
+			Computed:            true,
+		},
+	}
+}
+
 func (d *GovernancePoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	// Prevent panic if the provider is not configured
 	if req.ProviderData == nil {
 		return
 	}
-	
-	client, ok := req.ProviderData.(*client.Client)
-	
+
+	client, ok := req.ProviderData.(client.API)
+
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
-		
+
 		return
 	}
-	
+
 	d.client = client
 }
 
 func (d *GovernancePoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data GovernancePoliciesDataSourceModel
-	
+
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-	
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	
+
 	// Build request
 	listReq := &client.GovernancePolicyListRequest{
-		Page:     1,
-		PageSize: 100, // Get first 100 policies
 		OnlyAvailableProviders: true,
 	}
-	
+
 	// Add query filter if provided
 	if !data.Query.IsNull() && !data.Query.IsUnknown() {
 		listReq.Query = data.Query.ValueString()
 	}
-	
-	// Add labels filter if provided
-	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
-		var labels []string
-		diags := data.Labels.ElementsAs(ctx, &labels, false)
+
+	// Add list filters if provided
+	listFilters := []struct {
+		value  types.List
+		target *[]string
+	}{
+		{data.Labels, &listReq.Labels},
+		{data.Frameworks, &listReq.Frameworks},
+		{data.Type, &listReq.Type},
+		{data.Providers, &listReq.Providers},
+		{data.Integrations, &listReq.Integrations},
+		{data.Sorting, &listReq.Sorting},
+	}
+	for _, filter := range listFilters {
+		if filter.value.IsNull() || filter.value.IsUnknown() {
+			continue
+		}
+		diags := filter.value.ElementsAs(ctx, filter.target, false)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+
+	// The API filters severity by its numeric level
+	if !data.Severity.IsNull() && !data.Severity.IsUnknown() {
+		var severities []string
+		diags := data.Severity.ElementsAs(ctx, &severities, false)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
-		listReq.Labels = labels
+		for _, severity := range severities {
+			listReq.Severity = append(listReq.Severity, client.SeverityToInt(severity))
+		}
 	}
-	
+
 	// Add category filter if provided
 	if !data.Category.IsNull() && !data.Category.IsUnknown() {
 		listReq.Category = data.Category.ValueString()
 	}
-	
+
+	listReq.OnlyEnabled = data.OnlyEnabled.ValueBool()
+	if !data.IsDefault.IsNull() && !data.IsDefault.IsUnknown() {
+		isDefault := data.IsDefault.ValueBool()
+		listReq.IsDefault = &isDefault
+	}
+
 	tflog.Debug(ctx, "Reading governance policies", map[string]interface{}{
-		"query":    listReq.Query,
-		"labels":   listReq.Labels,
-		"category": listReq.Category,
+		"query":        listReq.Query,
+		"labels":       listReq.Labels,
+		"category":     listReq.Category,
+		"frameworks":   listReq.Frameworks,
+		"severity":     listReq.Severity,
+		"type":         listReq.Type,
+		"providers":    listReq.Providers,
+		"integrations": listReq.Integrations,
+		"only_enabled": listReq.OnlyEnabled,
+		"is_default":   listReq.IsDefault,
 	})
-	
+
 	// Get policies
-	policiesResp, err := d.client.GovernancePolicies.List(listReq)
+	policies, err := client.Collect(d.client.GovernancePolicies().All(ctx, listReq), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading governance policies",
@@ -180,91 +271,90 @@ func (d *GovernancePoliciesDataSource) Read(ctx context.Context, req datasource.
 		)
 		return
 	}
-	
+
 	// Map policies to data source model
-	data.Policies = make([]GovernancePolicyDataSourceModel, len(policiesResp.Hits))
-	for i, policy := range policiesResp.Hits {
-		policyModel := &GovernancePolicyDataSourceModel{}
-		
-		// Map each policy
-		policyModel.ID = types.StringValue(policy.ID)
-		policyModel.Name = types.StringValue(policy.Name)
-		
-		if policy.Description != "" {
-			policyModel.Description = types.StringValue(policy.Description)
-		} else {
-			policyModel.Description = types.StringNull()
-		}
-		
-		// Decode the base64 encoded Rego code from the API
-		decodedCode, err := base64.StdEncoding.DecodeString(policy.Code)
-		if err != nil {
-			// If decoding fails, assume it's already plain text (for backward compatibility)
-			policyModel.Code = types.StringValue(policy.Code)
-		} else {
-			policyModel.Code = types.StringValue(string(decodedCode))
-		}
-		
-		// Convert arrays to lists
-		typeList, diags := types.ListValueFrom(ctx, types.StringType, policy.Type)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		policyModel.Type = typeList
-		
-		providerList, diags := types.ListValueFrom(ctx, types.StringType, policy.ProviderIDs)
+	data.Policies = make([]GovernancePolicyDataSourceModel, len(policies))
+	for i, policy := range policies {
+		policyModel, diags := newGovernancePolicyDataSourceModel(ctx, policy)
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
 			return
 		}
-		policyModel.ProviderIDs = providerList
-		
-		labelsSlice := []string(policy.Labels)
-		if len(labelsSlice) > 0 {
-			labelsList, diags := types.ListValueFrom(ctx, types.StringType, labelsSlice)
-			if diags.HasError() {
-				resp.Diagnostics.Append(diags...)
-				return
-			}
-			policyModel.Labels = labelsList
-		} else {
-			policyModel.Labels = types.ListNull(types.StringType)
-		}
-		
-		if policy.Severity > 0 {
-			policyModel.Severity = types.StringValue(client.SeverityToString(policy.Severity))
-		} else {
-			policyModel.Severity = types.StringNull()
-		}
-		
-		if policy.Category != "" {
-			policyModel.Category = types.StringValue(policy.Category)
-		} else {
-			policyModel.Category = types.StringNull()
-		}
-		
-		if len(policy.Frameworks) > 0 {
-			frameworksList, diags := types.ListValueFrom(ctx, types.StringType, policy.Frameworks)
-			if diags.HasError() {
-				resp.Diagnostics.Append(diags...)
-				return
-			}
-			policyModel.Frameworks = frameworksList
-		} else {
-			policyModel.Frameworks = types.ListNull(types.StringType)
-		}
-		
-		data.Policies[i] = *policyModel
+		data.Policies[i] = policyModel
 	}
-	
+
 	// Generate unique ID for the data source
 	data.ID = types.StringValue("governance-policies")
-	
+
 	tflog.Debug(ctx, "Read governance policies", map[string]interface{}{
 		"count": len(data.Policies),
 	})
-	
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
\ No newline at end of file
+}
+
+// newGovernancePolicyDataSourceModel maps a policy from the API, decoding its Rego code
+func newGovernancePolicyDataSourceModel(ctx context.Context, policy client.GovernancePolicy) (GovernancePolicyDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policyModel := GovernancePolicyDataSourceModel{
+		ID:        types.StringValue(policy.ID),
+		Name:      types.StringValue(policy.Name),
+		IsDefault: types.BoolValue(policy.IsDefault),
+	}
+
+	if policy.Description != "" {
+		policyModel.Description = types.StringValue(policy.Description)
+	} else {
+		policyModel.Description = types.StringNull()
+	}
+
+	// Decode the base64 encoded Rego code from the API
+	decodedCode, err := base64.StdEncoding.DecodeString(policy.Code)
+	if err != nil {
+		// If decoding fails, assume it's already plain text (for backward compatibility)
+		policyModel.Code = types.StringValue(policy.Code)
+	} else {
+		policyModel.Code = types.StringValue(string(decodedCode))
+	}
+
+	// Convert arrays to lists
+	typeList, d := types.ListValueFrom(ctx, types.StringType, policy.Type)
+	diags.Append(d...)
+	policyModel.Type = typeList
+
+	providerList, d := types.ListValueFrom(ctx, types.StringType, policy.ProviderIDs)
+	diags.Append(d...)
+	policyModel.ProviderIDs = providerList
+
+	labelsSlice := []string(policy.Labels)
+	if len(labelsSlice) > 0 {
+		labelsList, d := types.ListValueFrom(ctx, types.StringType, labelsSlice)
+		diags.Append(d...)
+		policyModel.Labels = labelsList
+	} else {
+		policyModel.Labels = types.ListNull(types.StringType)
+	}
+
+	if policy.Severity > 0 {
+		policyModel.Severity = types.StringValue(client.SeverityToString(policy.Severity))
+	} else {
+		policyModel.Severity = types.StringNull()
+	}
+
+	if policy.Category != "" {
+		policyModel.Category = types.StringValue(policy.Category)
+	} else {
+		policyModel.Category = types.StringNull()
+	}
+
+	if len(policy.Frameworks) > 0 {
+		frameworksList, d := types.ListValueFrom(ctx, types.StringType, policy.Frameworks)
+		diags.Append(d...)
+		policyModel.Frameworks = frameworksList
+	} else {
+		policyModel.Frameworks = types.ListNull(types.StringType)
+	}
+
+	return policyModel, diags
+}
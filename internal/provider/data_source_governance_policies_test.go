@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
+	"iter"
+	"slices"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -48,6 +53,67 @@ func TestAccGovernancePoliciesDataSource_WithFilters(t *testing.T) {
 	})
 }
 
+func TestAccGovernancePoliciesDataSource_BuiltinPolicies(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGovernancePoliciesDataSourceConfigBuiltin,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_governance_policies.builtin", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_governance_policies.builtin", "policies.0.id", testAccBuiltinGovernancePolicy.ID),
+					resource.TestCheckResourceAttr("data.firefly_governance_policies.builtin", "policies.0.is_default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestGovernancePoliciesDataSource_Filters(t *testing.T) {
+	var request client.GovernancePolicyListRequest
+	api := &mockAPI{}
+	api.governancePolicies.all = func(ctx context.Context, req *client.GovernancePolicyListRequest) iter.Seq2[client.GovernancePolicy, error] {
+		request = *req
+		return sliceSeq(client.GovernancePolicy{ID: "policy-1", Name: "S3 Bucket ACL", IsDefault: true})
+	}
+
+	resp := runDataSourceRead(t, NewGovernancePoliciesDataSource(), api, &GovernancePoliciesDataSourceModel{
+		Labels:       types.ListNull(types.StringType),
+		Frameworks:   testStrings("SOC2", "PCI"),
+		Severity:     testStrings("high", "critical"),
+		Type:         testStrings("aws_s3_bucket"),
+		Providers:    testStrings("aws_all"),
+		Integrations: testStrings("integration-1"),
+		OnlyEnabled:  types.BoolValue(true),
+		IsDefault:    types.BoolValue(true),
+		Sorting:      testStrings("name"),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	if !slices.Equal(request.Frameworks, []string{"SOC2", "PCI"}) || !slices.Equal(request.Type, []string{"aws_s3_bucket"}) ||
+		!slices.Equal(request.Providers, []string{"aws_all"}) || !slices.Equal(request.Integrations, []string{"integration-1"}) ||
+		!slices.Equal(request.Sorting, []string{"name"}) {
+		t.Errorf("Unexpected list filters %+v", request)
+	}
+	if !slices.Equal(request.Severity, []int{5, 6}) {
+		t.Errorf("Expected severity levels 5 and 6, got %v", request.Severity)
+	}
+	if !request.OnlyEnabled || request.IsDefault == nil || !*request.IsDefault {
+		t.Errorf("Expected only enabled built-in policies, got %+v", request)
+	}
+
+	var data GovernancePoliciesDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if len(data.Policies) != 1 || !data.Policies[0].IsDefault.ValueBool() {
+		t.Errorf("Unexpected policies %+v", data.Policies)
+	}
+}
+
 // Unit test for data source code decoding
 func TestGovernancePoliciesDataSource_CodeDecoding(t *testing.T) {
 	// Test that the data source properly decodes base64 encoded code from API responses
@@ -92,6 +158,14 @@ data "firefly_governance_policies" "search" {
 }
 `
 
+const testAccGovernancePoliciesDataSourceConfigBuiltin = `
+data "firefly_governance_policies" "builtin" {
+  is_default = true
+  frameworks = ["SOC2"]
+  severity   = ["high", "critical"]
+}
+`
+
 const testAccGovernancePoliciesDataSourceConfigWithLabels = `
 data "firefly_governance_policies" "aws_policies" {
   labels = ["aws", "security"]
@@ -116,4 +190,4 @@ output "policy_count" {
 output "security_policy_names" {
   value = [for policy in data.firefly_governance_policies.filtered.policies : policy.name]
 }
-`
\ No newline at end of file
+`
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &GovernancePolicyDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &GovernancePolicyDataSource{}
+
+// NewGovernancePolicyDataSource creates a new governance policy data source
+func NewGovernancePolicyDataSource() datasource.DataSource {
+	return &GovernancePolicyDataSource{}
+}
+
+// GovernancePolicyDataSource defines the data source implementation
+type GovernancePolicyDataSource struct {
+	client client.API
+}
+
+func (d *GovernancePolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_policy"
+}
+
+func (d *GovernancePolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := governancePolicyAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique identifier of the governance policy",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The exact name of the governance policy (alternative to `id`), it must match exactly one policy",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for retrieving a single Firefly governance policy by ID or name, including Firefly's built-in policies",
+		Attributes:          attributes,
+	}
+}
+
+func (d *GovernancePolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *GovernancePolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GovernancePolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GovernancePolicyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *client.GovernancePolicy
+	var err error
+	lookup := "name " + data.Name.ValueString()
+	if id := data.ID.ValueString(); id != "" {
+		lookup = "ID " + id
+		tflog.Debug(ctx, "Reading governance policy by ID", map[string]interface{}{"id": id})
+		policy, err = d.client.GovernancePolicies().Get(ctx, id)
+	} else {
+		tflog.Debug(ctx, "Reading governance policy by name", map[string]interface{}{"name": data.Name.ValueString()})
+		policy, err = d.findPolicyByName(ctx, data.Name.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Governance policy not found", fmt.Sprintf("No governance policy matches %s", lookup))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading governance policy", fmt.Sprintf("Could not read governance policy %s: %s", lookup, err))
+		return
+	}
+
+	data, diags := newGovernancePolicyDataSourceModel(ctx, *policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findPolicyByName returns the only policy named name. The API query also matches part of the
+// name and the description, so the results are narrowed down to the exact name.
+func (d *GovernancePolicyDataSource) findPolicyByName(ctx context.Context, name string) (*client.GovernancePolicy, error) {
+	request := &client.GovernancePolicyListRequest{Query: name}
+	policies, err := client.Collect(client.Filter(d.client.GovernancePolicies().All(ctx, request), func(policy client.GovernancePolicy) bool {
+		return policy.Name == name
+	}), 2)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(policies) {
+	case 0:
+		return nil, client.ErrNotFound
+	case 1:
+		return &policies[0], nil
+	default:
+		return nil, fmt.Errorf("several governance policies are named %q, use id instead", name)
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGovernancePolicyDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "firefly_governance_policy" "by_name" {
+  name = %q
+}
+
+data "firefly_governance_policy" "by_id" {
+  id = data.firefly_governance_policy.by_name.id
+}
+`, testAccBuiltinGovernancePolicy.Name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_governance_policy.by_name", "id", testAccBuiltinGovernancePolicy.ID),
+					resource.TestCheckResourceAttr("data.firefly_governance_policy.by_name", "is_default", "true"),
+					resource.TestCheckResourceAttr("data.firefly_governance_policy.by_name", "severity", "high"),
+					resource.TestCheckResourceAttr("data.firefly_governance_policy.by_id", "name", testAccBuiltinGovernancePolicy.Name),
+					resource.TestCheckResourceAttrSet("data.firefly_governance_policy.by_id", "code"),
+				),
+			},
+		},
+	})
+}
+
+func TestGovernancePolicyDataSource_ByName(t *testing.T) {
+	rego := "firefly {\n  input.acl == \"private\"\n}\n"
+
+	var query string
+	api := &mockAPI{}
+	api.governancePolicies.all = func(ctx context.Context, request *client.GovernancePolicyListRequest) iter.Seq2[client.GovernancePolicy, error] {
+		query = request.Query
+		return sliceSeq(
+			client.GovernancePolicy{ID: "policy-1", Name: "Public S3 Bucket ACL"},
+			client.GovernancePolicy{ID: "policy-2", Name: "S3 Bucket ACL", Code: base64.StdEncoding.EncodeToString([]byte(rego)), Severity: 6, IsDefault: true},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewGovernancePolicyDataSource(), api, &GovernancePolicyDataSourceModel{
+		Name:        types.StringValue("S3 Bucket ACL"),
+		Type:        types.ListNull(types.StringType),
+		ProviderIDs: types.ListNull(types.StringType),
+		Labels:      types.ListNull(types.StringType),
+		Frameworks:  types.ListNull(types.StringType),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+	if query != "S3 Bucket ACL" {
+		t.Errorf("Expected the name to be searched, got query %q", query)
+	}
+
+	var data GovernancePolicyDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if data.ID.ValueString() != "policy-2" {
+		t.Errorf("Expected policy-2, got %s", data.ID)
+	}
+	if data.Code.ValueString() != rego {
+		t.Errorf("Expected the decoded Rego code, got %q", data.Code.ValueString())
+	}
+	if data.Severity.ValueString() != "critical" || !data.IsDefault.ValueBool() {
+		t.Errorf("Unexpected severity %s or is_default %s", data.Severity, data.IsDefault)
+	}
+}
+
+func TestGovernancePolicyDataSource_NameNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.governancePolicies.all = func(ctx context.Context, request *client.GovernancePolicyListRequest) iter.Seq2[client.GovernancePolicy, error] {
+		return sliceSeq(client.GovernancePolicy{ID: "policy-1", Name: "Public S3 Bucket ACL"})
+	}
+
+	resp := runDataSourceRead(t, NewGovernancePolicyDataSource(), api, &GovernancePolicyDataSourceModel{
+		Name:        types.StringValue("S3 Bucket ACL"),
+		Type:        types.ListNull(types.StringType),
+		ProviderIDs: types.ListNull(types.StringType),
+		Labels:      types.ListNull(types.StringType),
+		Frameworks:  types.ListNull(types.StringType),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Governance policy not found" {
+		t.Errorf("Expected a not found error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestGovernancePolicyDataSource_AmbiguousName(t *testing.T) {
+	api := &mockAPI{}
+	api.governancePolicies.all = func(ctx context.Context, request *client.GovernancePolicyListRequest) iter.Seq2[client.GovernancePolicy, error] {
+		return sliceSeq(
+			client.GovernancePolicy{ID: "policy-1", Name: "S3 Bucket ACL"},
+			client.GovernancePolicy{ID: "policy-2", Name: "S3 Bucket ACL"},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewGovernancePolicyDataSource(), api, &GovernancePolicyDataSourceModel{
+		Name:        types.StringValue("S3 Bucket ACL"),
+		Type:        types.ListNull(types.StringType),
+		ProviderIDs: types.ListNull(types.StringType),
+		Labels:      types.ListNull(types.StringType),
+		Frameworks:  types.ListNull(types.StringType),
+	})
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "use id") {
+		t.Errorf("Expected an error suggesting id, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &notificationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationsDataSource{}
+)
+
+// NewNotificationsDataSource is a helper function to simplify the provider implementation
+func NewNotificationsDataSource() datasource.DataSource {
+	return &notificationsDataSource{}
+}
+
+// notificationsDataSource is the data source implementation
+type notificationsDataSource struct {
+	client client.API
+}
+
+// NotificationsDataSourceModel describes the data source data model
+type NotificationsDataSourceModel struct {
+	Name          types.String            `tfsdk:"name"`
+	ExactName     types.Bool              `tfsdk:"exact_name"`
+	Type          types.String            `tfsdk:"type"`
+	Limit         types.Int64             `tfsdk:"limit"`
+	Notifications []NotificationDataModel `tfsdk:"notifications"`
+}
+
+// NotificationDataModel describes a single notification channel in the data source
+type NotificationDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Channel   types.String `tfsdk:"channel"`
+	Emails    types.List   `tfsdk:"emails"`
+	Region    types.String `tfsdk:"region"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Metadata returns the data source type name
+func (d *notificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notifications"
+}
+
+// Schema defines the schema for the data source
+func (d *notificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the notification channels of the Firefly account, optionally filtered by name or type. Their secrets are not returned",
+		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("notification channels"),
+			"name": schema.StringAttribute{
+				Description: "Only return the notification channels whose name contains this value",
+				Optional:    true,
+			},
+			"exact_name": schema.BoolAttribute{
+				Description: "Only return the notification channels named exactly name, compared case-insensitively",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("Only return the notification channels of this type: %s", strings.Join(client.NotificationTypes, ", ")),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.NotificationTypes...),
+				},
+			},
+			"notifications": schema.ListNestedAttribute{
+				Description: "List of notification channels",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the notification channel",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the notification channel",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the notification channel",
+							Computed:    true,
+						},
+						"channel": schema.StringAttribute{
+							Description: "The Slack channel of slack channels",
+							Computed:    true,
+						},
+						"emails": schema.ListAttribute{
+							Description: "The recipients of email channels",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"region": schema.StringAttribute{
+							Description: "The Opsgenie region of opsgenie channels",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Timestamp when the notification channel was created",
+							Computed:    true,
+						},
+						"updated_at": schema.StringAttribute{
+							Description: "Timestamp when the notification channel was last updated",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *notificationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *notificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NotificationsDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &client.ListNotificationsRequest{
+		Name: data.Name.ValueString(),
+		Type: data.Type.ValueString(),
+	}
+	exactName := data.ExactName.ValueBool()
+
+	tflog.Debug(ctx, "Reading notifications", map[string]interface{}{
+		"name":       request.Name,
+		"exact_name": exactName,
+		"type":       request.Type,
+	})
+
+	notifications := d.client.Notifications().AllNotifications(ctx, request)
+	if exactName {
+		notifications = client.Filter(notifications, func(n client.Notification) bool {
+			return strings.EqualFold(n.Name, request.Name)
+		})
+	}
+
+	notificationList, err := client.Collect(notifications, limitValue(data.Limit))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Notifications", fmt.Sprintf("Could not read notification channels: %s", err))
+		return
+	}
+
+	// Map response to model
+	data.Notifications = make([]NotificationDataModel, len(notificationList))
+	for i, notification := range notificationList {
+		emails, diags := types.ListValueFrom(ctx, types.StringType, notification.Emails)
+		resp.Diagnostics.Append(diags...)
+
+		data.Notifications[i] = NotificationDataModel{
+			ID:        types.StringValue(notification.ID),
+			Name:      types.StringValue(notification.Name),
+			Type:      types.StringValue(notification.Type),
+			Channel:   types.StringValue(notification.Channel),
+			Emails:    emails,
+			Region:    types.StringValue(notification.Region),
+			CreatedAt: types.StringValue(notification.CreatedAt),
+			UpdatedAt: types.StringValue(notification.UpdatedAt),
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
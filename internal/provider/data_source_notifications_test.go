@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_notifications.by_name", "notifications.#", "1"),
+					resource.TestCheckResourceAttrPair("data.firefly_notifications.by_name", "notifications.0.id", "firefly_notification.pagerduty", "id"),
+					resource.TestCheckResourceAttr("data.firefly_notifications.by_name", "notifications.0.type", "pagerduty"),
+					resource.TestCheckResourceAttr("data.firefly_notifications.by_type", "notifications.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_notifications.by_type", "notifications.0.emails.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNotificationsDataSourceConfig = `
+resource "firefly_notification" "pagerduty" {
+  name            = "test-on-call"
+  type            = "pagerduty"
+  integration_key = "0123456789abcdef0123456789abcdef"
+}
+
+resource "firefly_notification" "email" {
+  name   = "test-on-call-emails"
+  type   = "email"
+  emails = ["oncall@example.com"]
+}
+
+data "firefly_notifications" "by_name" {
+  name       = firefly_notification.pagerduty.name
+  exact_name = true
+
+  depends_on = [firefly_notification.email]
+}
+
+data "firefly_notifications" "by_type" {
+  type = "email"
+
+  depends_on = [firefly_notification.email]
+}
+`
+
+func TestNotificationsDataSource_ExactName(t *testing.T) {
+	api := &mockAPI{}
+	api.notifications.allNotifications = func(ctx context.Context, request *client.ListNotificationsRequest) iter.Seq2[client.Notification, error] {
+		// The API matches the names containing the filter
+		return sliceSeq(
+			client.Notification{ID: "notification-1", Name: "Alerts staging", Type: client.NotificationTypeSlack},
+			client.Notification{ID: "notification-2", Name: "alerts", Type: client.NotificationTypeSlack},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewNotificationsDataSource(), api, &NotificationsDataSourceModel{
+		Name:      types.StringValue("Alerts"),
+		ExactName: types.BoolValue(true),
+		Type:      types.StringNull(),
+		Limit:     types.Int64Null(),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data NotificationsDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if len(data.Notifications) != 1 || data.Notifications[0].ID.ValueString() != "notification-2" {
+		t.Errorf("Expected only notification-2, got %+v", data.Notifications)
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &userDataSource{}
+	_ datasource.DataSourceWithConfigure = &userDataSource{}
+)
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client client.API
+}
+
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The unique identifier of the user",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["email"] = schema.StringAttribute{
+		Description: "The email address of the user (alternative to id), compared case-insensitively",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single user of the Firefly account by ID or email",
+		Attributes:  attributes,
+	}
+}
+
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate that either ID or email is provided, but not both
+	hasID := data.ID.ValueString() != ""
+	hasEmail := data.Email.ValueString() != ""
+
+	if !hasID && !hasEmail {
+		resp.Diagnostics.AddError(
+			"Missing Required Attribute",
+			"Either 'id' or 'email' must be specified to identify the user",
+		)
+		return
+	}
+
+	if hasID && hasEmail {
+		resp.Diagnostics.AddError(
+			"Conflicting Attributes",
+			"Only one of 'id' or 'email' should be specified, not both",
+		)
+		return
+	}
+
+	var user *client.User
+	var err error
+	if hasID {
+		tflog.Debug(ctx, "Reading user by ID", map[string]interface{}{"id": data.ID.ValueString()})
+		user, err = d.client.Users().GetUser(ctx, data.ID.ValueString())
+	} else {
+		tflog.Debug(ctx, "Reading user by email", map[string]interface{}{"email": data.Email.ValueString()})
+		user, err = d.client.Users().GetUserByEmail(ctx, data.Email.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError("User Not Found", fmt.Sprintf("No user of the account matches %s", describeUserLookup(data)))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading User", fmt.Sprintf("Could not read user %s: %s", describeUserLookup(data), err))
+		return
+	}
+
+	data = newUserDataModel(*user)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// describeUserLookup returns the ID or the email a user is looked up with, for messages
+func describeUserLookup(data UserDataModel) string {
+	if data.ID.ValueString() != "" {
+		return "ID " + data.ID.ValueString()
+	}
+	return "email " + data.Email.ValueString()
+}
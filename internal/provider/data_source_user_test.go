@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "firefly_user" "by_email" {
+  email = "Dev@Example.com"
+}
+
+data "firefly_user" "by_id" {
+  id = "user123"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_user.by_email", "id", "dev-user-id"),
+					resource.TestCheckResourceAttr("data.firefly_user.by_email", "email", "dev@example.com"),
+					resource.TestCheckResourceAttr("data.firefly_user.by_email", "name", "Dev User"),
+					resource.TestCheckResourceAttr("data.firefly_user.by_id", "email", "test-user@example.com"),
+					resource.TestCheckResourceAttr("data.firefly_user.by_id", "role", "admin"),
+				),
+			},
+			{
+				Config: `
+data "firefly_user" "missing" {
+  email = "new-hire@example.com"
+}
+`,
+				ExpectError: regexp.MustCompile("User Not Found"),
+			},
+		},
+	})
+}
+
+// testUserModel returns the configuration of the data source
+func testUserModel(id, email string) *UserDataModel {
+	return &UserDataModel{
+		ID:        StringValueOrNull(id),
+		Email:     StringValueOrNull(email),
+		Name:      types.StringNull(),
+		Role:      types.StringNull(),
+		Status:    types.StringNull(),
+		CreatedAt: types.StringNull(),
+		LastLogin: types.StringNull(),
+	}
+}
+
+func TestUserDataSource_Lookup(t *testing.T) {
+	api := &mockAPI{}
+	api.users.getUserByEmail = func(ctx context.Context, email string) (*client.User, error) {
+		return &client.User{ID: "user-1", Email: "dev@example.com", Name: "Dev", Role: "member", Status: "active"}, nil
+	}
+
+	resp := runDataSourceRead(t, NewUserDataSource(), api, testUserModel("", "DEV@example.com"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data UserDataModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if data.ID.ValueString() != "user-1" || data.Email.ValueString() != "dev@example.com" {
+		t.Errorf("Unexpected id %s or email %s", data.ID, data.Email)
+	}
+}
+
+func TestUserDataSource_InvalidLookup(t *testing.T) {
+	tests := map[string]struct {
+		model   *UserDataModel
+		summary string
+	}{
+		"missing":     {model: testUserModel("", ""), summary: "Missing Required Attribute"},
+		"conflicting": {model: testUserModel("user-1", "dev@example.com"), summary: "Conflicting Attributes"},
+		"not found":   {model: testUserModel("user-2", ""), summary: "User Not Found"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			api := &mockAPI{}
+			api.users.getUser = func(ctx context.Context, id string) (*client.User, error) {
+				return nil, notFoundError()
+			}
+
+			resp := runDataSourceRead(t, NewUserDataSource(), api, tt.model)
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.summary {
+				t.Errorf("Expected %q, got %v", tt.summary, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation
+type usersDataSource struct {
+	client client.API
+}
+
+// UsersDataSourceModel describes the data source data model
+type UsersDataSourceModel struct {
+	Email types.String    `tfsdk:"email"`
+	Name  types.String    `tfsdk:"name"`
+	Role  types.String    `tfsdk:"role"`
+	Limit types.Int64     `tfsdk:"limit"`
+	Users []UserDataModel `tfsdk:"users"`
+}
+
+// UserDataModel describes a single user in the data source
+type UserDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Name      types.String `tfsdk:"name"`
+	Role      types.String `tfsdk:"role"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	LastLogin types.String `tfsdk:"last_login"`
+}
+
+// userAttributes are the computed attributes describing a user
+func userAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the user",
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "The email address of the user",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The full name of the user",
+			Computed:    true,
+		},
+		"role": schema.StringAttribute{
+			Description: "The role of the user in the account",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "The status of the user in the account, e.g. active or invited",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Timestamp when the user joined the account",
+			Computed:    true,
+		},
+		"last_login": schema.StringAttribute{
+			Description: "Timestamp of the last login of the user",
+			Computed:    true,
+		},
+	}
+}
+
+// newUserDataModel converts a user returned by the API
+func newUserDataModel(user client.User) UserDataModel {
+	return UserDataModel{
+		ID:        types.StringValue(user.ID),
+		Email:     types.StringValue(user.Email),
+		Name:      types.StringValue(user.Name),
+		Role:      types.StringValue(user.Role),
+		Status:    types.StringValue(user.Status),
+		CreatedAt: types.StringValue(user.CreatedAt),
+		LastLogin: types.StringValue(user.LastLogin),
+	}
+}
+
+// Metadata returns the data source type name
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the users of the Firefly account, optionally filtered by email, name or role",
+		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("users"),
+			"email": schema.StringAttribute{
+				Description: "Only return the users whose email contains this value",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return the users whose name contains this value",
+				Optional:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Only return the users with this account role",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "List of users",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &client.ListUsersRequest{
+		Email: data.Email.ValueString(),
+		Name:  data.Name.ValueString(),
+		Role:  data.Role.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Reading users", map[string]interface{}{
+		"email": request.Email,
+		"name":  request.Name,
+		"role":  request.Role,
+	})
+
+	userList, err := client.Collect(d.client.Users().AllUsers(ctx, request), limitValue(data.Limit))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Users", fmt.Sprintf("Could not read users: %s", err))
+		return
+	}
+
+	// Map response to model
+	users := make([]UserDataModel, len(userList))
+	for i, user := range userList {
+		users[i] = newUserDataModel(user)
+	}
+	data.Users = users
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
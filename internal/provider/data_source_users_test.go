@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_users.all", "users.#", "3"),
+					resource.TestCheckResourceAttr("data.firefly_users.viewers", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_users.viewers", "users.0.email", "viewer@example.com"),
+					resource.TestCheckResourceAttr("data.firefly_users.viewers", "users.0.status", "active"),
+					resource.TestCheckResourceAttr("data.firefly_users.by_name", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_users.by_name", "users.0.id", "dev-user-id"),
+					resource.TestCheckResourceAttr("data.firefly_users.limited", "users.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccUsersDataSourceConfig = `
+data "firefly_users" "all" {}
+
+data "firefly_users" "viewers" {
+  role = "viewer"
+}
+
+data "firefly_users" "by_name" {
+  name  = "dev"
+  email = "@example.com"
+}
+
+data "firefly_users" "limited" {
+  limit = 2
+}
+`
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ datasource.DataSource              = &vcsIntegrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &vcsIntegrationsDataSource{}
+)
+
+// vcsTypes are the types of VCS integrations
+var vcsTypes = []string{
+	string(client.VcsTypeGithub),
+	string(client.VcsTypeGitlab),
+	string(client.VcsTypeBitbucket),
+	string(client.VcsTypeCodecommit),
+	string(client.VcsTypeAzureDevops),
+}
+
+// NewVcsIntegrationsDataSource is a helper function to simplify the provider implementation
+func NewVcsIntegrationsDataSource() datasource.DataSource {
+	return &vcsIntegrationsDataSource{}
+}
+
+// vcsIntegrationsDataSource is the data source implementation
+type vcsIntegrationsDataSource struct {
+	client client.API
+}
+
+// VcsIntegrationsDataSourceModel describes the data source data model
+type VcsIntegrationsDataSourceModel struct {
+	Name         types.String              `tfsdk:"name"`
+	ExactName    types.Bool                `tfsdk:"exact_name"`
+	Type         types.String              `tfsdk:"type"`
+	Limit        types.Int64               `tfsdk:"limit"`
+	Integrations []VcsIntegrationDataModel `tfsdk:"integrations"`
+}
+
+// VcsIntegrationDataModel describes a single VCS integration in the data source
+type VcsIntegrationDataModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// integrationFilterAttributes are the optional attributes filtering the integrations, of one of integrationTypes
+func integrationFilterAttributes(integrationTypes []string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"limit": limitAttribute("integrations"),
+		"name": schema.StringAttribute{
+			Description: "Only return the integrations whose name contains this value",
+			Optional:    true,
+		},
+		"exact_name": schema.BoolAttribute{
+			Description: "Only return the integrations named exactly name, compared case-insensitively",
+			Optional:    true,
+			Validators: []validator.Bool{
+				boolvalidator.AlsoRequires(path.MatchRoot("name")),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: fmt.Sprintf("Only return the integrations of this type: %s", strings.Join(integrationTypes, ", ")),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(integrationTypes...),
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name
+func (d *vcsIntegrationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vcs_integrations"
+}
+
+// Schema defines the schema for the data source
+func (d *vcsIntegrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := integrationFilterAttributes(vcsTypes)
+	attributes["integrations"] = schema.ListNestedAttribute{
+		Description: "List of VCS integrations",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The unique identifier of the integration, e.g. to use as vcs_integration_id",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "The name of the integration",
+					Computed:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "The type of version control system",
+					Computed:    true,
+				},
+				"status": schema.StringAttribute{
+					Description: "The status of the integration",
+					Computed:    true,
+				},
+				"created_at": schema.StringAttribute{
+					Description: "Timestamp when the integration was connected",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the VCS integrations of the Firefly account, optionally filtered by name or type",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source
+func (d *vcsIntegrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data
+func (d *vcsIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VcsIntegrationsDataSourceModel
+
+	// Read configuration
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &client.ListIntegrationsRequest{
+		Name: data.Name.ValueString(),
+		Type: data.Type.ValueString(),
+	}
+
+	tflog.Debug(ctx, "Reading VCS integrations", map[string]interface{}{
+		"name":       request.Name,
+		"exact_name": data.ExactName.ValueBool(),
+		"type":       request.Type,
+	})
+
+	integrations := d.client.Integrations().AllVcsIntegrations(ctx, request)
+	if data.ExactName.ValueBool() {
+		integrations = client.Filter(integrations, func(i client.VcsIntegration) bool {
+			return strings.EqualFold(i.Name, request.Name)
+		})
+	}
+
+	integrationList, err := client.Collect(integrations, limitValue(data.Limit))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading VCS Integrations", fmt.Sprintf("Could not read VCS integrations: %s", err))
+		return
+	}
+
+	// Map response to model
+	data.Integrations = make([]VcsIntegrationDataModel, len(integrationList))
+	for i, integration := range integrationList {
+		data.Integrations[i] = VcsIntegrationDataModel{
+			ID:        types.StringValue(integration.ID),
+			Name:      types.StringValue(integration.Name),
+			Type:      types.StringValue(string(integration.Type)),
+			Status:    types.StringValue(integration.Status),
+			CreatedAt: types.StringValue(integration.CreatedAt),
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
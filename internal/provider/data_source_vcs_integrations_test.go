@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"iter"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVcsIntegrationsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVcsIntegrationsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.firefly_vcs_integrations.all", "integrations.#", "2"),
+					resource.TestCheckResourceAttr("data.firefly_vcs_integrations.github", "integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_vcs_integrations.github", "integrations.0.id", "test-vcs-integration-id"),
+					resource.TestCheckResourceAttr("data.firefly_vcs_integrations.github", "integrations.0.status", "active"),
+					resource.TestCheckResourceAttr("data.firefly_vcs_integrations.by_name", "integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.firefly_vcs_integrations.by_name", "integrations.0.type", "gitlab"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcsIntegrationsDataSourceConfig = `
+data "firefly_vcs_integrations" "all" {}
+
+data "firefly_vcs_integrations" "github" {
+  type = "github"
+}
+
+data "firefly_vcs_integrations" "by_name" {
+  name       = "test gitlab"
+  exact_name = true
+}
+`
+
+func TestVcsIntegrationsDataSource_ExactName(t *testing.T) {
+	var received *client.ListIntegrationsRequest
+	api := &mockAPI{}
+	api.integrations.allVcsIntegrations = func(ctx context.Context, request *client.ListIntegrationsRequest) iter.Seq2[client.VcsIntegration, error] {
+		received = request
+		// The API matches the names containing the filter
+		return sliceSeq(
+			client.VcsIntegration{ID: "vcs-1", Name: "main-github-legacy", Type: client.VcsTypeGithub},
+			client.VcsIntegration{ID: "vcs-2", Name: "Main-GitHub", Type: client.VcsTypeGithub},
+		)
+	}
+
+	resp := runDataSourceRead(t, NewVcsIntegrationsDataSource(), api, &VcsIntegrationsDataSourceModel{
+		Name:      types.StringValue("main-github"),
+		ExactName: types.BoolValue(true),
+		Type:      types.StringValue("github"),
+		Limit:     types.Int64Null(),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data VcsIntegrationsDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if received.Name != "main-github" || received.Type != "github" {
+		t.Errorf("Unexpected request %+v", received)
+	}
+	if len(data.Integrations) != 1 || data.Integrations[0].ID.ValueString() != "vcs-2" {
+		t.Errorf("Expected only vcs-2, got %+v", data.Integrations)
+	}
+}
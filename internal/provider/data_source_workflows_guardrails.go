@@ -26,7 +26,7 @@ func NewGuardrailsDataSource() datasource.DataSource {
 
 // guardrailsDataSource is the data source implementation
 type guardrailsDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 // GuardrailFiltersModel describes the guardrail filters
@@ -58,6 +58,7 @@ type GuardrailsDataSourceModel struct {
 	Guardrails  types.List             `tfsdk:"guardrails"`
 	Filters     *GuardrailFiltersModel `tfsdk:"filters"`
 	SearchValue types.String           `tfsdk:"search_value"`
+	Limit       types.Int64            `tfsdk:"limit"`
 }
 
 // Metadata returns the data source type name
@@ -70,6 +71,7 @@ func (d *guardrailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of Firefly guardrail rules",
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("guardrails"),
 			"guardrails": schema.ListNestedAttribute{
 				Description: "List of guardrail rules",
 				Computed:    true,
@@ -172,11 +174,11 @@ func (d *guardrailsDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -269,7 +271,7 @@ func (d *guardrailsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Get guardrails from API
-	guardrails, err := d.client.Guardrails.ListGuardrails(request, 0, 100)
+	guardrails, err := client.Collect(d.client.Guardrails().AllGuardrails(ctx, request), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Guardrails",
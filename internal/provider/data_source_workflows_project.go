@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 var (
@@ -22,7 +22,7 @@ func NewProjectDataSource() datasource.DataSource {
 }
 
 type projectDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 type ProjectSingleDataSourceModel struct {
@@ -98,11 +98,11 @@ func (d *projectDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -145,32 +145,24 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if hasID {
 		projectID := data.ID.ValueString()
 		tflog.Debug(ctx, "Reading project by ID", map[string]interface{}{"id": projectID})
-		project, err = d.client.Projects.GetProject(projectID)
+		project, err = d.client.Projects().GetProject(ctx, projectID)
 	} else {
 		// Search for project by path (name)
 		projectPath := data.Path.ValueString()
 		tflog.Debug(ctx, "Reading project by path", map[string]interface{}{"path": projectPath})
-		
-		// Use ListProjects with search to find project by name/path
-		projects, err := d.client.Projects.ListProjects(100, 0, projectPath)
-		if err != nil {
-			resp.Diagnostics.AddError("Error Searching Projects", fmt.Sprintf("Could not search for project path %s: %s", projectPath, err))
-			return
-		}
 
-		// Find exact match by name
-		var foundProject *client.Project
-		for _, p := range projects.Data {
-			if p.Name == projectPath {
-				foundProject = &p
-				break
-			}
-		}
-
-		if foundProject == nil {
+		// Search projects by name/path and find the exact match
+		foundProject, err := client.Find(d.client.Projects().AllProjects(ctx, projectPath), func(p client.Project) bool {
+			return p.Name == projectPath
+		})
+		if client.IsNotFound(err) {
 			resp.Diagnostics.AddError("Project Not Found", fmt.Sprintf("Could not find project with path %s", projectPath))
 			return
 		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error Searching Projects", fmt.Sprintf("Could not search for project path %s: %s", projectPath, err))
+			return
+		}
 
 		project = foundProject
 	}
@@ -205,4 +197,4 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-}
\ No newline at end of file
+}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -25,13 +25,14 @@ func NewProjectsDataSource() datasource.DataSource {
 
 // projectsDataSource is the data source implementation
 type projectsDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 // ProjectsDataSourceModel describes the data source data model
 type ProjectsDataSourceModel struct {
-	SearchQuery types.String         `tfsdk:"search_query"`
-	Projects    []ProjectDataModel   `tfsdk:"projects"`
+	SearchQuery types.String       `tfsdk:"search_query"`
+	Limit       types.Int64        `tfsdk:"limit"`
+	Projects    []ProjectDataModel `tfsdk:"projects"`
 }
 
 // ProjectDataModel describes a single project in the data source
@@ -57,6 +58,7 @@ func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Fetches a list of Firefly projects",
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("projects"),
 			"search_query": schema.StringAttribute{
 				Description: "Optional search query to filter projects",
 				Optional:    true,
@@ -116,11 +118,11 @@ func (d *projectsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -150,7 +152,7 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	})
 
 	// Get projects from API
-	projectsResp, err := d.client.Projects.ListProjects(100, 0, searchQuery) // Default to first 100
+	projectList, err := client.Collect(d.client.Projects().AllProjects(ctx, searchQuery), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Projects",
@@ -160,8 +162,8 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Map response to model
-	projects := make([]ProjectDataModel, len(projectsResp.Data))
-	for i, project := range projectsResp.Data {
+	projects := make([]ProjectDataModel, len(projectList))
+	for i, project := range projectList {
 		// Convert labels
 		var labelsList types.List
 		if len(project.Labels) > 0 {
@@ -192,4 +194,4 @@ func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Set state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-}
\ No newline at end of file
+}
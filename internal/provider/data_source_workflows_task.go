@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &workflowsTaskDataSource{}
+	_ datasource.DataSourceWithConfigure = &workflowsTaskDataSource{}
+)
+
+func NewWorkflowsTaskDataSource() datasource.DataSource {
+	return &workflowsTaskDataSource{}
+}
+
+type workflowsTaskDataSource struct {
+	client client.API
+}
+
+type WorkflowsTaskDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	TaskID      types.String `tfsdk:"task_id"`
+	TaskType    types.String `tfsdk:"task_type"`
+	Status      types.String `tfsdk:"status"`
+	Finished    types.Bool   `tfsdk:"finished"`
+	RunID       types.String `tfsdk:"run_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+	Message     types.String `tfsdk:"message"`
+	Logs        types.String `tfsdk:"logs"`
+}
+
+func (d *workflowsTaskDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflows_task"
+}
+
+func (d *workflowsTaskDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the status and logs of a task of a Firefly runners workspace, by ID or the most recent one",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the task",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "The ID of the runners workspace the task was started on",
+				Required:    true,
+			},
+			"task_id": schema.StringAttribute{
+				Description: "The ID of the task. When not set, the most recent task of the workspace is fetched",
+				Optional:    true,
+				Computed:    true,
+			},
+			"task_type": schema.StringAttribute{
+				Description: "The type of the task, e.g. plan, apply or destroy. When task_id is not set, restricts the lookup to the most recent task of this type",
+				Optional:    true,
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the task: queued, running, completed, failed or cancelled",
+				Computed:    true,
+			},
+			"finished": schema.BoolAttribute{
+				Description: "Whether the task reached a final status",
+				Computed:    true,
+			},
+			"run_id": schema.StringAttribute{
+				Description: "The ID of the run started by a plan or apply task",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the task was started",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp of the last status change of the task",
+				Computed:    true,
+			},
+			"message": schema.StringAttribute{
+				Description: "The reason of a failure",
+				Computed:    true,
+			},
+			"logs": schema.StringAttribute{
+				Description: "The output of the task",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *workflowsTaskDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *workflowsTaskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkflowsTaskDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := data.WorkspaceID.ValueString()
+	taskID := data.TaskID.ValueString()
+
+	// Look up the most recent task when no ID is given
+	if taskID == "" {
+		tasks, err := d.client.RunnersWorkspaces().ListTasks(ctx, workspaceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Listing Tasks", fmt.Sprintf("Could not list the tasks of runners workspace ID %s: %s", workspaceID, err))
+			return
+		}
+
+		taskType := data.TaskType.ValueString()
+		for _, task := range tasks {
+			if taskType == "" || task.TaskType == taskType {
+				taskID = task.TaskID
+				break
+			}
+		}
+		if taskID == "" {
+			detail := fmt.Sprintf("Runners workspace ID %s has no task", workspaceID)
+			if taskType != "" {
+				detail = fmt.Sprintf("Runners workspace ID %s has no %s task", workspaceID, taskType)
+			}
+			resp.Diagnostics.AddError("Task Not Found", detail)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Reading task", map[string]interface{}{"workspace_id": workspaceID, "task_id": taskID})
+
+	task, err := d.client.RunnersWorkspaces().GetTask(ctx, workspaceID, taskID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Task", fmt.Sprintf("Could not read task ID %s of runners workspace ID %s: %s", taskID, workspaceID, err))
+		return
+	}
+
+	data.ID = types.StringValue(task.TaskID)
+	data.TaskID = types.StringValue(task.TaskID)
+	data.TaskType = types.StringValue(task.TaskType)
+	data.Status = types.StringValue(task.Status)
+	data.Finished = types.BoolValue(client.IsTerminalTaskStatus(task.Status))
+	data.RunID = types.StringValue(task.RunID)
+	data.CreatedAt = types.StringValue(task.CreatedAt)
+	data.UpdatedAt = types.StringValue(task.UpdatedAt)
+	data.Message = types.StringValue(task.Message)
+	data.Logs = types.StringValue(task.Logs)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkflowsTaskDataSource_basic(t *testing.T) {
+	fastPolling(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowsTaskDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.firefly_workflows_task.test", "run_id", "firefly_workflows_run.test", "run_id"),
+					resource.TestCheckResourceAttrPair("data.firefly_workflows_task.test", "task_id", "firefly_workflows_run.test", "task_id"),
+					resource.TestCheckResourceAttr("data.firefly_workflows_task.test", "task_type", "apply"),
+					resource.TestCheckResourceAttrSet("data.firefly_workflows_task.test", "status"),
+					resource.TestCheckResourceAttrSet("data.firefly_workflows_task.test", "logs"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowsTaskDataSourceConfig() string {
+	return `
+resource "firefly_workflows_runners_workspace" "test" {
+  name               = "workspace-with-tasks"
+  repository         = "myorg/infrastructure"
+  vcs_integration_id = "test-vcs-integration-id"
+  vcs_type           = "github"
+  default_branch     = "main"
+}
+
+resource "firefly_workflows_run" "test" {
+  workspace_id = firefly_workflows_runners_workspace.test.id
+}
+
+data "firefly_workflows_task" "test" {
+  workspace_id = firefly_workflows_runners_workspace.test.id
+  task_type    = "apply"
+
+  depends_on = [firefly_workflows_run.test]
+}
+`
+}
+
+// testWorkflowsTaskModel returns the configuration of the data source
+func testWorkflowsTaskModel(taskID, taskType string) *WorkflowsTaskDataSourceModel {
+	model := &WorkflowsTaskDataSourceModel{
+		ID:          types.StringNull(),
+		WorkspaceID: types.StringValue("workspace-1"),
+		TaskID:      types.StringNull(),
+		TaskType:    types.StringNull(),
+		Status:      types.StringNull(),
+		Finished:    types.BoolNull(),
+		RunID:       types.StringNull(),
+		CreatedAt:   types.StringNull(),
+		UpdatedAt:   types.StringNull(),
+		Message:     types.StringNull(),
+		Logs:        types.StringNull(),
+	}
+	if taskID != "" {
+		model.TaskID = types.StringValue(taskID)
+	}
+	if taskType != "" {
+		model.TaskType = types.StringValue(taskType)
+	}
+	return model
+}
+
+func TestWorkflowsTaskDataSource_ByID(t *testing.T) {
+	api := &mockAPI{}
+	api.runnersWorkspaces.getTask = func(ctx context.Context, workspaceID, taskID string) (*client.TaskResponse, error) {
+		return &client.TaskResponse{TaskID: taskID, Status: client.TaskStatusFailed, TaskType: "destroy", Message: "state locked", Logs: "Error: state locked"}, nil
+	}
+
+	resp := runDataSourceRead(t, NewWorkflowsTaskDataSource(), api, testWorkflowsTaskModel("task-1", ""))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	var data WorkflowsTaskDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if data.ID.ValueString() != "task-1" || data.TaskType.ValueString() != "destroy" {
+		t.Errorf("Unexpected id %s or task_type %s", data.ID, data.TaskType)
+	}
+	if data.Status.ValueString() != "failed" || !data.Finished.ValueBool() {
+		t.Errorf("Expected a finished failed task, got status %s and finished %s", data.Status, data.Finished)
+	}
+	if data.Message.ValueString() != "state locked" || data.Logs.ValueString() != "Error: state locked" {
+		t.Errorf("Unexpected message %s or logs %s", data.Message, data.Logs)
+	}
+}
+
+func TestWorkflowsTaskDataSource_MostRecentOfType(t *testing.T) {
+	var read string
+	api := &mockAPI{}
+	api.runnersWorkspaces.listTasks = func(ctx context.Context, workspaceID string) ([]client.TaskResponse, error) {
+		return []client.TaskResponse{
+			{TaskID: "task-3", TaskType: client.TaskTypePlan},
+			{TaskID: "task-2", TaskType: "destroy"},
+			{TaskID: "task-1", TaskType: "destroy"},
+		}, nil
+	}
+	api.runnersWorkspaces.getTask = func(ctx context.Context, workspaceID, taskID string) (*client.TaskResponse, error) {
+		read = taskID
+		return &client.TaskResponse{TaskID: taskID, Status: client.TaskStatusRunning, TaskType: "destroy"}, nil
+	}
+
+	resp := runDataSourceRead(t, NewWorkflowsTaskDataSource(), api, testWorkflowsTaskModel("", "destroy"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+	if read != "task-2" {
+		t.Errorf("Expected the most recent destroy task to be read, got %q", read)
+	}
+
+	var data WorkflowsTaskDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if data.TaskID.ValueString() != "task-2" || data.Finished.ValueBool() {
+		t.Errorf("Expected the running task-2, got %s finished %s", data.TaskID, data.Finished)
+	}
+}
+
+func TestWorkflowsTaskDataSource_NoTask(t *testing.T) {
+	api := &mockAPI{}
+	api.runnersWorkspaces.listTasks = func(ctx context.Context, workspaceID string) ([]client.TaskResponse, error) {
+		return []client.TaskResponse{{TaskID: "task-1", TaskType: client.TaskTypePlan}}, nil
+	}
+
+	resp := runDataSourceRead(t, NewWorkflowsTaskDataSource(), api, testWorkflowsTaskModel("", "destroy"))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error when the workspace has no matching task")
+	}
+}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 var (
@@ -22,7 +22,7 @@ func NewVariableSetDataSource() datasource.DataSource {
 }
 
 type variableSetDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 type VariableSetSingleDataSourceModel struct {
@@ -77,11 +77,11 @@ func (d *variableSetDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -101,7 +101,7 @@ func (d *variableSetDataSource) Read(ctx context.Context, req datasource.ReadReq
 	variableSetID := data.ID.ValueString()
 	tflog.Debug(ctx, "Reading variable set", map[string]interface{}{"id": variableSetID})
 
-	variableSet, err := d.client.VariableSets.GetVariableSet(variableSetID)
+	variableSet, err := d.client.VariableSets().GetVariableSet(ctx, variableSetID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Variable Set", fmt.Sprintf("Could not read variable set ID %s: %s", variableSetID, err))
 		return
@@ -139,4 +139,4 @@ func (d *variableSetDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-}
\ No newline at end of file
+}
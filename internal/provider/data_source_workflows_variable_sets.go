@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 var (
@@ -22,12 +22,13 @@ func NewVariableSetsDataSource() datasource.DataSource {
 }
 
 type variableSetsDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 type VariableSetsDataSourceModel struct {
-	SearchQuery  types.String               `tfsdk:"search_query"`
-	VariableSets []VariableSetDataModel     `tfsdk:"variable_sets"`
+	SearchQuery  types.String           `tfsdk:"search_query"`
+	Limit        types.Int64            `tfsdk:"limit"`
+	VariableSets []VariableSetDataModel `tfsdk:"variable_sets"`
 }
 
 type VariableSetDataModel struct {
@@ -47,6 +48,7 @@ func (d *variableSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 	resp.Schema = schema.Schema{
 		Description: "Fetches a list of Firefly variable sets",
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("variable sets"),
 			"search_query": schema.StringAttribute{
 				Description: "Optional search query to filter variable sets",
 				Optional:    true,
@@ -94,11 +96,11 @@ func (d *variableSetsDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -124,7 +126,7 @@ func (d *variableSetsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		"search_query": searchQuery,
 	})
 
-	variableSets, err := d.client.VariableSets.ListVariableSets(100, 0, searchQuery)
+	variableSets, err := client.Collect(d.client.VariableSets().AllVariableSets(ctx, searchQuery), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Variable Sets",
@@ -174,4 +176,4 @@ func (d *variableSetsDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
-}
\ No newline at end of file
+}
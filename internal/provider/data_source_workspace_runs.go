@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -24,19 +24,19 @@ func NewWorkspaceRunsDataSource() datasource.DataSource {
 
 // workspaceRunsDataSource is the data source implementation
 type workspaceRunsDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 // WorkspaceRunFiltersModel describes the workspace run filters
 type WorkspaceRunFiltersModel struct {
-	RunID       types.List   `tfsdk:"run_id"`
-	RunName     types.List   `tfsdk:"run_name"`
-	Status      types.List   `tfsdk:"status"`
-	Branch      types.List   `tfsdk:"branch"`
-	CommitID    types.List   `tfsdk:"commit_id"`
-	CITool      types.List   `tfsdk:"ci_tool"`
-	VCSType     types.List   `tfsdk:"vcs_type"`
-	Repository  types.List   `tfsdk:"repository"`
+	RunID      types.List `tfsdk:"run_id"`
+	RunName    types.List `tfsdk:"run_name"`
+	Status     types.List `tfsdk:"status"`
+	Branch     types.List `tfsdk:"branch"`
+	CommitID   types.List `tfsdk:"commit_id"`
+	CITool     types.List `tfsdk:"ci_tool"`
+	VCSType    types.List `tfsdk:"vcs_type"`
+	Repository types.List `tfsdk:"repository"`
 }
 
 // WorkspaceRunDataModel describes a single workspace run
@@ -64,10 +64,11 @@ type WorkspaceRunDataModel struct {
 
 // WorkspaceRunsDataSourceModel describes the data source data model
 type WorkspaceRunsDataSourceModel struct {
-	WorkspaceID  types.String            `tfsdk:"workspace_id"`
-	Runs         types.List              `tfsdk:"runs"`
-	Filters      *WorkspaceRunFiltersModel `tfsdk:"filters"`
-	SearchValue  types.String            `tfsdk:"search_value"`
+	WorkspaceID types.String              `tfsdk:"workspace_id"`
+	Runs        types.List                `tfsdk:"runs"`
+	Filters     *WorkspaceRunFiltersModel `tfsdk:"filters"`
+	SearchValue types.String              `tfsdk:"search_value"`
+	Limit       types.Int64               `tfsdk:"limit"`
 }
 
 // Metadata returns the data source type name
@@ -80,6 +81,7 @@ func (d *workspaceRunsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of runs for a Firefly workspace",
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("runs"),
 			"workspace_id": schema.StringAttribute{
 				Description: "ID of the workspace to fetch runs for",
 				Required:    true,
@@ -229,11 +231,11 @@ func (d *workspaceRunsDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -262,16 +264,16 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	// Prepare the request with filters
 	request := &client.ListWorkspaceRunsRequest{}
-	
+
 	// Add search value if provided
 	if !data.SearchValue.IsNull() {
 		request.SearchValue = data.SearchValue.ValueString()
 	}
-	
+
 	// Add filters if provided
 	if data.Filters != nil {
 		filters := &client.WorkspaceRunFilters{}
-		
+
 		// Add run ID filter
 		if !data.Filters.RunID.IsNull() {
 			var runIDs []string
@@ -281,7 +283,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.RunID = runIDs
 			}
 		}
-		
+
 		// Add run name filter
 		if !data.Filters.RunName.IsNull() {
 			var runNames []string
@@ -291,7 +293,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.RunName = runNames
 			}
 		}
-		
+
 		// Add status filter
 		if !data.Filters.Status.IsNull() {
 			var statuses []string
@@ -301,7 +303,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.Status = statuses
 			}
 		}
-		
+
 		// Add branch filter
 		if !data.Filters.Branch.IsNull() {
 			var branches []string
@@ -311,7 +313,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.Branch = branches
 			}
 		}
-		
+
 		// Add commit ID filter
 		if !data.Filters.CommitID.IsNull() {
 			var commitIDs []string
@@ -321,7 +323,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.CommitID = commitIDs
 			}
 		}
-		
+
 		// Add CI tool filter
 		if !data.Filters.CITool.IsNull() {
 			var ciTools []string
@@ -331,7 +333,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.CITool = ciTools
 			}
 		}
-		
+
 		// Add VCS type filter
 		if !data.Filters.VCSType.IsNull() {
 			var vcsTypes []string
@@ -341,7 +343,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.VCSType = vcsTypes
 			}
 		}
-		
+
 		// Add repository filter
 		if !data.Filters.Repository.IsNull() {
 			var repositories []string
@@ -351,12 +353,12 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 				filters.Repository = repositories
 			}
 		}
-		
+
 		request.Filters = filters
 	}
-	
+
 	// Get runs from API
-	runs, err := d.client.Workspaces.ListWorkspaceRuns(workspaceID, request, 0, 100)
+	runs, err := client.Collect(d.client.Workspaces().AllWorkspaceRuns(ctx, workspaceID, request), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Workspace Runs",
@@ -364,7 +366,7 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 		)
 		return
 	}
-	
+
 	// Map response to model
 	var runModels []WorkspaceRunDataModel
 	for _, run := range runs {
@@ -389,10 +391,10 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 			CreatedAt:     types.StringValue(run.CreatedAt),
 			UpdatedAt:     types.StringValue(run.UpdatedAt),
 		}
-		
+
 		runModels = append(runModels, runModel)
 	}
-	
+
 	// Set runs in the data model
 	runsList, diags := types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
@@ -421,9 +423,9 @@ func (d *workspaceRunsDataSource) Read(ctx context.Context, req datasource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	
+
 	data.Runs = runsList
-	
+
 	// Set state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
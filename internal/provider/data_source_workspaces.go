@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -24,18 +24,18 @@ func NewWorkspacesDataSource() datasource.DataSource {
 
 // workspacesDataSource is the data source implementation
 type workspacesDataSource struct {
-	client *client.Client
+	client client.API
 }
 
 // WorkspaceFiltersModel describes the workspace filters
 type WorkspaceFiltersModel struct {
-	WorkspaceName    types.List   `tfsdk:"workspace_name"`
-	Repositories     types.List   `tfsdk:"repositories"`
-	CITool           types.List   `tfsdk:"ci_tool"`
-	Labels           types.List   `tfsdk:"labels"`
-	Status           types.List   `tfsdk:"status"`
-	IsManagedWorkflow types.Bool  `tfsdk:"is_managed_workflow"`
-	VCSType          types.List   `tfsdk:"vcs_type"`
+	WorkspaceName     types.List `tfsdk:"workspace_name"`
+	Repositories      types.List `tfsdk:"repositories"`
+	CITool            types.List `tfsdk:"ci_tool"`
+	Labels            types.List `tfsdk:"labels"`
+	Status            types.List `tfsdk:"status"`
+	IsManagedWorkflow types.Bool `tfsdk:"is_managed_workflow"`
+	VCSType           types.List `tfsdk:"vcs_type"`
 }
 
 // WorkspaceDataModel describes a single workspace
@@ -63,9 +63,10 @@ type WorkspaceDataModel struct {
 
 // WorkspacesDataSourceModel describes the data source data model
 type WorkspacesDataSourceModel struct {
-	Workspaces types.List          `tfsdk:"workspaces"`
-	Filters    *WorkspaceFiltersModel `tfsdk:"filters"`
-	SearchValue types.String       `tfsdk:"search_value"`
+	Workspaces  types.List             `tfsdk:"workspaces"`
+	Filters     *WorkspaceFiltersModel `tfsdk:"filters"`
+	SearchValue types.String           `tfsdk:"search_value"`
+	Limit       types.Int64            `tfsdk:"limit"`
 }
 
 // Metadata returns the data source type name
@@ -78,6 +79,7 @@ func (d *workspacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	resp.Schema = schema.Schema{
 		Description: "Fetches the list of Firefly workspaces",
 		Attributes: map[string]schema.Attribute{
+			"limit": limitAttribute("workspaces"),
 			"workspaces": schema.ListNestedAttribute{
 				Description: "List of workspaces",
 				Computed:    true,
@@ -218,11 +220,11 @@ func (d *workspacesDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -241,16 +243,16 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	// Prepare the request with filters
 	request := &client.ListWorkspacesRequest{}
-	
+
 	// Add search value if provided
 	if !data.SearchValue.IsNull() {
 		request.SearchValue = data.SearchValue.ValueString()
 	}
-	
+
 	// Add filters if provided
 	if data.Filters != nil {
 		filters := &client.WorkspaceFilters{}
-		
+
 		// Add workspace name filter
 		if !data.Filters.WorkspaceName.IsNull() {
 			var workspaceNames []string
@@ -260,7 +262,7 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				filters.WorkspaceName = workspaceNames
 			}
 		}
-		
+
 		// Add repositories filter
 		if !data.Filters.Repositories.IsNull() {
 			var repositories []string
@@ -270,7 +272,7 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				filters.Repositories = repositories
 			}
 		}
-		
+
 		// Add CI tool filter
 		if !data.Filters.CITool.IsNull() {
 			var ciTools []string
@@ -280,7 +282,7 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				filters.CITool = ciTools
 			}
 		}
-		
+
 		// Add labels filter
 		if !data.Filters.Labels.IsNull() {
 			var labels []string
@@ -290,7 +292,7 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				filters.Labels = labels
 			}
 		}
-		
+
 		// Add status filter
 		if !data.Filters.Status.IsNull() {
 			var statuses []string
@@ -300,13 +302,13 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				filters.Status = statuses
 			}
 		}
-		
+
 		// Add is managed workflow filter
 		if !data.Filters.IsManagedWorkflow.IsNull() {
 			isManagedWorkflow := data.Filters.IsManagedWorkflow.ValueBool()
 			filters.IsManagedWorkflow = &isManagedWorkflow
 		}
-		
+
 		// Add VCS type filter
 		if !data.Filters.VCSType.IsNull() {
 			var vcsTypes []string
@@ -316,12 +318,12 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 				filters.VCSType = vcsTypes
 			}
 		}
-		
+
 		request.Filters = filters
 	}
-	
+
 	// Get workspaces from API
-	workspaces, err := d.client.Workspaces.ListWorkspaces(request, 0, 100)
+	workspaces, err := client.Collect(d.client.Workspaces().AllWorkspaces(ctx, request), limitValue(data.Limit))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Workspaces",
@@ -329,68 +331,68 @@ func (d *workspacesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		)
 		return
 	}
-	
+
 	// Map response to model
 	var workspaceModels []WorkspaceDataModel
 	for _, workspace := range workspaces {
 		workspaceModel := WorkspaceDataModel{
-			ID:               types.StringValue(workspace.ID),
-			AccountID:        types.StringValue(workspace.AccountID),
-			WorkspaceID:      types.StringValue(workspace.WorkspaceID),
-			WorkspaceName:    types.StringValue(workspace.WorkspaceName),
-			Repo:             types.StringValue(workspace.Repo),
-			RepoURL:          types.StringValue(workspace.RepoURL),
-			VCSType:          types.StringValue(workspace.VCSType),
-			RunnerType:       types.StringValue(workspace.RunnerType),
-			LastRunStatus:    types.StringValue(workspace.LastRunStatus),
-			LastApplyTime:    types.StringValue(workspace.LastApplyTime),
-			LastPlanTime:     types.StringValue(workspace.LastPlanTime),
-			LastRunTime:      types.StringValue(workspace.LastRunTime),
-			IACType:          types.StringValue(workspace.IACType),
-			IACTypeVersion:   types.StringValue(workspace.IACTypeVersion),
-			RunsCount:        types.Int64Value(int64(workspace.RunsCount)),
+			ID:                types.StringValue(workspace.ID),
+			AccountID:         types.StringValue(workspace.AccountID),
+			WorkspaceID:       types.StringValue(workspace.WorkspaceID),
+			WorkspaceName:     types.StringValue(workspace.WorkspaceName),
+			Repo:              types.StringValue(workspace.Repo),
+			RepoURL:           types.StringValue(workspace.RepoURL),
+			VCSType:           types.StringValue(workspace.VCSType),
+			RunnerType:        types.StringValue(workspace.RunnerType),
+			LastRunStatus:     types.StringValue(workspace.LastRunStatus),
+			LastApplyTime:     types.StringValue(workspace.LastApplyTime),
+			LastPlanTime:      types.StringValue(workspace.LastPlanTime),
+			LastRunTime:       types.StringValue(workspace.LastRunTime),
+			IACType:           types.StringValue(workspace.IACType),
+			IACTypeVersion:    types.StringValue(workspace.IACTypeVersion),
+			RunsCount:         types.Int64Value(int64(workspace.RunsCount)),
 			IsWorkflowManaged: types.BoolValue(workspace.IsWorkflowManaged),
-			CreatedAt:        types.StringValue(workspace.CreatedAt),
-			UpdatedAt:        types.StringValue(workspace.UpdatedAt),
+			CreatedAt:         types.StringValue(workspace.CreatedAt),
+			UpdatedAt:         types.StringValue(workspace.UpdatedAt),
 		}
-		
+
 		// Set labels
 		workspaceModel.Labels = types.ListValueMust(types.StringType, listToValues(workspace.Labels))
-		
+
 		workspaceModels = append(workspaceModels, workspaceModel)
 	}
-	
+
 	// Set workspaces in the data model
 	workspacesList, diags := types.ListValueFrom(ctx, types.ObjectType{
 		AttrTypes: map[string]attr.Type{
-			"id":                 types.StringType,
-			"account_id":         types.StringType,
-			"workspace_id":       types.StringType,
-			"workspace_name":     types.StringType,
-			"repo":               types.StringType,
-			"repo_url":           types.StringType,
-			"vcs_type":           types.StringType,
-			"runner_type":        types.StringType,
-			"last_run_status":    types.StringType,
-			"last_apply_time":    types.StringType,
-			"last_plan_time":     types.StringType,
-			"last_run_time":      types.StringType,
-			"iac_type":           types.StringType,
-			"iac_type_version":   types.StringType,
-			"labels":             types.ListType{ElemType: types.StringType},
-			"runs_count":         types.Int64Type,
+			"id":                  types.StringType,
+			"account_id":          types.StringType,
+			"workspace_id":        types.StringType,
+			"workspace_name":      types.StringType,
+			"repo":                types.StringType,
+			"repo_url":            types.StringType,
+			"vcs_type":            types.StringType,
+			"runner_type":         types.StringType,
+			"last_run_status":     types.StringType,
+			"last_apply_time":     types.StringType,
+			"last_plan_time":      types.StringType,
+			"last_run_time":       types.StringType,
+			"iac_type":            types.StringType,
+			"iac_type_version":    types.StringType,
+			"labels":              types.ListType{ElemType: types.StringType},
+			"runs_count":          types.Int64Type,
 			"is_workflow_managed": types.BoolType,
-			"created_at":         types.StringType,
-			"updated_at":         types.StringType,
+			"created_at":          types.StringType,
+			"updated_at":          types.StringType,
 		},
 	}, workspaceModels)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	
+
 	data.Workspaces = workspacesList
-	
+
 	// Set state
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
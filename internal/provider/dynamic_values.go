@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// dynamicToMap converts a dynamic attribute holding an object or a map to the map sent to the API.
+// A null value converts to a nil map, so that the field is left out of the request.
+func dynamicToMap(value types.Dynamic) (map[string]interface{}, error) {
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() || value.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	converted, err := attrToInterface(value.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := converted.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object or a map, got %s", value.UnderlyingValue().Type(context.Background()))
+	}
+	return m, nil
+}
+
+// mapToDynamic converts a map returned by the API to a dynamic attribute holding an object.
+// JSON arrays become tuples and JSON objects become objects, as HCL literals do.
+func mapToDynamic(m map[string]interface{}) (types.Dynamic, error) {
+	value, err := interfaceToAttr(m)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(value), nil
+}
+
+// refreshDynamic returns the value to store for an attribute the API returned as m. The current
+// value is kept when it holds the same content, so that a map configured where the API returns an
+// object, or a list where it returns a tuple, isn't reported as drift.
+func refreshDynamic(current types.Dynamic, m map[string]interface{}) (types.Dynamic, error) {
+	if sameDynamicContent(current, m) {
+		return current, nil
+	}
+	return mapToDynamic(m)
+}
+
+// sameDynamicContent reports whether the dynamic value holds the same content as m
+func sameDynamicContent(value types.Dynamic, m map[string]interface{}) bool {
+	current, err := dynamicToMap(value)
+	if err != nil {
+		return false
+	}
+	// A null value and an empty map hold no values either way
+	if len(current) == 0 && len(m) == 0 {
+		return true
+	}
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false
+	}
+	otherJSON, err := json.Marshal(m)
+	if err != nil {
+		return false
+	}
+	return string(currentJSON) == string(otherJSON)
+}
+
+// attrToInterface converts a Terraform value to its JSON representation
+func attrToInterface(value attr.Value) (interface{}, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return attrToInterface(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		number := v.ValueBigFloat()
+		if number.IsInt() {
+			if i, accuracy := number.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+		f, _ := number.Float64()
+		return f, nil
+	case basetypes.ObjectValue:
+		return attrMapToInterface(v.Attributes())
+	case basetypes.MapValue:
+		return attrMapToInterface(v.Elements())
+	case basetypes.ListValue:
+		return attrSliceToInterface(v.Elements())
+	case basetypes.SetValue:
+		return attrSliceToInterface(v.Elements())
+	case basetypes.TupleValue:
+		return attrSliceToInterface(v.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", value.Type(context.Background()))
+	}
+}
+
+func attrMapToInterface(values map[string]attr.Value) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		converted, err := attrToInterface(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		result[k] = converted
+	}
+	return result, nil
+}
+
+func attrSliceToInterface(values []attr.Value) ([]interface{}, error) {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		converted, err := attrToInterface(v)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+// interfaceToAttr converts a decoded JSON value to a Terraform value
+func interfaceToAttr(value interface{}) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case int64:
+		return types.NumberValue(new(big.Float).SetInt64(v)), nil
+	case int:
+		return types.NumberValue(new(big.Float).SetInt64(int64(v))), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(number), nil
+	case map[string]interface{}:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrValues := make(map[string]attr.Value, len(v))
+		for k, elem := range v {
+			converted, err := interfaceToAttr(elem)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k] = converted.Type(context.Background())
+			attrValues[k] = converted
+		}
+		object, diags := types.ObjectValue(attrTypes, attrValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building object: %v", diags)
+		}
+		return object, nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, len(v))
+		elemValues := make([]attr.Value, len(v))
+		for i, elem := range v {
+			converted, err := interfaceToAttr(elem)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elemTypes[i] = converted.Type(context.Background())
+			elemValues[i] = converted
+		}
+		tuple, diags := types.TupleValue(elemTypes, elemValues)
+		if diags.HasError() {
+			return nil, fmt.Errorf("building tuple: %v", diags)
+		}
+		return tuple, nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %T", value)
+	}
+}
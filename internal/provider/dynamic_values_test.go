@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDynamicToMap(t *testing.T) {
+	value := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"name":    types.StringType,
+			"count":   types.NumberType,
+			"ratio":   types.NumberType,
+			"enabled": types.BoolType,
+			"zones":   types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+			"tags":    types.MapType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"name":    types.StringValue("app"),
+			"count":   types.NumberValue(big.NewFloat(3)),
+			"ratio":   types.NumberValue(big.NewFloat(0.5)),
+			"enabled": types.BoolValue(true),
+			"zones":   types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			"tags":    types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("platform")}),
+		},
+	))
+
+	m, err := dynamicToMap(value)
+	if err != nil {
+		t.Fatalf("dynamicToMap failed: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"name":    "app",
+		"count":   int64(3),
+		"ratio":   0.5,
+		"enabled": true,
+		"zones":   []interface{}{"a", "b"},
+		"tags":    map[string]interface{}{"team": "platform"},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("Expected %v, got %v", expected, m)
+	}
+}
+
+func TestDynamicToMap_Null(t *testing.T) {
+	m, err := dynamicToMap(types.DynamicNull())
+	if err != nil {
+		t.Fatalf("dynamicToMap failed: %v", err)
+	}
+	if m != nil {
+		t.Errorf("Expected a nil map, got %v", m)
+	}
+}
+
+func TestDynamicToMap_NotAnObject(t *testing.T) {
+	if _, err := dynamicToMap(types.DynamicValue(types.StringValue("value"))); err == nil {
+		t.Error("Expected an error for a string value")
+	}
+}
+
+func TestMapToDynamic_RoundTrip(t *testing.T) {
+	// As decoded from a JSON response
+	m := map[string]interface{}{
+		"name":    "app",
+		"count":   float64(3),
+		"enabled": false,
+		"zones":   []interface{}{"a", "b"},
+		"nested":  map[string]interface{}{"key": "value"},
+		"empty":   nil,
+	}
+
+	value, err := mapToDynamic(m)
+	if err != nil {
+		t.Fatalf("mapToDynamic failed: %v", err)
+	}
+	if _, ok := value.UnderlyingValue().(types.Object); !ok {
+		t.Fatalf("Expected an object, got %T", value.UnderlyingValue())
+	}
+
+	if !sameDynamicContent(value, m) {
+		t.Errorf("Expected %s to hold %v", value, m)
+	}
+}
+
+func TestRefreshDynamic(t *testing.T) {
+	// A map configured with tomap() comes back from the API as a JSON object
+	current := types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{"region": types.StringValue("us-east-1")}))
+
+	unchanged, err := refreshDynamic(current, map[string]interface{}{"region": "us-east-1"})
+	if err != nil {
+		t.Fatalf("refreshDynamic failed: %v", err)
+	}
+	if !unchanged.Equal(current) {
+		t.Errorf("Expected the current value to be kept, got %s", unchanged)
+	}
+
+	changed, err := refreshDynamic(current, map[string]interface{}{"region": "eu-west-1"})
+	if err != nil {
+		t.Fatalf("refreshDynamic failed: %v", err)
+	}
+	if sameDynamicContent(changed, map[string]interface{}{"region": "us-east-1"}) {
+		t.Errorf("Expected the value from the API, got %s", changed)
+	}
+}
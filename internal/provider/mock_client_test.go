@@ -0,0 +1,990 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Hand-written mocks of the client.API services. Each method calls the function field
+// of the same name, and fails with an "unexpected call" error when it isn't set.
+
+// unexpectedCall is the error returned by a mock method without an implementation
+func unexpectedCall(method string) error {
+	return fmt.Errorf("unexpected call to %s", method)
+}
+
+// errorSeq returns an iterator yielding only err
+func errorSeq[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
+
+// sliceSeq returns an iterator over items
+func sliceSeq[T any](items ...T) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// mockAPI implements client.API with the mock services
+type mockAPI struct {
+	workspaces         mockWorkspaces
+	guardrails         mockGuardrails
+	projects           mockProjects
+	runnersWorkspaces  mockRunnersWorkspaces
+	variableSets       mockVariableSets
+	governancePolicies mockGovernancePolicies
+	backupAndDr        mockBackupAndDr
+	users              mockUsers
+	notifications      mockNotifications
+	integrations       mockIntegrations
+}
+
+var _ client.API = (*mockAPI)(nil)
+
+func (m *mockAPI) Workspaces() client.WorkspacesAPI                 { return &m.workspaces }
+func (m *mockAPI) Guardrails() client.GuardrailsAPI                 { return &m.guardrails }
+func (m *mockAPI) Projects() client.ProjectsAPI                     { return &m.projects }
+func (m *mockAPI) RunnersWorkspaces() client.RunnersWorkspacesAPI   { return &m.runnersWorkspaces }
+func (m *mockAPI) VariableSets() client.VariableSetsAPI             { return &m.variableSets }
+func (m *mockAPI) GovernancePolicies() client.GovernancePoliciesAPI { return &m.governancePolicies }
+func (m *mockAPI) BackupAndDr() client.BackupAndDrAPI               { return &m.backupAndDr }
+func (m *mockAPI) Users() client.UsersAPI                           { return &m.users }
+func (m *mockAPI) Notifications() client.NotificationsAPI           { return &m.notifications }
+func (m *mockAPI) Integrations() client.IntegrationsAPI             { return &m.integrations }
+
+type mockWorkspaces struct {
+	listWorkspaces        func(ctx context.Context, request *client.ListWorkspacesRequest, page, pageSize int) ([]client.Workspace, error)
+	allWorkspaces         func(ctx context.Context, request *client.ListWorkspacesRequest) iter.Seq2[client.Workspace, error]
+	deleteWorkspace       func(ctx context.Context, workspaceID string) (*client.DeleteWorkspaceResponse, error)
+	updateWorkspaceLabels func(ctx context.Context, workspaceID string, labels []string) (*client.UpdateWorkspaceLabelsResponse, error)
+	listWorkspaceRuns     func(ctx context.Context, workspaceID string, request *client.ListWorkspaceRunsRequest, page, pageSize int) ([]client.WorkspaceRun, error)
+	allWorkspaceRuns      func(ctx context.Context, workspaceID string, request *client.ListWorkspaceRunsRequest) iter.Seq2[client.WorkspaceRun, error]
+}
+
+func (m *mockWorkspaces) ListWorkspaces(ctx context.Context, request *client.ListWorkspacesRequest, page, pageSize int) ([]client.Workspace, error) {
+	if m.listWorkspaces == nil {
+		return nil, unexpectedCall("ListWorkspaces")
+	}
+	return m.listWorkspaces(ctx, request, page, pageSize)
+}
+
+func (m *mockWorkspaces) AllWorkspaces(ctx context.Context, request *client.ListWorkspacesRequest) iter.Seq2[client.Workspace, error] {
+	if m.allWorkspaces == nil {
+		return errorSeq[client.Workspace](unexpectedCall("AllWorkspaces"))
+	}
+	return m.allWorkspaces(ctx, request)
+}
+
+func (m *mockWorkspaces) DeleteWorkspace(ctx context.Context, workspaceID string) (*client.DeleteWorkspaceResponse, error) {
+	if m.deleteWorkspace == nil {
+		return nil, unexpectedCall("DeleteWorkspace")
+	}
+	return m.deleteWorkspace(ctx, workspaceID)
+}
+
+func (m *mockWorkspaces) UpdateWorkspaceLabels(ctx context.Context, workspaceID string, labels []string) (*client.UpdateWorkspaceLabelsResponse, error) {
+	if m.updateWorkspaceLabels == nil {
+		return nil, unexpectedCall("UpdateWorkspaceLabels")
+	}
+	return m.updateWorkspaceLabels(ctx, workspaceID, labels)
+}
+
+func (m *mockWorkspaces) ListWorkspaceRuns(ctx context.Context, workspaceID string, request *client.ListWorkspaceRunsRequest, page, pageSize int) ([]client.WorkspaceRun, error) {
+	if m.listWorkspaceRuns == nil {
+		return nil, unexpectedCall("ListWorkspaceRuns")
+	}
+	return m.listWorkspaceRuns(ctx, workspaceID, request, page, pageSize)
+}
+
+func (m *mockWorkspaces) AllWorkspaceRuns(ctx context.Context, workspaceID string, request *client.ListWorkspaceRunsRequest) iter.Seq2[client.WorkspaceRun, error] {
+	if m.allWorkspaceRuns == nil {
+		return errorSeq[client.WorkspaceRun](unexpectedCall("AllWorkspaceRuns"))
+	}
+	return m.allWorkspaceRuns(ctx, workspaceID, request)
+}
+
+type mockGuardrails struct {
+	listGuardrails  func(ctx context.Context, request *client.ListGuardrailsRequest, page, pageSize int) ([]client.GuardrailRule, error)
+	allGuardrails   func(ctx context.Context, request *client.ListGuardrailsRequest) iter.Seq2[client.GuardrailRule, error]
+	createGuardrail func(ctx context.Context, guardrail *client.GuardrailRule) (*client.CreateGuardrailResponse, error)
+	getGuardrail    func(ctx context.Context, ruleID string) (*client.GuardrailRule, error)
+	updateGuardrail func(ctx context.Context, ruleID string, guardrail *client.GuardrailRule) (*client.UpdateGuardrailResponse, error)
+	deleteGuardrail func(ctx context.Context, ruleID string) (*client.DeleteGuardrailResponse, error)
+}
+
+func (m *mockGuardrails) ListGuardrails(ctx context.Context, request *client.ListGuardrailsRequest, page, pageSize int) ([]client.GuardrailRule, error) {
+	if m.listGuardrails == nil {
+		return nil, unexpectedCall("ListGuardrails")
+	}
+	return m.listGuardrails(ctx, request, page, pageSize)
+}
+
+func (m *mockGuardrails) AllGuardrails(ctx context.Context, request *client.ListGuardrailsRequest) iter.Seq2[client.GuardrailRule, error] {
+	if m.allGuardrails == nil {
+		return errorSeq[client.GuardrailRule](unexpectedCall("AllGuardrails"))
+	}
+	return m.allGuardrails(ctx, request)
+}
+
+func (m *mockGuardrails) CreateGuardrail(ctx context.Context, guardrail *client.GuardrailRule) (*client.CreateGuardrailResponse, error) {
+	if m.createGuardrail == nil {
+		return nil, unexpectedCall("CreateGuardrail")
+	}
+	return m.createGuardrail(ctx, guardrail)
+}
+
+func (m *mockGuardrails) GetGuardrail(ctx context.Context, ruleID string) (*client.GuardrailRule, error) {
+	if m.getGuardrail == nil {
+		return nil, unexpectedCall("GetGuardrail")
+	}
+	return m.getGuardrail(ctx, ruleID)
+}
+
+func (m *mockGuardrails) UpdateGuardrail(ctx context.Context, ruleID string, guardrail *client.GuardrailRule) (*client.UpdateGuardrailResponse, error) {
+	if m.updateGuardrail == nil {
+		return nil, unexpectedCall("UpdateGuardrail")
+	}
+	return m.updateGuardrail(ctx, ruleID, guardrail)
+}
+
+func (m *mockGuardrails) DeleteGuardrail(ctx context.Context, ruleID string) (*client.DeleteGuardrailResponse, error) {
+	if m.deleteGuardrail == nil {
+		return nil, unexpectedCall("DeleteGuardrail")
+	}
+	return m.deleteGuardrail(ctx, ruleID)
+}
+
+type mockProjects struct {
+	createProject        func(ctx context.Context, req client.CreateProjectRequest) (*client.Project, error)
+	getProject           func(ctx context.Context, id string) (*client.Project, error)
+	updateProject        func(ctx context.Context, id string, req client.UpdateProjectRequest) (*client.Project, error)
+	deleteProject        func(ctx context.Context, id string) error
+	listProjectMembers   func(ctx context.Context, projectID string) ([]client.Member, error)
+	addProjectMembers    func(ctx context.Context, projectID string, members []client.Member) ([]client.Member, error)
+	removeProjectMembers func(ctx context.Context, projectID string, userIDs []string) error
+	getProjectMember     func(ctx context.Context, projectID, userID string) (*client.Member, error)
+	addProjectMember     func(ctx context.Context, projectID string, member client.Member) (*client.Member, error)
+	removeProjectMember  func(ctx context.Context, projectID, userID string) error
+	updateProjectMember  func(ctx context.Context, projectID string, member client.Member) (*client.Member, error)
+	listProjects         func(ctx context.Context, pageSize, offset int, searchQuery string) (*client.ProjectsListResponse, error)
+	allProjects          func(ctx context.Context, searchQuery string) iter.Seq2[client.Project, error]
+}
+
+func (m *mockProjects) CreateProject(ctx context.Context, req client.CreateProjectRequest) (*client.Project, error) {
+	if m.createProject == nil {
+		return nil, unexpectedCall("CreateProject")
+	}
+	return m.createProject(ctx, req)
+}
+
+func (m *mockProjects) GetProject(ctx context.Context, id string) (*client.Project, error) {
+	if m.getProject == nil {
+		return nil, unexpectedCall("GetProject")
+	}
+	return m.getProject(ctx, id)
+}
+
+func (m *mockProjects) UpdateProject(ctx context.Context, id string, req client.UpdateProjectRequest) (*client.Project, error) {
+	if m.updateProject == nil {
+		return nil, unexpectedCall("UpdateProject")
+	}
+	return m.updateProject(ctx, id, req)
+}
+
+func (m *mockProjects) DeleteProject(ctx context.Context, id string) error {
+	if m.deleteProject == nil {
+		return unexpectedCall("DeleteProject")
+	}
+	return m.deleteProject(ctx, id)
+}
+
+func (m *mockProjects) ListProjectMembers(ctx context.Context, projectID string) ([]client.Member, error) {
+	if m.listProjectMembers == nil {
+		return nil, unexpectedCall("ListProjectMembers")
+	}
+	return m.listProjectMembers(ctx, projectID)
+}
+
+func (m *mockProjects) AddProjectMembers(ctx context.Context, projectID string, members []client.Member) ([]client.Member, error) {
+	if m.addProjectMembers == nil {
+		return nil, unexpectedCall("AddProjectMembers")
+	}
+	return m.addProjectMembers(ctx, projectID, members)
+}
+
+func (m *mockProjects) RemoveProjectMembers(ctx context.Context, projectID string, userIDs []string) error {
+	if m.removeProjectMembers == nil {
+		return unexpectedCall("RemoveProjectMembers")
+	}
+	return m.removeProjectMembers(ctx, projectID, userIDs)
+}
+
+func (m *mockProjects) GetProjectMember(ctx context.Context, projectID, userID string) (*client.Member, error) {
+	if m.getProjectMember == nil {
+		return nil, unexpectedCall("GetProjectMember")
+	}
+	return m.getProjectMember(ctx, projectID, userID)
+}
+
+func (m *mockProjects) AddProjectMember(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+	if m.addProjectMember == nil {
+		return nil, unexpectedCall("AddProjectMember")
+	}
+	return m.addProjectMember(ctx, projectID, member)
+}
+
+func (m *mockProjects) RemoveProjectMember(ctx context.Context, projectID, userID string) error {
+	if m.removeProjectMember == nil {
+		return unexpectedCall("RemoveProjectMember")
+	}
+	return m.removeProjectMember(ctx, projectID, userID)
+}
+
+func (m *mockProjects) UpdateProjectMember(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+	if m.updateProjectMember == nil {
+		return nil, unexpectedCall("UpdateProjectMember")
+	}
+	return m.updateProjectMember(ctx, projectID, member)
+}
+
+func (m *mockProjects) ListProjects(ctx context.Context, pageSize, offset int, searchQuery string) (*client.ProjectsListResponse, error) {
+	if m.listProjects == nil {
+		return nil, unexpectedCall("ListProjects")
+	}
+	return m.listProjects(ctx, pageSize, offset, searchQuery)
+}
+
+func (m *mockProjects) AllProjects(ctx context.Context, searchQuery string) iter.Seq2[client.Project, error] {
+	if m.allProjects == nil {
+		return errorSeq[client.Project](unexpectedCall("AllProjects"))
+	}
+	return m.allProjects(ctx, searchQuery)
+}
+
+type mockRunnersWorkspaces struct {
+	createRunnersWorkspace    func(ctx context.Context, req client.CreateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error)
+	getRunnersWorkspace       func(ctx context.Context, id string) (*client.RunnersWorkspace, error)
+	updateRunnersWorkspace    func(ctx context.Context, id string, req client.UpdateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error)
+	deleteRunnersWorkspace    func(ctx context.Context, id string) error
+	destroyWorkspaceResources func(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error)
+	getTask                   func(ctx context.Context, workspaceID, taskID string) (*client.TaskResponse, error)
+	listTasks                 func(ctx context.Context, workspaceID string) ([]client.TaskResponse, error)
+	runWorkspaceTask          func(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error)
+	getWorkspaceRun           func(ctx context.Context, workspaceID, runID string) (*client.WorkspaceRun, error)
+}
+
+func (m *mockRunnersWorkspaces) CreateRunnersWorkspace(ctx context.Context, req client.CreateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error) {
+	if m.createRunnersWorkspace == nil {
+		return nil, unexpectedCall("CreateRunnersWorkspace")
+	}
+	return m.createRunnersWorkspace(ctx, req)
+}
+
+func (m *mockRunnersWorkspaces) GetRunnersWorkspace(ctx context.Context, id string) (*client.RunnersWorkspace, error) {
+	if m.getRunnersWorkspace == nil {
+		return nil, unexpectedCall("GetRunnersWorkspace")
+	}
+	return m.getRunnersWorkspace(ctx, id)
+}
+
+func (m *mockRunnersWorkspaces) UpdateRunnersWorkspace(ctx context.Context, id string, req client.UpdateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error) {
+	if m.updateRunnersWorkspace == nil {
+		return nil, unexpectedCall("UpdateRunnersWorkspace")
+	}
+	return m.updateRunnersWorkspace(ctx, id, req)
+}
+
+func (m *mockRunnersWorkspaces) DeleteRunnersWorkspace(ctx context.Context, id string) error {
+	if m.deleteRunnersWorkspace == nil {
+		return unexpectedCall("DeleteRunnersWorkspace")
+	}
+	return m.deleteRunnersWorkspace(ctx, id)
+}
+
+func (m *mockRunnersWorkspaces) DestroyWorkspaceResources(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error) {
+	if m.destroyWorkspaceResources == nil {
+		return nil, unexpectedCall("DestroyWorkspaceResources")
+	}
+	return m.destroyWorkspaceResources(ctx, id, req)
+}
+
+func (m *mockRunnersWorkspaces) GetTask(ctx context.Context, workspaceID, taskID string) (*client.TaskResponse, error) {
+	if m.getTask == nil {
+		return nil, unexpectedCall("GetTask")
+	}
+	return m.getTask(ctx, workspaceID, taskID)
+}
+
+func (m *mockRunnersWorkspaces) ListTasks(ctx context.Context, workspaceID string) ([]client.TaskResponse, error) {
+	if m.listTasks == nil {
+		return nil, unexpectedCall("ListTasks")
+	}
+	return m.listTasks(ctx, workspaceID)
+}
+
+func (m *mockRunnersWorkspaces) RunWorkspaceTask(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error) {
+	if m.runWorkspaceTask == nil {
+		return nil, unexpectedCall("RunWorkspaceTask")
+	}
+	return m.runWorkspaceTask(ctx, id, req)
+}
+
+func (m *mockRunnersWorkspaces) GetWorkspaceRun(ctx context.Context, workspaceID, runID string) (*client.WorkspaceRun, error) {
+	if m.getWorkspaceRun == nil {
+		return nil, unexpectedCall("GetWorkspaceRun")
+	}
+	return m.getWorkspaceRun(ctx, workspaceID, runID)
+}
+
+type mockVariableSets struct {
+	createVariableSet      func(ctx context.Context, req client.CreateVariableSetRequest) (*client.CreateVariableSetResponse, error)
+	getVariableSet         func(ctx context.Context, id string) (*client.VariableSet, error)
+	updateVariableSet      func(ctx context.Context, id string, req client.UpdateVariableSetRequest) (*client.VariableSet, error)
+	deleteVariableSet      func(ctx context.Context, id string) error
+	upsertVariablesInSet   func(ctx context.Context, id string, req client.UpsertVariableSetVariablesRequest) ([]client.Variable, error)
+	deleteVariablesFromSet func(ctx context.Context, id string, req client.DeleteVariablesRequest) error
+	listVariableSets       func(ctx context.Context, pageSize, offset int, searchQuery string) ([]client.VariableSet, error)
+	allVariableSets        func(ctx context.Context, searchQuery string) iter.Seq2[client.VariableSet, error]
+}
+
+func (m *mockVariableSets) CreateVariableSet(ctx context.Context, req client.CreateVariableSetRequest) (*client.CreateVariableSetResponse, error) {
+	if m.createVariableSet == nil {
+		return nil, unexpectedCall("CreateVariableSet")
+	}
+	return m.createVariableSet(ctx, req)
+}
+
+func (m *mockVariableSets) GetVariableSet(ctx context.Context, id string) (*client.VariableSet, error) {
+	if m.getVariableSet == nil {
+		return nil, unexpectedCall("GetVariableSet")
+	}
+	return m.getVariableSet(ctx, id)
+}
+
+func (m *mockVariableSets) UpdateVariableSet(ctx context.Context, id string, req client.UpdateVariableSetRequest) (*client.VariableSet, error) {
+	if m.updateVariableSet == nil {
+		return nil, unexpectedCall("UpdateVariableSet")
+	}
+	return m.updateVariableSet(ctx, id, req)
+}
+
+func (m *mockVariableSets) DeleteVariableSet(ctx context.Context, id string) error {
+	if m.deleteVariableSet == nil {
+		return unexpectedCall("DeleteVariableSet")
+	}
+	return m.deleteVariableSet(ctx, id)
+}
+
+func (m *mockVariableSets) UpsertVariablesInSet(ctx context.Context, id string, req client.UpsertVariableSetVariablesRequest) ([]client.Variable, error) {
+	if m.upsertVariablesInSet == nil {
+		return nil, unexpectedCall("UpsertVariablesInSet")
+	}
+	return m.upsertVariablesInSet(ctx, id, req)
+}
+
+func (m *mockVariableSets) DeleteVariablesFromSet(ctx context.Context, id string, req client.DeleteVariablesRequest) error {
+	if m.deleteVariablesFromSet == nil {
+		return unexpectedCall("DeleteVariablesFromSet")
+	}
+	return m.deleteVariablesFromSet(ctx, id, req)
+}
+
+func (m *mockVariableSets) ListVariableSets(ctx context.Context, pageSize, offset int, searchQuery string) ([]client.VariableSet, error) {
+	if m.listVariableSets == nil {
+		return nil, unexpectedCall("ListVariableSets")
+	}
+	return m.listVariableSets(ctx, pageSize, offset, searchQuery)
+}
+
+func (m *mockVariableSets) AllVariableSets(ctx context.Context, searchQuery string) iter.Seq2[client.VariableSet, error] {
+	if m.allVariableSets == nil {
+		return errorSeq[client.VariableSet](unexpectedCall("AllVariableSets"))
+	}
+	return m.allVariableSets(ctx, searchQuery)
+}
+
+type mockGovernancePolicies struct {
+	list   func(ctx context.Context, request *client.GovernancePolicyListRequest) (*client.GovernancePoliciesResponse, error)
+	all    func(ctx context.Context, request *client.GovernancePolicyListRequest) iter.Seq2[client.GovernancePolicy, error]
+	get    func(ctx context.Context, id string) (*client.GovernancePolicy, error)
+	create func(ctx context.Context, policy *client.GovernancePolicy) (*client.GovernancePolicy, error)
+	update func(ctx context.Context, id string, policy *client.GovernancePolicy) (*client.GovernancePolicy, error)
+	delete func(ctx context.Context, id string) error
+
+	createExclusion func(ctx context.Context, policyID string, exclusion *client.GovernancePolicyExclusionRequest) (*client.GovernancePolicyExclusion, error)
+	getExclusion    func(ctx context.Context, policyID, exclusionID string) (*client.GovernancePolicyExclusion, error)
+	updateExclusion func(ctx context.Context, policyID, exclusionID string, exclusion *client.GovernancePolicyExclusionRequest) (*client.GovernancePolicyExclusion, error)
+	deleteExclusion func(ctx context.Context, policyID, exclusionID string) error
+}
+
+func (m *mockGovernancePolicies) List(ctx context.Context, request *client.GovernancePolicyListRequest) (*client.GovernancePoliciesResponse, error) {
+	if m.list == nil {
+		return nil, unexpectedCall("GovernancePolicies.List")
+	}
+	return m.list(ctx, request)
+}
+
+func (m *mockGovernancePolicies) All(ctx context.Context, request *client.GovernancePolicyListRequest) iter.Seq2[client.GovernancePolicy, error] {
+	if m.all == nil {
+		return errorSeq[client.GovernancePolicy](unexpectedCall("GovernancePolicies.All"))
+	}
+	return m.all(ctx, request)
+}
+
+func (m *mockGovernancePolicies) Get(ctx context.Context, id string) (*client.GovernancePolicy, error) {
+	if m.get == nil {
+		return nil, unexpectedCall("GovernancePolicies.Get")
+	}
+	return m.get(ctx, id)
+}
+
+func (m *mockGovernancePolicies) Create(ctx context.Context, policy *client.GovernancePolicy) (*client.GovernancePolicy, error) {
+	if m.create == nil {
+		return nil, unexpectedCall("GovernancePolicies.Create")
+	}
+	return m.create(ctx, policy)
+}
+
+func (m *mockGovernancePolicies) Update(ctx context.Context, id string, policy *client.GovernancePolicy) (*client.GovernancePolicy, error) {
+	if m.update == nil {
+		return nil, unexpectedCall("GovernancePolicies.Update")
+	}
+	return m.update(ctx, id, policy)
+}
+
+func (m *mockGovernancePolicies) Delete(ctx context.Context, id string) error {
+	if m.delete == nil {
+		return unexpectedCall("GovernancePolicies.Delete")
+	}
+	return m.delete(ctx, id)
+}
+
+func (m *mockGovernancePolicies) CreateExclusion(ctx context.Context, policyID string, exclusion *client.GovernancePolicyExclusionRequest) (*client.GovernancePolicyExclusion, error) {
+	if m.createExclusion == nil {
+		return nil, unexpectedCall("GovernancePolicies.CreateExclusion")
+	}
+	return m.createExclusion(ctx, policyID, exclusion)
+}
+
+func (m *mockGovernancePolicies) GetExclusion(ctx context.Context, policyID, exclusionID string) (*client.GovernancePolicyExclusion, error) {
+	if m.getExclusion == nil {
+		return nil, unexpectedCall("GovernancePolicies.GetExclusion")
+	}
+	return m.getExclusion(ctx, policyID, exclusionID)
+}
+
+func (m *mockGovernancePolicies) UpdateExclusion(ctx context.Context, policyID, exclusionID string, exclusion *client.GovernancePolicyExclusionRequest) (*client.GovernancePolicyExclusion, error) {
+	if m.updateExclusion == nil {
+		return nil, unexpectedCall("GovernancePolicies.UpdateExclusion")
+	}
+	return m.updateExclusion(ctx, policyID, exclusionID, exclusion)
+}
+
+func (m *mockGovernancePolicies) DeleteExclusion(ctx context.Context, policyID, exclusionID string) error {
+	if m.deleteExclusion == nil {
+		return unexpectedCall("GovernancePolicies.DeleteExclusion")
+	}
+	return m.deleteExclusion(ctx, policyID, exclusionID)
+}
+
+type mockBackupAndDr struct {
+	create func(ctx context.Context, policy *client.PolicyCreateRequest) (*client.PolicyResponse, error)
+	get    func(ctx context.Context, policyID string) (*client.PolicyResponse, error)
+	update func(ctx context.Context, policyID string, policy *client.PolicyUpdateRequest) (*client.PolicyResponse, error)
+	delete func(ctx context.Context, policyID string) error
+	list   func(ctx context.Context, filters *client.PolicyListFilters) (*client.PolicyListResponse, error)
+	all    func(ctx context.Context, filters *client.PolicyListFilters) iter.Seq2[client.PolicyResponse, error]
+
+	listSnapshots func(ctx context.Context, policyID string, filters *client.SnapshotListFilters) (*client.SnapshotListResponse, error)
+	allSnapshots  func(ctx context.Context, policyID string, filters *client.SnapshotListFilters) iter.Seq2[client.Snapshot, error]
+	getSnapshot   func(ctx context.Context, policyID, snapshotID string) (*client.Snapshot, error)
+	diffSnapshots func(ctx context.Context, policyID, baseSnapshotID, targetSnapshotID string) (*client.SnapshotDiff, error)
+	triggerBackup func(ctx context.Context, policyID string) (*client.Snapshot, error)
+	startRestore  func(ctx context.Context, policyID string, restore *client.RestoreRequest) (*client.Restore, error)
+	getRestore    func(ctx context.Context, policyID, restoreID string) (*client.Restore, error)
+}
+
+func (m *mockBackupAndDr) Create(ctx context.Context, policy *client.PolicyCreateRequest) (*client.PolicyResponse, error) {
+	if m.create == nil {
+		return nil, unexpectedCall("BackupAndDr.Create")
+	}
+	return m.create(ctx, policy)
+}
+
+func (m *mockBackupAndDr) Get(ctx context.Context, policyID string) (*client.PolicyResponse, error) {
+	if m.get == nil {
+		return nil, unexpectedCall("BackupAndDr.Get")
+	}
+	return m.get(ctx, policyID)
+}
+
+func (m *mockBackupAndDr) Update(ctx context.Context, policyID string, policy *client.PolicyUpdateRequest) (*client.PolicyResponse, error) {
+	if m.update == nil {
+		return nil, unexpectedCall("BackupAndDr.Update")
+	}
+	return m.update(ctx, policyID, policy)
+}
+
+func (m *mockBackupAndDr) Delete(ctx context.Context, policyID string) error {
+	if m.delete == nil {
+		return unexpectedCall("BackupAndDr.Delete")
+	}
+	return m.delete(ctx, policyID)
+}
+
+func (m *mockBackupAndDr) List(ctx context.Context, filters *client.PolicyListFilters) (*client.PolicyListResponse, error) {
+	if m.list == nil {
+		return nil, unexpectedCall("BackupAndDr.List")
+	}
+	return m.list(ctx, filters)
+}
+
+func (m *mockBackupAndDr) All(ctx context.Context, filters *client.PolicyListFilters) iter.Seq2[client.PolicyResponse, error] {
+	if m.all == nil {
+		return errorSeq[client.PolicyResponse](unexpectedCall("BackupAndDr.All"))
+	}
+	return m.all(ctx, filters)
+}
+
+func (m *mockBackupAndDr) ListSnapshots(ctx context.Context, policyID string, filters *client.SnapshotListFilters) (*client.SnapshotListResponse, error) {
+	if m.listSnapshots == nil {
+		return nil, unexpectedCall("BackupAndDr.ListSnapshots")
+	}
+	return m.listSnapshots(ctx, policyID, filters)
+}
+
+func (m *mockBackupAndDr) AllSnapshots(ctx context.Context, policyID string, filters *client.SnapshotListFilters) iter.Seq2[client.Snapshot, error] {
+	if m.allSnapshots == nil {
+		return errorSeq[client.Snapshot](unexpectedCall("BackupAndDr.AllSnapshots"))
+	}
+	return m.allSnapshots(ctx, policyID, filters)
+}
+
+func (m *mockBackupAndDr) GetSnapshot(ctx context.Context, policyID, snapshotID string) (*client.Snapshot, error) {
+	if m.getSnapshot == nil {
+		return nil, unexpectedCall("BackupAndDr.GetSnapshot")
+	}
+	return m.getSnapshot(ctx, policyID, snapshotID)
+}
+
+func (m *mockBackupAndDr) DiffSnapshots(ctx context.Context, policyID, baseSnapshotID, targetSnapshotID string) (*client.SnapshotDiff, error) {
+	if m.diffSnapshots == nil {
+		return nil, unexpectedCall("BackupAndDr.DiffSnapshots")
+	}
+	return m.diffSnapshots(ctx, policyID, baseSnapshotID, targetSnapshotID)
+}
+
+func (m *mockBackupAndDr) TriggerBackup(ctx context.Context, policyID string) (*client.Snapshot, error) {
+	if m.triggerBackup == nil {
+		return nil, unexpectedCall("BackupAndDr.TriggerBackup")
+	}
+	return m.triggerBackup(ctx, policyID)
+}
+
+func (m *mockBackupAndDr) StartRestore(ctx context.Context, policyID string, restore *client.RestoreRequest) (*client.Restore, error) {
+	if m.startRestore == nil {
+		return nil, unexpectedCall("BackupAndDr.StartRestore")
+	}
+	return m.startRestore(ctx, policyID, restore)
+}
+
+func (m *mockBackupAndDr) GetRestore(ctx context.Context, policyID, restoreID string) (*client.Restore, error) {
+	if m.getRestore == nil {
+		return nil, unexpectedCall("BackupAndDr.GetRestore")
+	}
+	return m.getRestore(ctx, policyID, restoreID)
+}
+
+type mockUsers struct {
+	listUsers      func(ctx context.Context, request *client.ListUsersRequest, pageSize, offset int) (*client.UsersListResponse, error)
+	allUsers       func(ctx context.Context, request *client.ListUsersRequest) iter.Seq2[client.User, error]
+	getUser        func(ctx context.Context, id string) (*client.User, error)
+	getUserByEmail func(ctx context.Context, email string) (*client.User, error)
+}
+
+func (m *mockUsers) ListUsers(ctx context.Context, request *client.ListUsersRequest, pageSize, offset int) (*client.UsersListResponse, error) {
+	if m.listUsers == nil {
+		return nil, unexpectedCall("ListUsers")
+	}
+	return m.listUsers(ctx, request, pageSize, offset)
+}
+
+func (m *mockUsers) AllUsers(ctx context.Context, request *client.ListUsersRequest) iter.Seq2[client.User, error] {
+	if m.allUsers == nil {
+		return errorSeq[client.User](unexpectedCall("AllUsers"))
+	}
+	return m.allUsers(ctx, request)
+}
+
+func (m *mockUsers) GetUser(ctx context.Context, id string) (*client.User, error) {
+	if m.getUser == nil {
+		return nil, unexpectedCall("GetUser")
+	}
+	return m.getUser(ctx, id)
+}
+
+func (m *mockUsers) GetUserByEmail(ctx context.Context, email string) (*client.User, error) {
+	if m.getUserByEmail == nil {
+		return nil, unexpectedCall("GetUserByEmail")
+	}
+	return m.getUserByEmail(ctx, email)
+}
+
+type mockNotifications struct {
+	listNotifications  func(ctx context.Context, request *client.ListNotificationsRequest, pageSize, offset int) (*client.NotificationsListResponse, error)
+	allNotifications   func(ctx context.Context, request *client.ListNotificationsRequest) iter.Seq2[client.Notification, error]
+	createNotification func(ctx context.Context, req client.NotificationRequest) (*client.Notification, error)
+	getNotification    func(ctx context.Context, id string) (*client.Notification, error)
+	updateNotification func(ctx context.Context, id string, req client.NotificationRequest) (*client.Notification, error)
+	deleteNotification func(ctx context.Context, id string) error
+}
+
+func (m *mockNotifications) ListNotifications(ctx context.Context, request *client.ListNotificationsRequest, pageSize, offset int) (*client.NotificationsListResponse, error) {
+	if m.listNotifications == nil {
+		return nil, unexpectedCall("ListNotifications")
+	}
+	return m.listNotifications(ctx, request, pageSize, offset)
+}
+
+func (m *mockNotifications) AllNotifications(ctx context.Context, request *client.ListNotificationsRequest) iter.Seq2[client.Notification, error] {
+	if m.allNotifications == nil {
+		return errorSeq[client.Notification](unexpectedCall("AllNotifications"))
+	}
+	return m.allNotifications(ctx, request)
+}
+
+func (m *mockNotifications) CreateNotification(ctx context.Context, req client.NotificationRequest) (*client.Notification, error) {
+	if m.createNotification == nil {
+		return nil, unexpectedCall("CreateNotification")
+	}
+	return m.createNotification(ctx, req)
+}
+
+func (m *mockNotifications) GetNotification(ctx context.Context, id string) (*client.Notification, error) {
+	if m.getNotification == nil {
+		return nil, unexpectedCall("GetNotification")
+	}
+	return m.getNotification(ctx, id)
+}
+
+func (m *mockNotifications) UpdateNotification(ctx context.Context, id string, req client.NotificationRequest) (*client.Notification, error) {
+	if m.updateNotification == nil {
+		return nil, unexpectedCall("UpdateNotification")
+	}
+	return m.updateNotification(ctx, id, req)
+}
+
+func (m *mockNotifications) DeleteNotification(ctx context.Context, id string) error {
+	if m.deleteNotification == nil {
+		return unexpectedCall("DeleteNotification")
+	}
+	return m.deleteNotification(ctx, id)
+}
+
+type mockIntegrations struct {
+	listVcsIntegrations   func(ctx context.Context, request *client.ListIntegrationsRequest, pageSize, offset int) (*client.VcsIntegrationsListResponse, error)
+	allVcsIntegrations    func(ctx context.Context, request *client.ListIntegrationsRequest) iter.Seq2[client.VcsIntegration, error]
+	listCloudIntegrations func(ctx context.Context, request *client.ListIntegrationsRequest, pageSize, offset int) (*client.CloudIntegrationsListResponse, error)
+	allCloudIntegrations  func(ctx context.Context, request *client.ListIntegrationsRequest) iter.Seq2[client.CloudIntegration, error]
+}
+
+func (m *mockIntegrations) ListVcsIntegrations(ctx context.Context, request *client.ListIntegrationsRequest, pageSize, offset int) (*client.VcsIntegrationsListResponse, error) {
+	if m.listVcsIntegrations == nil {
+		return nil, unexpectedCall("ListVcsIntegrations")
+	}
+	return m.listVcsIntegrations(ctx, request, pageSize, offset)
+}
+
+func (m *mockIntegrations) AllVcsIntegrations(ctx context.Context, request *client.ListIntegrationsRequest) iter.Seq2[client.VcsIntegration, error] {
+	if m.allVcsIntegrations == nil {
+		return errorSeq[client.VcsIntegration](unexpectedCall("AllVcsIntegrations"))
+	}
+	return m.allVcsIntegrations(ctx, request)
+}
+
+func (m *mockIntegrations) ListCloudIntegrations(ctx context.Context, request *client.ListIntegrationsRequest, pageSize, offset int) (*client.CloudIntegrationsListResponse, error) {
+	if m.listCloudIntegrations == nil {
+		return nil, unexpectedCall("ListCloudIntegrations")
+	}
+	return m.listCloudIntegrations(ctx, request, pageSize, offset)
+}
+
+func (m *mockIntegrations) AllCloudIntegrations(ctx context.Context, request *client.ListIntegrationsRequest) iter.Seq2[client.CloudIntegration, error] {
+	if m.allCloudIntegrations == nil {
+		return errorSeq[client.CloudIntegration](unexpectedCall("AllCloudIntegrations"))
+	}
+	return m.allCloudIntegrations(ctx, request)
+}
+
+// Helpers to drive the CRUD methods of a resource directly, without Terraform
+
+// configureResource passes api to the resource as the provider data
+func configureResource(t *testing.T, r resource.Resource, api client.API) {
+	t.Helper()
+
+	configurable, ok := r.(resource.ResourceWithConfigure)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithConfigure", r)
+	}
+
+	resp := &resource.ConfigureResponse{}
+	configurable.Configure(context.Background(), resource.ConfigureRequest{ProviderData: api}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure failed: %v", resp.Diagnostics)
+	}
+}
+
+// resourceSchema returns the schema of the resource
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema failed: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// nullState returns a state without resource, as seen by Create
+func nullState(ctx context.Context, s schema.Schema) tfsdk.State {
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+}
+
+// planFromModel returns a plan holding model, which must be a pointer to the resource model
+func planFromModel(t *testing.T, s schema.Schema, model interface{}) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Failed to build plan: %v", diags)
+	}
+	return plan
+}
+
+// stateFromModel returns a state holding model, which must be a pointer to the resource model
+func stateFromModel(t *testing.T, s schema.Schema, model interface{}) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	state := nullState(ctx, s)
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Failed to build state: %v", diags)
+	}
+	return state
+}
+
+// runCreate runs Create with a plan holding model and returns the response, whatever the diagnostics
+func runCreate(t *testing.T, r resource.Resource, model interface{}) *resource.CreateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	s := resourceSchema(t, r)
+
+	resp := &resource.CreateResponse{State: nullState(ctx, s)}
+	r.Create(ctx, resource.CreateRequest{Plan: planFromModel(t, s, model)}, resp)
+	return resp
+}
+
+// testCreate runs Create with a plan holding model and decodes the resulting state into result
+func testCreate(t *testing.T, r resource.Resource, model interface{}, result interface{}) {
+	t.Helper()
+
+	resp := runCreate(t, r, model)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create failed: %v", resp.Diagnostics)
+	}
+
+	if diags := resp.State.Get(context.Background(), result); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+}
+
+// runRead runs Read with a state holding model and returns the response, whatever the diagnostics
+func runRead(t *testing.T, r resource.Resource, model interface{}) *resource.ReadResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	s := resourceSchema(t, r)
+	state := stateFromModel(t, s, model)
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+// testRead runs Read with a state holding model. It decodes the refreshed state into result
+// and reports whether the resource is still in the state.
+func testRead(t *testing.T, r resource.Resource, model interface{}, result interface{}) bool {
+	t.Helper()
+
+	ctx := context.Background()
+	resp := runRead(t, r, model)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+
+	if resp.State.Raw.IsNull() {
+		return false
+	}
+	if diags := resp.State.Get(ctx, result); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	return true
+}
+
+// runUpdate runs Update with a plan holding model and a prior state holding prior, and returns
+// the response, whatever the diagnostics
+func runUpdate(t *testing.T, r resource.Resource, model interface{}, prior interface{}) *resource.UpdateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	s := resourceSchema(t, r)
+
+	resp := &resource.UpdateResponse{State: nullState(ctx, s)}
+	r.Update(ctx, resource.UpdateRequest{Plan: planFromModel(t, s, model), State: stateFromModel(t, s, prior)}, resp)
+	return resp
+}
+
+// runDelete runs Delete with a state holding model and returns the response, whatever the diagnostics
+func runDelete(t *testing.T, r resource.Resource, model interface{}) *resource.DeleteResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	s := resourceSchema(t, r)
+	state := stateFromModel(t, s, model)
+
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+	return resp
+}
+
+// runValidateConfig runs ValidateConfig with a configuration holding model and returns the response
+func runValidateConfig(t *testing.T, r resource.Resource, model interface{}) *resource.ValidateConfigResponse {
+	t.Helper()
+
+	validatable, ok := r.(resource.ResourceWithValidateConfig)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithValidateConfig", r)
+	}
+
+	s := resourceSchema(t, r)
+	config := tfsdk.Config{Schema: s, Raw: stateFromModel(t, s, model).Raw}
+
+	resp := &resource.ValidateConfigResponse{}
+	validatable.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, resp)
+	return resp
+}
+
+// runModifyPlan runs ModifyPlan for the creation of a resource planned as model and returns the response
+func runModifyPlan(t *testing.T, r resource.Resource, model interface{}) *resource.ModifyPlanResponse {
+	t.Helper()
+
+	modifiable, ok := r.(resource.ResourceWithModifyPlan)
+	if !ok {
+		t.Fatalf("%T does not implement resource.ResourceWithModifyPlan", r)
+	}
+
+	ctx := context.Background()
+	s := resourceSchema(t, r)
+	plan := planFromModel(t, s, model)
+	config := tfsdk.Config{Schema: s, Raw: plan.Raw}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	modifiable.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, Plan: plan, State: nullState(ctx, s)}, resp)
+	return resp
+}
+
+// runDataSourceRead configures the data source with api and runs Read with a configuration
+// holding model. It returns the response, whatever the diagnostics.
+func runDataSourceRead(t *testing.T, d datasource.DataSource, api client.API, model interface{}) *datasource.ReadResponse {
+	t.Helper()
+
+	ctx := context.Background()
+
+	if configurable, ok := d.(datasource.DataSourceWithConfigure); ok {
+		configureResp := &datasource.ConfigureResponse{}
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: api}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("Configure failed: %v", configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema failed: %v", schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+
+	config := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("Failed to build config: %v", diags)
+	}
+	config.Raw = state.Raw
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	return resp
+}
+
+// notFoundError returns the error the client returns for a 404 response
+func notFoundError() error {
+	return &client.APIError{Operation: "get", StatusCode: 404, Message: "not found"}
+}
+
+// testVariableType is the object type of the variables blocks
+var testVariableType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":         types.StringType,
+		"value":       types.StringType,
+		"sensitivity": types.StringType,
+		"destination": types.StringType,
+	},
+}
+
+// testVariables returns a variables list holding variables
+func testVariables(variables ...client.Variable) types.List {
+	values := make([]attr.Value, len(variables))
+	for i, v := range variables {
+		values[i] = types.ObjectValueMust(testVariableType.AttrTypes, map[string]attr.Value{
+			"key":         types.StringValue(v.Key),
+			"value":       types.StringValue(v.Value),
+			"sensitivity": types.StringValue(string(v.Sensitivity)),
+			"destination": types.StringValue(string(v.Destination)),
+		})
+	}
+	return types.ListValueMust(testVariableType, values)
+}
+
+// testStrings returns a list of strings
+func testStrings(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
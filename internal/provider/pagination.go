@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// limitAttribute returns the optional "limit" attribute shared by the list data sources
+func limitAttribute(items string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("Maximum number of %s to return. When not set, all matching %s are returned.", items, items),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// limitValue converts the "limit" attribute to the limit expected by client.Collect, where 0 means no limit
+func limitValue(limit types.Int64) int {
+	if limit.IsNull() || limit.IsUnknown() {
+		return 0
+	}
+	return int(limit.ValueInt64())
+}
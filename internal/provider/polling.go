@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+)
+
+// pollOptions controls how long-running operations such as runs and tasks are polled. The
+// timeout is set by each operation.
+var pollOptions = client.WaitOptions{
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     30 * time.Second,
+}
+
+// waitOptions returns the polling options of an operation limited to timeout
+func waitOptions(timeout time.Duration) client.WaitOptions {
+	opts := pollOptions
+	opts.Timeout = timeout
+	return opts
+}
+
+// describeWaitError explains why waiting for an operation failed, with its last known status
+func describeWaitError(err error, timeout time.Duration, lastStatus string) string {
+	if errors.Is(err, client.ErrWaitTimeout) {
+		if lastStatus == "" {
+			return fmt.Sprintf("timed out after %s", timeout)
+		}
+		return fmt.Sprintf("timed out after %s, last status was %s", timeout, lastStatus)
+	}
+	return err.Error()
+}
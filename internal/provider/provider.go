@@ -3,15 +3,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 // Ensure the implementation satisfies the provider.Provider interface
@@ -30,6 +36,24 @@ type FireflyProviderModel struct {
 	AccessKey types.String `tfsdk:"access_key"`
 	SecretKey types.String `tfsdk:"secret_key"`
 	APIURL    types.String `tfsdk:"api_url"`
+
+	Profile                   types.String `tfsdk:"profile"`
+	CredentialsFile           types.String `tfsdk:"credentials_file"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	HTTPLogging        types.Bool   `tfsdk:"http_logging"`
+
+	MaxRetries   types.Int64 `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64 `tfsdk:"retry_max_wait"`
 }
 
 // New creates a new provider instance
@@ -57,19 +81,106 @@ func (p *FireflyProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 		Description: "Interact with Firefly",
 		Attributes: map[string]schema.Attribute{
 			"access_key": schema.StringAttribute{
-				Description: "The access key for API operations. May also be provided via FIREFLY_ACCESS_KEY environment variable.",
-				Required:    true,
+				Description: "The access key for API operations. May also be provided via FIREFLY_ACCESS_KEY environment variable or the shared credentials file.",
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"secret_key": schema.StringAttribute{
-				Description: "The secret key for API operations. May also be provided via FIREFLY_SECRET_KEY environment variable.",
-				Required:    true,
+				Description: "The secret key for API operations. May also be provided via FIREFLY_SECRET_KEY environment variable or the shared credentials file.",
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"api_url": schema.StringAttribute{
 				Description: "The URL of the Firefly API. May also be provided via FIREFLY_API_URL environment variable.",
 				Optional:    true,
 			},
+			"profile": schema.StringAttribute{
+				Description: "The profile of the shared credentials file to read the keys from. May also be provided via FIREFLY_PROFILE environment variable. Defaults to `default`.",
+				Optional:    true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: "Path to the shared credentials file. May also be provided via FIREFLY_CREDENTIALS_FILE environment variable. Defaults to `~/.firefly/credentials`.",
+				Optional:    true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Description: "Skip logging in to Firefly when the provider is configured. Invalid credentials are then only reported by the first API call. Defaults to `false`.",
+				Optional:    true,
+			},
+			"http_proxy": schema.StringAttribute{
+				Description: "URL of the proxy to send API requests through, e.g. `http://proxy.internal:3128`. When not set, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM encoded bundle of certificate authorities to trust in addition to the system ones, e.g. for proxies doing TLS interception.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded bundle of certificate authorities to trust in addition to the system ones. Conflicts with `ca_cert_file`.",
+				Optional:    true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded client certificate used for mutual TLS.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_key_file"), path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate used for mutual TLS. Conflicts with `client_cert_file`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_key_file"), path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM encoded private key of the client certificate.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_cert_file"), path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate. Conflicts with `client_key_file`.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("client_cert_file"), path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disable the verification of the Firefly API TLS certificate. Only use this for testing, it exposes the credentials to man-in-the-middle attacks. Defaults to `false`.",
+				Optional:    true,
+			},
+			"http_logging": schema.BoolAttribute{
+				Description: fmt.Sprintf("Log every API request and response at TRACE level, with credentials, tokens and secret variable values redacted. Also enabled by setting the %s environment variable to the desired log level. Defaults to `false`.", client.EnvHTTPLogLevel),
+				Optional:    true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: fmt.Sprintf("Time limit in seconds for a single API request. Defaults to %d.", int64(client.DefaultRequestTimeout/time.Second)),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times a request is retried after a rate-limit (429) or server (5xx) response. Set to 0 to disable retries. Defaults to %d.", client.DefaultMaxRetries),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of seconds to wait between two retries. Defaults to %d.", int64(client.DefaultRetryMaxWait/time.Second)),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -77,7 +188,7 @@ func (p *FireflyProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 // Configure prepares the API client using the provider configuration
 func (p *FireflyProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring Firefly client")
-	
+
 	// Retrieve provider data from configuration
 	var config FireflyProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -85,43 +196,118 @@ func (p *FireflyProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	
-	// Default values for the API URL if unspecified
-	apiURL := "https://api.firefly.ai"
-	if !config.APIURL.IsNull() {
-		apiURL = config.APIURL.ValueString()
+
+	// Values coming from other resources are not known until apply
+	for attr, value := range map[string]types.String{
+		"access_key": config.AccessKey,
+		"secret_key": config.SecretKey,
+		"api_url":    config.APIURL,
+	} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Unknown Firefly Provider Setting",
+				fmt.Sprintf("The provider cannot create the Firefly API client as there is an unknown configuration value for %s. "+
+					"Either set the value statically in the configuration, or use an environment variable or the shared credentials file.", attr),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve credentials from the configuration, the environment and the shared credentials file
+	creds, err := resolveCredentials(&config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Firefly Credentials File",
+			fmt.Sprintf("Unable to load Firefly credentials: %s", err),
+		)
+		return
 	}
-	
+
 	// Check for required configuration
-	if config.AccessKey.IsNull() {
+	if creds.AccessKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("access_key"),
 			"Missing Firefly Access Key",
 			"The provider cannot create the Firefly API client without an access_key. "+
-				"Please provide a valid access_key or set the FIREFLY_ACCESS_KEY environment variable.",
+				"Please provide a valid access_key, set the FIREFLY_ACCESS_KEY environment variable, or add it to a profile of the shared credentials file.",
 		)
 	}
-	
-	if config.SecretKey.IsNull() {
+
+	if creds.SecretKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("secret_key"),
 			"Missing Firefly Secret Key",
 			"The provider cannot create the Firefly API client without a secret_key. "+
-				"Please provide a valid secret_key or set the FIREFLY_SECRET_KEY environment variable.",
+				"Please provide a valid secret_key, set the FIREFLY_SECRET_KEY environment variable, or add it to a profile of the shared credentials file.",
 		)
 	}
-	
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	
+
+	tflog.Info(ctx, "Resolved Firefly credentials", map[string]interface{}{
+		"access_key_source": creds.AccessKeySource,
+		"secret_key_source": creds.SecretKeySource,
+	})
+
+	// Default values for the API URL if unspecified
+	apiURL := "https://api.firefly.ai"
+	if creds.APIURL != "" {
+		apiURL = creds.APIURL
+	}
+
+	// Retry settings
+	maxRetries := client.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	retryMaxWait := client.DefaultRetryMaxWait
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+
+	// Network settings
+	transportConfig, diags := transportConfigFromModel(&config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if transportConfig.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"insecure_skip_verify is set, so the Firefly API certificate is not verified. "+
+				"Anyone able to intercept the traffic can read the Firefly credentials and the data sent by the provider. "+
+				"Prefer ca_cert_file or ca_cert_pem to trust a proxy doing TLS interception.",
+		)
+	}
+
+	httpClient, err := client.NewHTTPClient(*transportConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Firefly Transport Settings",
+			fmt.Sprintf("Unable to configure the HTTP client: %s", err),
+		)
+		return
+	}
+
 	// Create a new client
 	c, err := client.NewClient(client.Config{
-		AccessKey:  config.AccessKey.ValueString(),
-		SecretKey:  config.SecretKey.ValueString(),
-		APIURL:     apiURL,
+		HTTPClient:   httpClient,
+		AccessKey:    creds.AccessKey,
+		SecretKey:    creds.SecretKey,
+		APIURL:       apiURL,
+		MaxRetries:   maxRetries,
+		RetryMaxWait: retryMaxWait,
 	})
-	
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create Firefly API client",
@@ -129,11 +315,22 @@ func (p *FireflyProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 		return
 	}
-	
+
+	// Log in once so that invalid credentials are reported against the provider block
+	if !config.SkipCredentialsValidation.ValueBool() {
+		if err := c.Authenticate(ctx); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Firefly Credentials",
+				fmt.Sprintf("Unable to log in to Firefly at %s with the access key from %s: %s", apiURL, creds.AccessKeySource, err),
+			)
+			return
+		}
+	}
+
 	// Make the client available to resources and data sources
 	resp.DataSourceData = c
 	resp.ResourceData = c
-	
+
 	tflog.Info(ctx, "Configured Firefly client", map[string]any{"success": true})
 }
 
@@ -147,8 +344,18 @@ func (p *FireflyProvider) DataSources(_ context.Context) []func() datasource.Dat
 		NewProjectDataSource,
 		NewVariableSetsDataSource,
 		NewVariableSetDataSource,
+		NewGovernancePolicyDataSource,
 		NewGovernancePoliciesDataSource,
+		NewBackupAndDrApplicationDataSource,
 		NewBackupAndDrApplicationsDataSource,
+		NewBackupAndDrSnapshotsDataSource,
+		NewBackupAndDrSnapshotDiffDataSource,
+		NewWorkflowsTaskDataSource,
+		NewUsersDataSource,
+		NewUserDataSource,
+		NewNotificationsDataSource,
+		NewVcsIntegrationsDataSource,
+		NewCloudIntegrationsDataSource,
 	}
 }
 
@@ -159,9 +366,56 @@ func (p *FireflyProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewGuardrailResource,
 		NewProjectResource,
 		NewProjectMembershipResource,
+		NewProjectMembersResource,
 		NewRunnersWorkspaceResource,
+		NewWorkflowsRunResource,
 		NewVariableSetResource,
 		NewGovernancePolicyResource,
+		NewGovernancePolicyExclusionResource,
 		NewBackupAndDrApplicationResource,
+		NewBackupAndDrBackupResource,
+		NewBackupAndDrRestoreResource,
+		NewNotificationResource,
 	}
 }
+
+// transportConfigFromModel reads the network settings of the provider configuration, loading PEM files from disk
+func transportConfigFromModel(config *FireflyProviderModel) (*client.TransportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transportConfig := &client.TransportConfig{
+		ProxyURL:           config.HTTPProxy.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		LogHTTP:            config.HTTPLogging.ValueBool() || client.HTTPLoggingEnabledFromEnv(),
+	}
+
+	if !config.RequestTimeout.IsNull() {
+		transportConfig.Timeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	// readPEM returns the inline value or the content of the file
+	readPEM := func(fileAttr string, file types.String, inline types.String) []byte {
+		if !inline.IsNull() {
+			return []byte(inline.ValueString())
+		}
+		if file.IsNull() {
+			return nil
+		}
+		content, err := os.ReadFile(file.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(fileAttr),
+				"Unable to Read PEM File",
+				fmt.Sprintf("Could not read %s: %s", file.ValueString(), err),
+			)
+			return nil
+		}
+		return content
+	}
+
+	transportConfig.CACertPEM = readPEM("ca_cert_file", config.CACertFile, config.CACertPEM)
+	transportConfig.ClientCertPEM = readPEM("client_cert_file", config.ClientCertFile, config.ClientCertPEM)
+	transportConfig.ClientKeyPEM = readPEM("client_key_file", config.ClientKeyFile, config.ClientKeyPEM)
+
+	return transportConfig, diags
+}
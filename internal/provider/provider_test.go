@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/gofireflyio/terraform-provider-firefly/internal/fakefirefly"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -23,8 +30,143 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// testAccWorkspaceIDs are the existing workspaces referenced by the acceptance tests
+var testAccWorkspaceIDs = []string{"test-workspace-id", "single-workspace-id", "empty-workspace-id"}
+
+// testAccUsers are the users of the account referenced by the acceptance tests
+var testAccUsers = []client.User{
+	{ID: "user123", Email: "test-user@example.com", Name: "Test User", Role: "admin"},
+	{ID: "dev-user-id", Email: "dev@example.com", Name: "Dev User", Role: "member"},
+	{ID: "viewer-user-id", Email: "viewer@example.com", Name: "Viewer User", Role: "viewer"},
+}
+
+// testAccVcsIntegrations and testAccCloudIntegrations are the integrations connected to the account,
+// referenced by the acceptance tests
+var testAccVcsIntegrations = []client.VcsIntegration{
+	{ID: "test-vcs-integration-id", Name: "Test GitHub", Type: client.VcsTypeGithub},
+	{ID: "test-gitlab-integration-id", Name: "Test GitLab", Type: client.VcsTypeGitlab},
+}
+
+var testAccCloudIntegrations = []client.CloudIntegration{
+	{ID: "test-integration-id", Name: "Test AWS", Type: client.CloudTypeAWS, AccountID: "123456789012"},
+	{ID: "test-azure-integration-id", Name: "Test Azure", Type: client.CloudTypeAzure, AccountID: "00000000-0000-0000-0000-000000000000"},
+}
+
+// testAccBackupApplicationID is a backup application with the two snapshots testAccBaseSnapshotID and
+// testAccTargetSnapshotID, between which a bucket changed and a queue replaced a topic
+const (
+	testAccBackupApplicationID = "test-backup-application-id"
+	testAccBaseSnapshotID      = "test-base-snapshot-id"
+	testAccTargetSnapshotID    = "test-target-snapshot-id"
+)
+
+// testAccBuiltinGovernancePolicy is a Firefly built-in policy, which can't be created through the API
+var testAccBuiltinGovernancePolicy = client.GovernancePolicy{
+	ID:          "test-builtin-policy-id",
+	Name:        "S3 Bucket Without Encryption",
+	Code:        base64.StdEncoding.EncodeToString([]byte("firefly {\n  input.server_side_encryption_configuration == []\n}\n")),
+	Type:        []string{"aws_s3_bucket"},
+	ProviderIDs: []string{"aws_all"},
+	Severity:    5,
+	Category:    "Security",
+	Frameworks:  []string{"SOC2"},
+	IsDefault:   true,
+}
+
+// testAccPreCheck runs the acceptance tests against an in-memory fake of the Firefly API,
+// unless the credentials of a real account are set in the environment
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
-}
\ No newline at end of file
+	if os.Getenv(envAccessKey) != "" && os.Getenv(envSecretKey) != "" {
+		return
+	}
+
+	server := fakefirefly.NewServer()
+	t.Cleanup(server.Close)
+
+	for _, id := range testAccWorkspaceIDs {
+		server.AddWorkspace(client.Workspace{ID: id, WorkspaceName: id})
+	}
+	for _, user := range testAccUsers {
+		server.AddUser(user)
+	}
+	for _, integration := range testAccVcsIntegrations {
+		server.AddVcsIntegration(integration)
+	}
+	for _, integration := range testAccCloudIntegrations {
+		server.AddCloudIntegration(integration)
+	}
+	server.AddGovernancePolicy(testAccBuiltinGovernancePolicy)
+	seedTestAccBackupSnapshots(server)
+
+	t.Setenv(envAPIURL, server.URL())
+	t.Setenv(envAccessKey, fakefirefly.DefaultAccessKey)
+	t.Setenv(envSecretKey, fakefirefly.DefaultSecretKey)
+}
+
+// seedTestAccBackupSnapshots stores testAccBackupApplicationID and its snapshots, scheduled backups can't be
+// triggered by the acceptance tests
+func seedTestAccBackupSnapshots(server *fakefirefly.Server) {
+	server.AddBackupPolicy(client.PolicyResponse{
+		PolicyID:      testAccBackupApplicationID,
+		PolicyName:    "Test Backup Application",
+		IntegrationID: "test-integration-id",
+		Region:        "us-east-1",
+		ProviderType:  "aws",
+		Frequency:     24,
+	})
+	server.AddSnapshot(testAccBackupApplicationID, client.Snapshot{SnapshotID: testAccBaseSnapshotID}, []fakefirefly.SnapshotResource{
+		{ID: "test-bucket", Type: "aws_s3_bucket", Name: "test-bucket", Region: "us-east-1", Attributes: map[string]string{"versioning": "false"}},
+		{ID: "test-topic", Type: "aws_sns_topic", Name: "test-topic", Region: "us-east-1"},
+	})
+	server.AddSnapshot(testAccBackupApplicationID, client.Snapshot{SnapshotID: testAccTargetSnapshotID}, []fakefirefly.SnapshotResource{
+		{ID: "test-bucket", Type: "aws_s3_bucket", Name: "test-bucket", Region: "us-east-1", Attributes: map[string]string{"versioning": "true"}},
+		{ID: "test-queue", Type: "aws_sqs_queue", Name: "test-queue", Region: "us-east-1"},
+	})
+}
+
+func TestTransportConfigFromModel(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte("ca-from-file"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &FireflyProviderModel{
+		HTTPProxy:          types.StringValue("http://proxy.internal:3128"),
+		CACertFile:         types.StringValue(caFile),
+		ClientCertPEM:      types.StringValue("inline-cert"),
+		ClientKeyPEM:       types.StringValue("inline-key"),
+		InsecureSkipVerify: types.BoolNull(),
+		RequestTimeout:     types.Int64Value(30),
+	}
+
+	transportConfig, diags := transportConfigFromModel(config)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if transportConfig.ProxyURL != "http://proxy.internal:3128" {
+		t.Errorf("Expected proxy URL, got '%s'", transportConfig.ProxyURL)
+	}
+	if string(transportConfig.CACertPEM) != "ca-from-file" {
+		t.Errorf("Expected CA bundle read from file, got '%s'", transportConfig.CACertPEM)
+	}
+	if string(transportConfig.ClientCertPEM) != "inline-cert" || string(transportConfig.ClientKeyPEM) != "inline-key" {
+		t.Errorf("Expected inline client certificate and key")
+	}
+	if transportConfig.InsecureSkipVerify {
+		t.Error("Expected certificate verification to stay enabled")
+	}
+	if transportConfig.Timeout != 30*time.Second {
+		t.Errorf("Expected 30s timeout, got %v", transportConfig.Timeout)
+	}
+}
+
+func TestTransportConfigFromModel_MissingFile(t *testing.T) {
+	config := &FireflyProviderModel{
+		CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem")),
+	}
+
+	if _, diags := transportConfigFromModel(config); !diags.HasError() {
+		t.Error("Expected an error for a missing CA file")
+	}
+}
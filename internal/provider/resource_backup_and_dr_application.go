@@ -31,7 +31,7 @@ func NewBackupAndDrApplicationResource() resource.Resource {
 
 // BackupAndDrApplicationResource defines the resource implementation
 type BackupAndDrApplicationResource struct {
-	client *client.Client
+	client client.API
 }
 
 func (r *BackupAndDrApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -104,7 +104,7 @@ func (r *BackupAndDrApplicationResource) Schema(ctx context.Context, req resourc
 				},
 			},
 			"notification_id": schema.StringAttribute{
-				MarkdownDescription: "Notification channel ID for backup alerts",
+				MarkdownDescription: "Notification channel ID for backup alerts, e.g. the `id` of a `firefly_notification`",
 				Optional:            true,
 			},
 			"restore_instructions": schema.StringAttribute{
@@ -223,12 +223,12 @@ func (r *BackupAndDrApplicationResource) Configure(ctx context.Context, req reso
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -261,7 +261,7 @@ func (r *BackupAndDrApplicationResource) Create(ctx context.Context, req resourc
 	})
 
 	// Create the policy
-	createdPolicy, err := r.client.BackupAndDr.Create(request)
+	createdPolicy, err := r.client.BackupAndDr().Create(ctx, request)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating backup application",
@@ -306,12 +306,9 @@ func (r *BackupAndDrApplicationResource) Read(ctx context.Context, req resource.
 	})
 
 	// Get the policy
-	policy, err := r.client.BackupAndDr.Get(policyID)
+	policy, err := r.client.BackupAndDr().Get(ctx, policyID)
 	if err != nil {
-		errorMsg := err.Error()
-		if strings.Contains(errorMsg, "policy not found") ||
-			strings.Contains(errorMsg, "not found") ||
-			strings.Contains(errorMsg, "status 404") {
+		if client.IsNotFound(err) {
 			tflog.Info(ctx, "Backup application not found, removing from state", map[string]interface{}{
 				"account_id": data.AccountID.ValueString(),
 				"policy_id":  policyID,
@@ -371,7 +368,7 @@ func (r *BackupAndDrApplicationResource) Update(ctx context.Context, req resourc
 	updateRequest := client.ConvertCreateToUpdate(request)
 
 	// Update the policy
-	updatedPolicy, err := r.client.BackupAndDr.Update(policyID, updateRequest)
+	updatedPolicy, err := r.client.BackupAndDr().Update(ctx, policyID, updateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating backup application",
@@ -415,8 +412,8 @@ func (r *BackupAndDrApplicationResource) Delete(ctx context.Context, req resourc
 		"policy_id":  policyID,
 	})
 
-	err := r.client.BackupAndDr.Delete(policyID)
-	if err != nil {
+	err := r.client.BackupAndDr().Delete(ctx, policyID)
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting backup application",
 			fmt.Sprintf("Could not delete backup application: %s", err),
@@ -48,8 +48,8 @@ type VCSModel struct {
 	RepoID           types.String `tfsdk:"repo_id"`            // optional
 }
 
-// BackupAndDrApplicationDataSourceModel represents the data source model for a single application
-// Note: Simplified schema without nested blocks (scope, vcs) to comply with data source limitations
+// BackupAndDrApplicationDataSourceModel represents an application listed by the applications data source.
+// The scope and VCS settings are only read by the single application data source.
 type BackupAndDrApplicationDataSourceModel struct {
 	ApplicationID        types.String `tfsdk:"application_id"`
 	AccountID            types.String `tfsdk:"account_id"`
@@ -83,5 +83,19 @@ type BackupAndDrApplicationsDataSourceModel struct {
 	IntegrationID types.String                            `tfsdk:"integration_id"`
 	Region        types.String                            `tfsdk:"region"`
 	ProviderType  types.String                            `tfsdk:"provider_type"`
+	Limit         types.Int64                             `tfsdk:"limit"`
 	Applications  []BackupAndDrApplicationDataSourceModel `tfsdk:"applications"`
+	Facets        []BackupAndDrFacetModel                 `tfsdk:"facets"`
+}
+
+// BackupAndDrFacetModel represents the values of a field of the matching applications
+type BackupAndDrFacetModel struct {
+	Field  types.String                 `tfsdk:"field"`
+	Values []BackupAndDrFacetValueModel `tfsdk:"values"`
+}
+
+// BackupAndDrFacetValueModel represents a value of a facet with the number of applications having it
+type BackupAndDrFacetValueModel struct {
+	Value types.String `tfsdk:"value"`
+	Count types.Int64  `tfsdk:"count"`
 }
@@ -408,3 +408,89 @@ func TestMapAPIResponseToModel_NullOptionalFields(t *testing.T) {
 		t.Errorf("Expected VCS to be nil, got %v", model.VCS)
 	}
 }
+
+// testBackupAndDrApplicationModel returns the planned model of a basic application
+func testBackupAndDrApplicationModel() *BackupAndDrApplicationResourceModel {
+	return &BackupAndDrApplicationResourceModel{
+		ID:                   types.StringUnknown(),
+		AccountID:            types.StringValue("account-456"),
+		ApplicationName:      types.StringValue("Nightly"),
+		IntegrationID:        types.StringValue("int-789"),
+		Region:               types.StringValue("us-east-1"),
+		ProviderType:         types.StringValue("aws"),
+		Description:          types.StringNull(),
+		Frequency:            types.Int64Value(8),
+		NotificationID:       types.StringNull(),
+		RestoreInstructions:  types.StringNull(),
+		BackupOnSave:         types.BoolValue(true),
+		TargetAccount:        types.StringNull(),
+		TargetRegion:         types.StringNull(),
+		AutoCreatePR:         types.BoolNull(),
+		ResilienceEnabled:    types.BoolNull(),
+		Status:               types.StringUnknown(),
+		SnapshotsCount:       types.Int64Unknown(),
+		LastBackupSnapshotID: types.StringUnknown(),
+		LastBackupTime:       types.StringUnknown(),
+		LastBackupStatus:     types.StringUnknown(),
+		NextBackupTime:       types.StringUnknown(),
+		CreatedAt:            types.StringUnknown(),
+		UpdatedAt:            types.StringUnknown(),
+	}
+}
+
+func TestBackupAndDrApplicationResource_Create(t *testing.T) {
+	var created *client.PolicyCreateRequest
+	api := &mockAPI{}
+	api.backupAndDr.create = func(ctx context.Context, policy *client.PolicyCreateRequest) (*client.PolicyResponse, error) {
+		created = policy
+		return &client.PolicyResponse{
+			PolicyID:      "policy-123",
+			AccountID:     "account-456",
+			PolicyName:    policy.PolicyName,
+			IntegrationID: policy.IntegrationID,
+			Region:        policy.Region,
+			ProviderType:  policy.ProviderType,
+			Frequency:     policy.Frequency,
+			Status:        "Active",
+			CreatedAt:     "2025-01-01T00:00:00Z",
+		}, nil
+	}
+
+	r := NewBackupAndDrApplicationResource()
+	configureResource(t, r, api)
+
+	var state BackupAndDrApplicationResourceModel
+	testCreate(t, r, testBackupAndDrApplicationModel(), &state)
+
+	if created == nil {
+		t.Fatal("Expected Create to be called")
+	}
+	if created.PolicyName != "Nightly" || created.Frequency != 8 || !created.BackupOnSave {
+		t.Errorf("Unexpected request %+v", created)
+	}
+
+	if state.ID.ValueString() != "policy-123" || state.Status.ValueString() != "Active" {
+		t.Errorf("Unexpected ID %s or status %s", state.ID, state.Status)
+	}
+	if !state.Description.IsNull() {
+		t.Errorf("Expected a null description, got %s", state.Description)
+	}
+}
+
+func TestBackupAndDrApplicationResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.get = func(ctx context.Context, policyID string) (*client.PolicyResponse, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewBackupAndDrApplicationResource()
+	configureResource(t, r, api)
+
+	model := testBackupAndDrApplicationModel()
+	model.ID = types.StringValue("policy-123")
+
+	var state BackupAndDrApplicationResourceModel
+	if testRead(t, r, model, &state) {
+		t.Error("Expected the application to be removed from the state")
+	}
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BackupAndDrBackupResource{}
+
+// defaultBackupTimeoutMinutes is how long the resource waits for a backup to finish when timeout_minutes isn't set
+const defaultBackupTimeoutMinutes = 60
+
+// NewBackupAndDrBackupResource creates a new backup and DR on-demand backup resource
+func NewBackupAndDrBackupResource() resource.Resource {
+	return &BackupAndDrBackupResource{}
+}
+
+// BackupAndDrBackupResource defines the resource implementation
+type BackupAndDrBackupResource struct {
+	client client.API
+}
+
+// BackupAndDrBackupResourceModel describes the resource data model
+type BackupAndDrBackupResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	TimeoutMinutes types.Int64  `tfsdk:"timeout_minutes"`
+	SnapshotID     types.String `tfsdk:"snapshot_id"`
+	Status         types.String `tfsdk:"status"`
+	BackupTime     types.String `tfsdk:"backup_time"`
+}
+
+func (r *BackupAndDrBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_and_dr_backup"
+}
+
+func (r *BackupAndDrBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes an on-demand backup of a Firefly Backup & DR application and waits for it to finish. " +
+			"A new backup is taken whenever the application or the triggers change. Destroying the resource doesn't delete the snapshot.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot taken by the backup",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the backup application to back up",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that take a new backup when they change, e.g. the version about to be applied",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Time limit in minutes for the backup to finish. Defaults to %d", defaultBackupTimeoutMinutes),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultBackupTimeoutMinutes),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot taken by the backup",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Last known status of the backup: `running`, `completed` or `failed`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_time": schema.StringAttribute{
+				MarkdownDescription: "Timestamp the backup started",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupAndDrBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create triggers a backup and waits for its snapshot to finish
+func (r *BackupAndDrBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BackupAndDrBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+
+	tflog.Debug(ctx, "Triggering backup", map[string]interface{}{
+		"application_id": applicationID,
+	})
+
+	snapshot, err := r.client.BackupAndDr().TriggerBackup(ctx, applicationID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error triggering backup",
+			fmt.Sprintf("Could not trigger a backup of backup application %s: %s", applicationID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(snapshot.SnapshotID)
+	plan.SnapshotID = types.StringValue(snapshot.SnapshotID)
+	plan.Status = types.StringValue(snapshot.Status)
+	plan.BackupTime = types.StringValue(snapshot.CreatedAt)
+
+	timeout := time.Duration(plan.TimeoutMinutes.ValueInt64()) * time.Minute
+	poll := client.BackupPoller(r.client.BackupAndDr(), applicationID, snapshot.SnapshotID)
+	taken, err := client.WaitFor(ctx, poll, waitOptions(timeout))
+	if taken != nil {
+		updateBackupAndDrBackupModel(&plan, taken)
+	}
+
+	// The snapshot exists even when waiting failed, so it's saved in the state. An error taints
+	// the resource and the next apply takes a new backup.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for backup",
+			fmt.Sprintf("Backup %s of backup application %s didn't finish: %s", snapshot.SnapshotID, applicationID, describeWaitError(err, timeout, plan.Status.ValueString())),
+		)
+		return
+	}
+
+	if taken.Status == client.SnapshotStatusFailed {
+		resp.Diagnostics.AddError(
+			"Backup failed",
+			fmt.Sprintf("Backup %s of backup application %s failed.", snapshot.SnapshotID, applicationID),
+		)
+	}
+}
+
+// Read refreshes the status of the backup snapshot and removes the backup from the state when
+// its application or snapshot is deleted
+func (r *BackupAndDrBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BackupAndDrBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshot, err := r.client.BackupAndDr().GetSnapshot(ctx, state.ApplicationID.ValueString(), state.SnapshotID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Backup snapshot not found, removing backup from state", map[string]interface{}{
+				"application_id": state.ApplicationID.ValueString(),
+				"snapshot_id":    state.SnapshotID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading backup snapshot",
+			fmt.Sprintf("Could not read snapshot %s of backup application %s: %s", state.SnapshotID.ValueString(), state.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	updateBackupAndDrBackupModel(&state, snapshot)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the new timeout, every other change takes a new backup
+func (r *BackupAndDrBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BackupAndDrBackupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the backup from the Terraform state. Snapshots can't be deleted.
+func (r *BackupAndDrBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BackupAndDrBackupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing backup from state", map[string]interface{}{
+		"snapshot_id": state.SnapshotID.ValueString(),
+	})
+}
+
+// updateBackupAndDrBackupModel copies the snapshot taken by the backup to the model
+func updateBackupAndDrBackupModel(model *BackupAndDrBackupResourceModel, snapshot *client.Snapshot) {
+	model.Status = types.StringValue(snapshot.Status)
+	if snapshot.CreatedAt != "" {
+		model.BackupTime = types.StringValue(snapshot.CreatedAt)
+	}
+}
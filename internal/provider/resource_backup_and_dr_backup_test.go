@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupAndDrBackupResource(t *testing.T) {
+	fastPolling(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupAndDrBackupResourceConfig("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly_backup_and_dr_backup.test", "snapshot_id"),
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_backup.test", "status", "completed"),
+					// The new snapshot holds the same resources as the previous backup
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshot_diff.test", "has_changes", "false"),
+				),
+			},
+			// A new version takes a new backup
+			{
+				Config: testAccBackupAndDrBackupResourceConfig("v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_backup.test", "status", "completed"),
+					resource.TestCheckResourceAttr("data.firefly_backup_and_dr_snapshots.test", "snapshots.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBackupAndDrBackupResourceConfig(version string) string {
+	return fmt.Sprintf(`
+resource "firefly_backup_and_dr_backup" "test" {
+  application_id = %[1]q
+
+  triggers = {
+    version = %[2]q
+  }
+}
+
+data "firefly_backup_and_dr_snapshot_diff" "test" {
+  application_id     = %[1]q
+  base_snapshot_id   = %[3]q
+  target_snapshot_id = firefly_backup_and_dr_backup.test.snapshot_id
+}
+
+data "firefly_backup_and_dr_snapshots" "test" {
+  application_id = %[1]q
+
+  depends_on = [firefly_backup_and_dr_backup.test]
+}
+`, testAccBackupApplicationID, version, testAccTargetSnapshotID)
+}
+
+// testBackupAndDrBackupModel returns the model of a backup of application-1 to take
+func testBackupAndDrBackupModel() *BackupAndDrBackupResourceModel {
+	return &BackupAndDrBackupResourceModel{
+		ID:             types.StringUnknown(),
+		ApplicationID:  types.StringValue("application-1"),
+		Triggers:       types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue("v1")}),
+		TimeoutMinutes: types.Int64Value(5),
+		SnapshotID:     types.StringUnknown(),
+		Status:         types.StringUnknown(),
+		BackupTime:     types.StringUnknown(),
+	}
+}
+
+// mockBackupStatuses makes the mock take snapshot-2, with statuses, one per poll
+func mockBackupStatuses(api *mockAPI, statuses ...string) *int {
+	polls := 0
+	api.backupAndDr.triggerBackup = func(ctx context.Context, policyID string) (*client.Snapshot, error) {
+		return &client.Snapshot{SnapshotID: "snapshot-2", PolicyID: policyID, Status: client.SnapshotStatusRunning, CreatedAt: "2026-01-01T00:00:00Z"}, nil
+	}
+	api.backupAndDr.getSnapshot = func(ctx context.Context, policyID, snapshotID string) (*client.Snapshot, error) {
+		if snapshotID != "snapshot-2" {
+			return nil, notFoundError()
+		}
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+		return &client.Snapshot{SnapshotID: snapshotID, PolicyID: policyID, Status: status, CreatedAt: "2026-01-01T00:00:00Z"}, nil
+	}
+	return &polls
+}
+
+func TestBackupAndDrBackupResource_Create(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	polls := mockBackupStatuses(api, client.SnapshotStatusRunning, client.SnapshotStatusCompleted)
+
+	r := NewBackupAndDrBackupResource()
+	configureResource(t, r, api)
+
+	var state BackupAndDrBackupResourceModel
+	testCreate(t, r, testBackupAndDrBackupModel(), &state)
+
+	if *polls != 2 {
+		t.Errorf("Expected 2 polls, got %d", *polls)
+	}
+	if state.ID.ValueString() != "snapshot-2" || state.SnapshotID.ValueString() != "snapshot-2" {
+		t.Errorf("Unexpected id %s or snapshot_id %s", state.ID, state.SnapshotID)
+	}
+	if state.Status.ValueString() != "completed" || state.BackupTime.ValueString() != "2026-01-01T00:00:00Z" {
+		t.Errorf("Unexpected status %s or backup_time %s", state.Status, state.BackupTime)
+	}
+}
+
+func TestBackupAndDrBackupResource_CreateFailed(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	mockBackupStatuses(api, client.SnapshotStatusFailed)
+
+	r := NewBackupAndDrBackupResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testBackupAndDrBackupModel())
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a failed backup")
+	}
+
+	// The snapshot is kept in the state, so the tainted resource takes a new backup on the next apply
+	var state BackupAndDrBackupResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if state.SnapshotID.ValueString() != "snapshot-2" || state.Status.ValueString() != "failed" {
+		t.Errorf("Unexpected snapshot_id %s or status %s", state.SnapshotID, state.Status)
+	}
+}
+
+func TestBackupAndDrBackupResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.getSnapshot = func(ctx context.Context, policyID, snapshotID string) (*client.Snapshot, error) {
+		return &client.Snapshot{SnapshotID: snapshotID, PolicyID: policyID, Status: client.SnapshotStatusCompleted, CreatedAt: "2026-01-01T00:00:00Z"}, nil
+	}
+
+	r := NewBackupAndDrBackupResource()
+	configureResource(t, r, api)
+
+	model := testBackupAndDrBackupModel()
+	model.ID = types.StringValue("snapshot-2")
+	model.SnapshotID = types.StringValue("snapshot-2")
+	// The apply timed out while the backup was running
+	model.Status = types.StringValue(client.SnapshotStatusRunning)
+	model.BackupTime = types.StringValue("2026-01-01T00:00:00Z")
+
+	var state BackupAndDrBackupResourceModel
+	if !testRead(t, r, model, &state) {
+		t.Fatal("Expected the backup to remain in state")
+	}
+	if state.Status.ValueString() != "completed" {
+		t.Errorf("Expected the status to be refreshed from the snapshot, got %s", state.Status)
+	}
+}
+
+func TestBackupAndDrBackupResource_ReadApplicationDeleted(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.getSnapshot = func(ctx context.Context, policyID, snapshotID string) (*client.Snapshot, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewBackupAndDrBackupResource()
+	configureResource(t, r, api)
+
+	model := testBackupAndDrBackupModel()
+	model.ID = types.StringValue("snapshot-2")
+	model.SnapshotID = types.StringValue("snapshot-2")
+	model.Status = types.StringValue(client.SnapshotStatusCompleted)
+	model.BackupTime = types.StringValue("2026-01-01T00:00:00Z")
+
+	var state BackupAndDrBackupResourceModel
+	if testRead(t, r, model, &state) {
+		t.Error("Expected the backup of a deleted application to be removed from state")
+	}
+}
@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &BackupAndDrRestoreResource{}
+var _ resource.ResourceWithImportState = &BackupAndDrRestoreResource{}
+
+// defaultRestoreTimeoutMinutes is how long the resource waits for a restore to finish when timeout_minutes isn't set
+const defaultRestoreTimeoutMinutes = 60
+
+// NewBackupAndDrRestoreResource creates a new backup and DR restore resource
+func NewBackupAndDrRestoreResource() resource.Resource {
+	return &BackupAndDrRestoreResource{}
+}
+
+// BackupAndDrRestoreResource defines the resource implementation
+type BackupAndDrRestoreResource struct {
+	client client.API
+}
+
+// BackupAndDrRestoreResourceModel describes the resource data model
+type BackupAndDrRestoreResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ApplicationID  types.String `tfsdk:"application_id"`
+	SnapshotID     types.String `tfsdk:"snapshot_id"`
+	TargetAccount  types.String `tfsdk:"target_account"`
+	TargetRegion   types.String `tfsdk:"target_region"`
+	AutoCreatePR   types.Bool   `tfsdk:"auto_create_pr"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	TimeoutMinutes types.Int64  `tfsdk:"timeout_minutes"`
+	Status         types.String `tfsdk:"status"`
+	PullRequestURL types.String `tfsdk:"pull_request_url"`
+	ErrorMessage   types.String `tfsdk:"error_message"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	CompletedAt    types.String `tfsdk:"completed_at"`
+}
+
+func (r *BackupAndDrRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_and_dr_restore"
+}
+
+func (r *BackupAndDrRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a snapshot of a Firefly Backup & DR application and waits for the restore to finish. " +
+			"A new restore is started whenever the snapshot, the target or the triggers change. Destroying the resource doesn't undo the restore.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the restore",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the backup application to restore",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot to restore. Defaults to the last backup of the application when the restore is created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_account": schema.StringAttribute{
+				MarkdownDescription: "The integration ID of the account the resources are restored to. Defaults to the target account of the application",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_region": schema.StringAttribute{
+				MarkdownDescription: "The region the resources are restored to. Defaults to the target region of the application",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"auto_create_pr": schema.BoolAttribute{
+				MarkdownDescription: "If true, a VCS pull request with the IaC of the restored resources is opened. Defaults to the setting of the application",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that start a new restore when they change, e.g. the date of a DR drill",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Time limit in minutes for the restore to finish. Defaults to %d", defaultRestoreTimeoutMinutes),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultRestoreTimeoutMinutes),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Last known status of the restore: `pending`, `running`, `completed` or `failed`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pull_request_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the pull request with the IaC of the restored resources, set once completed when `auto_create_pr` is true",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"error_message": schema.StringAttribute{
+				MarkdownDescription: "Why the restore failed",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp the restore started",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp the restore finished",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BackupAndDrRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create starts a restore and waits for it to finish
+func (r *BackupAndDrRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BackupAndDrRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := plan.ApplicationID.ValueString()
+	restoreReq := &client.RestoreRequest{
+		SnapshotID:    plan.SnapshotID.ValueString(),
+		TargetAccount: plan.TargetAccount.ValueString(),
+		TargetRegion:  plan.TargetRegion.ValueString(),
+	}
+	if !plan.AutoCreatePR.IsNull() && !plan.AutoCreatePR.IsUnknown() {
+		autoCreatePR := plan.AutoCreatePR.ValueBool()
+		restoreReq.AutoCreatePR = &autoCreatePR
+	}
+
+	// Restore the last backup when no snapshot is set
+	if restoreReq.SnapshotID == "" {
+		policy, err := r.client.BackupAndDr().Get(ctx, applicationID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading backup application",
+				fmt.Sprintf("Could not read backup application %s to find its last backup: %s", applicationID, err),
+			)
+			return
+		}
+		if policy.LastBackupSnapshotID == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("snapshot_id"),
+				"No snapshot to restore",
+				fmt.Sprintf("Backup application %s has no backup yet, set snapshot_id or wait for its first backup.", applicationID),
+			)
+			return
+		}
+		restoreReq.SnapshotID = policy.LastBackupSnapshotID
+	}
+
+	tflog.Debug(ctx, "Starting backup restore", map[string]interface{}{
+		"application_id": applicationID,
+		"snapshot_id":    restoreReq.SnapshotID,
+		"target_account": restoreReq.TargetAccount,
+		"target_region":  restoreReq.TargetRegion,
+	})
+
+	restore, err := r.client.BackupAndDr().StartRestore(ctx, applicationID, restoreReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error starting backup restore",
+			fmt.Sprintf("Could not restore snapshot %s of backup application %s: %s", restoreReq.SnapshotID, applicationID, err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(restore.RestoreID)
+	updateBackupAndDrRestoreModel(&plan, restore)
+
+	timeout := time.Duration(plan.TimeoutMinutes.ValueInt64()) * time.Minute
+	poll := client.RestorePoller(r.client.BackupAndDr(), applicationID, restore.RestoreID)
+	restore, err = client.WaitFor(ctx, poll, waitOptions(timeout))
+	if restore != nil {
+		updateBackupAndDrRestoreModel(&plan, restore)
+	}
+
+	// The restore exists even when waiting failed, so it's saved in the state. An error taints
+	// the resource and the next apply starts a new restore.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for backup restore",
+			fmt.Sprintf("Restore %s of backup application %s didn't finish: %s", plan.ID.ValueString(), applicationID, describeWaitError(err, timeout, plan.Status.ValueString())),
+		)
+		return
+	}
+
+	if restore.Status == client.RestoreStatusFailed {
+		resp.Diagnostics.AddError(
+			"Backup restore failed",
+			fmt.Sprintf("Restore %s of backup application %s failed: %s", restore.RestoreID, applicationID, restore.ErrorMessage),
+		)
+	}
+}
+
+func (r *BackupAndDrRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BackupAndDrRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restore, err := r.client.BackupAndDr().GetRestore(ctx, state.ApplicationID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Backup restore not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading backup restore",
+			fmt.Sprintf("Could not read restore %s of backup application %s: %s", state.ID.ValueString(), state.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	updateBackupAndDrRestoreModel(&state, restore)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the new timeout, every other change starts a new restore
+func (r *BackupAndDrRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BackupAndDrRestoreResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the restore from the Terraform state. Restored resources are left in place.
+func (r *BackupAndDrRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BackupAndDrRestoreResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing backup restore from state", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+// ImportState imports a restore using the application_id:restore_id format
+func (r *BackupAndDrRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format: application_id:restore_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout_minutes"), int64(defaultRestoreTimeoutMinutes))...)
+}
+
+// updateBackupAndDrRestoreModel copies the restore returned by the API to the model
+func updateBackupAndDrRestoreModel(model *BackupAndDrRestoreResourceModel, restore *client.Restore) {
+	model.SnapshotID = types.StringValue(restore.SnapshotID)
+	model.TargetAccount = types.StringValue(restore.TargetAccount)
+	model.TargetRegion = types.StringValue(restore.TargetRegion)
+	model.AutoCreatePR = types.BoolValue(restore.AutoCreatePR)
+	model.Status = types.StringValue(restore.Status)
+	model.PullRequestURL = StringValueOrNull(restore.PullRequestURL)
+	model.ErrorMessage = StringValueOrNull(restore.ErrorMessage)
+	model.CreatedAt = types.StringValue(restore.CreatedAt)
+	model.CompletedAt = StringValueOrNull(restore.CompletedAt)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupAndDrRestoreResource(t *testing.T) {
+	fastPolling(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Restore the last backup of the application
+			{
+				Config: testAccBackupAndDrRestoreResourceConfig("drill-1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly_backup_and_dr_restore.test", "id"),
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_restore.test", "snapshot_id", testAccTargetSnapshotID),
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_restore.test", "target_region", "us-west-2"),
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_restore.test", "target_account", "test-integration-id"),
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_restore.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("firefly_backup_and_dr_restore.test", "pull_request_url"),
+				),
+			},
+			// A new drill restores an older snapshot
+			{
+				Config: testAccBackupAndDrRestoreResourceConfig("drill-2", testAccBaseSnapshotID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_restore.test", "snapshot_id", testAccBaseSnapshotID),
+					resource.TestCheckResourceAttr("firefly_backup_and_dr_restore.test", "status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBackupAndDrRestoreResourceConfig(drill, snapshotID string) string {
+	snapshot := ""
+	if snapshotID != "" {
+		snapshot = fmt.Sprintf("snapshot_id = %q", snapshotID)
+	}
+
+	return fmt.Sprintf(`
+resource "firefly_backup_and_dr_restore" "test" {
+  application_id = %q
+  %s
+  target_region  = "us-west-2"
+  auto_create_pr = true
+
+  triggers = {
+    drill = %q
+  }
+}
+`, testAccBackupApplicationID, snapshot, drill)
+}
+
+// testBackupAndDrRestoreModel returns the model of a restore of the last backup of application-1
+func testBackupAndDrRestoreModel() *BackupAndDrRestoreResourceModel {
+	return &BackupAndDrRestoreResourceModel{
+		ID:             types.StringUnknown(),
+		ApplicationID:  types.StringValue("application-1"),
+		SnapshotID:     types.StringUnknown(),
+		TargetAccount:  types.StringUnknown(),
+		TargetRegion:   types.StringValue("eu-west-1"),
+		AutoCreatePR:   types.BoolUnknown(),
+		Triggers:       types.MapNull(types.StringType),
+		TimeoutMinutes: types.Int64Value(5),
+		Status:         types.StringUnknown(),
+		PullRequestURL: types.StringUnknown(),
+		ErrorMessage:   types.StringUnknown(),
+		CreatedAt:      types.StringUnknown(),
+		CompletedAt:    types.StringUnknown(),
+	}
+}
+
+// mockRestoreStatuses makes the mock restore snapshot-2, the last backup of the application,
+// going through statuses, one per poll
+func mockRestoreStatuses(api *mockAPI, statuses ...string) *client.RestoreRequest {
+	var started client.RestoreRequest
+	polls := 0
+	api.backupAndDr.get = func(ctx context.Context, policyID string) (*client.PolicyResponse, error) {
+		return &client.PolicyResponse{PolicyID: policyID, IntegrationID: "integration-1", LastBackupSnapshotID: "snapshot-2"}, nil
+	}
+	api.backupAndDr.startRestore = func(ctx context.Context, policyID string, restore *client.RestoreRequest) (*client.Restore, error) {
+		started = *restore
+		return &client.Restore{RestoreID: "restore-1", PolicyID: policyID, SnapshotID: restore.SnapshotID, Status: client.RestoreStatusPending}, nil
+	}
+	api.backupAndDr.getRestore = func(ctx context.Context, policyID, restoreID string) (*client.Restore, error) {
+		restore := &client.Restore{
+			RestoreID:     restoreID,
+			PolicyID:      policyID,
+			SnapshotID:    "snapshot-2",
+			TargetAccount: "integration-1",
+			TargetRegion:  "eu-west-1",
+			AutoCreatePR:  true,
+			Status:        statuses[min(polls, len(statuses)-1)],
+			CreatedAt:     "2026-01-01T00:00:00Z",
+		}
+		polls++
+		switch restore.Status {
+		case client.RestoreStatusCompleted:
+			restore.PullRequestURL = "https://github.com/acme/infra/pull/7"
+			restore.CompletedAt = "2026-01-01T00:10:00Z"
+		case client.RestoreStatusFailed:
+			restore.ErrorMessage = "target region quota exceeded"
+		}
+		return restore, nil
+	}
+	return &started
+}
+
+func TestBackupAndDrRestoreResource_Create(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	started := mockRestoreStatuses(api, client.RestoreStatusRunning, client.RestoreStatusCompleted)
+
+	r := NewBackupAndDrRestoreResource()
+	configureResource(t, r, api)
+
+	var state BackupAndDrRestoreResourceModel
+	testCreate(t, r, testBackupAndDrRestoreModel(), &state)
+
+	// The last backup is restored, the application settings apply to the unset target account and auto PR
+	if started.SnapshotID != "snapshot-2" || started.TargetAccount != "" || started.TargetRegion != "eu-west-1" || started.AutoCreatePR != nil {
+		t.Errorf("Unexpected restore request %+v", started)
+	}
+	if state.ID.ValueString() != "restore-1" || state.SnapshotID.ValueString() != "snapshot-2" || state.TargetAccount.ValueString() != "integration-1" {
+		t.Errorf("Unexpected id %s, snapshot_id %s or target_account %s", state.ID, state.SnapshotID, state.TargetAccount)
+	}
+	if state.Status.ValueString() != "completed" || state.PullRequestURL.ValueString() != "https://github.com/acme/infra/pull/7" {
+		t.Errorf("Unexpected status %s or pull_request_url %s", state.Status, state.PullRequestURL)
+	}
+}
+
+func TestBackupAndDrRestoreResource_CreateFailed(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	mockRestoreStatuses(api, client.RestoreStatusRunning, client.RestoreStatusFailed)
+
+	r := NewBackupAndDrRestoreResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testBackupAndDrRestoreModel())
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a failed restore")
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "quota exceeded") {
+		t.Errorf("Expected the error to mention the cause, got %v", resp.Diagnostics)
+	}
+
+	// The restore is kept in the state, so the tainted resource starts a new restore on the next apply
+	var state BackupAndDrRestoreResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if state.ID.ValueString() != "restore-1" || state.Status.ValueString() != "failed" {
+		t.Errorf("Unexpected id %s or status %s", state.ID, state.Status)
+	}
+}
+
+func TestBackupAndDrRestoreResource_CreateWithoutBackup(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.get = func(ctx context.Context, policyID string) (*client.PolicyResponse, error) {
+		return &client.PolicyResponse{PolicyID: policyID}, nil
+	}
+
+	r := NewBackupAndDrRestoreResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testBackupAndDrRestoreModel())
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "no backup yet") {
+		t.Errorf("Expected an error for an application without backups, got %v", resp.Diagnostics)
+	}
+}
+
+func TestBackupAndDrRestoreResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.backupAndDr.getRestore = func(ctx context.Context, policyID, restoreID string) (*client.Restore, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewBackupAndDrRestoreResource()
+	configureResource(t, r, api)
+
+	model := testBackupAndDrRestoreModel()
+	model.ID = types.StringValue("restore-1")
+	model.SnapshotID = types.StringValue("snapshot-2")
+	model.TargetAccount = types.StringValue("integration-1")
+	model.AutoCreatePR = types.BoolValue(false)
+	model.Status = types.StringValue(client.RestoreStatusCompleted)
+	model.PullRequestURL = types.StringNull()
+	model.ErrorMessage = types.StringNull()
+	model.CreatedAt = types.StringValue("2026-01-01T00:00:00Z")
+	model.CompletedAt = types.StringValue("2026-01-01T00:10:00Z")
+
+	var state BackupAndDrRestoreResourceModel
+	if testRead(t, r, model, &state) {
+		t.Error("Expected the deleted restore to be removed from state")
+	}
+}
@@ -3,7 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
+	"net/http"
 
 	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -32,7 +32,7 @@ func NewGovernancePolicyResource() resource.Resource {
 
 // GovernancePolicyResource defines the resource implementation
 type GovernancePolicyResource struct {
-	client *client.Client
+	client client.API
 }
 
 func (r *GovernancePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -126,12 +126,12 @@ func (r *GovernancePolicyResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 	
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		
 		return
@@ -165,7 +165,7 @@ func (r *GovernancePolicyResource) Create(ctx context.Context, req resource.Crea
 	})
 	
 	// Create the policy
-	createdPolicy, err := r.client.GovernancePolicies.Create(policy)
+	createdPolicy, err := r.client.GovernancePolicies().Create(ctx, policy)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating governance policy",
@@ -208,19 +208,17 @@ func (r *GovernancePolicyResource) Read(ctx context.Context, req resource.ReadRe
 	})
 	
 	// Get the policy
-	policy, err := r.client.GovernancePolicies.Get(data.ID.ValueString())
+	policy, err := r.client.GovernancePolicies().Get(ctx, data.ID.ValueString())
 	if err != nil {
-		// Check if the error indicates the policy was not found (deleted outside Terraform)
-		errorMsg := err.Error()
-		if strings.Contains(errorMsg, "policy not found") || 
-		   strings.Contains(errorMsg, "not found") ||
-		   strings.Contains(errorMsg, "status 404") ||
-		   strings.Contains(errorMsg, "status 500") {
+		// Check if the error indicates the policy was not found (deleted outside Terraform).
+		// The insights API answers lookups of deleted policies with a 500, so that is
+		// treated the same way as a 404.
+		if client.IsNotFound(err) || client.StatusCode(err) == http.StatusInternalServerError {
 			// Policy was deleted outside of Terraform or there's a server error
 			// In either case, remove it from state to allow Terraform to continue
 			tflog.Info(ctx, "Governance policy not found or server error, removing from state", map[string]interface{}{
 				"id": data.ID.ValueString(),
-				"error": errorMsg,
+				"error": err.Error(),
 			})
 			resp.State.RemoveResource(ctx)
 			return
@@ -274,7 +272,7 @@ func (r *GovernancePolicyResource) Update(ctx context.Context, req resource.Upda
 	})
 	
 	// Update the policy
-	updatedPolicy, err := r.client.GovernancePolicies.Update(data.ID.ValueString(), policy)
+	updatedPolicy, err := r.client.GovernancePolicies().Update(ctx, data.ID.ValueString(), policy)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating governance policy",
@@ -323,8 +321,8 @@ func (r *GovernancePolicyResource) Delete(ctx context.Context, req resource.Dele
 	})
 	
 	// Delete the policy
-	err := r.client.GovernancePolicies.Delete(data.ID.ValueString())
-	if err != nil {
+	err := r.client.GovernancePolicies().Delete(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error deleting governance policy",
 			fmt.Sprintf("Could not delete governance policy: %s", err),
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &GovernancePolicyExclusionResource{}
+var _ resource.ResourceWithImportState = &GovernancePolicyExclusionResource{}
+var _ resource.ResourceWithConfigValidators = &GovernancePolicyExclusionResource{}
+var _ resource.ResourceWithValidateConfig = &GovernancePolicyExclusionResource{}
+var _ resource.ResourceWithModifyPlan = &GovernancePolicyExclusionResource{}
+
+// NewGovernancePolicyExclusionResource creates a new governance policy exclusion resource
+func NewGovernancePolicyExclusionResource() resource.Resource {
+	return &GovernancePolicyExclusionResource{}
+}
+
+// GovernancePolicyExclusionResource defines the resource implementation
+type GovernancePolicyExclusionResource struct {
+	client client.API
+}
+
+// GovernancePolicyExclusionResourceModel describes the resource data model
+type GovernancePolicyExclusionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PolicyID         types.String `tfsdk:"policy_id"`
+	Assets           types.List   `tfsdk:"assets"`
+	ResourceTypes    types.List   `tfsdk:"resource_types"`
+	ProviderAccounts types.List   `tfsdk:"provider_accounts"`
+	Justification    types.String `tfsdk:"justification"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	CreatedBy        types.String `tfsdk:"created_by"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+func (r *GovernancePolicyExclusionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_governance_policy_exclusion"
+}
+
+func (r *GovernancePolicyExclusionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an exclusion of a Firefly governance policy: assets or resource types the policy doesn't apply to, " +
+			"with the justification of the exception. Plans warn about exclusions past their `expires_at`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the exclusion",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the governance policy to exclude assets from",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"assets": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs or ARNs of the assets the policy doesn't apply to",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"resource_types": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Resource types the policy doesn't apply to, e.g. `aws_s3_bucket`",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"provider_accounts": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Provider accounts the exclusion is limited to. When not set, it applies to every account",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Why the policy doesn't apply to the excluded assets, kept as the review trail of the exception",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp after which the exclusion should be reviewed, e.g. `2025-06-30T00:00:00Z`. When not set, the exclusion doesn't expire",
+				Optional:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "The user who created the exclusion",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the exclusion was created",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the exclusion was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GovernancePolicyExclusionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("assets"),
+			path.MatchRoot("resource_types"),
+		),
+	}
+}
+
+// ValidateConfig checks expires_at is an RFC 3339 timestamp
+func (r *GovernancePolicyExclusionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expiresAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, expiresAt.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid Attribute Value",
+			fmt.Sprintf("expires_at must be an RFC 3339 timestamp such as 2025-06-30T00:00:00Z, got %q.", expiresAt.ValueString()))
+	}
+}
+
+// ModifyPlan warns about an exclusion past its expiry, so expired exceptions are reviewed
+func (r *GovernancePolicyExclusionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan GovernancePolicyExclusionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ExpiresAt.IsNull() || plan.ExpiresAt.IsUnknown() {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+	if err != nil {
+		// ValidateConfig reports it
+		return
+	}
+	if time.Now().After(expiresAt) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("expires_at"), "Expired Governance Policy Exclusion",
+			fmt.Sprintf("The exclusion of governance policy %s expired on %s. Review it, then extend expires_at or remove the exclusion.",
+				plan.PolicyID.ValueString(), plan.ExpiresAt.ValueString()))
+	}
+}
+
+func (r *GovernancePolicyExclusionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GovernancePolicyExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan GovernancePolicyExclusionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := newGovernancePolicyExclusionRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating governance policy exclusion", map[string]interface{}{
+		"policy_id": plan.PolicyID.ValueString(),
+	})
+
+	exclusion, err := r.client.GovernancePolicies().CreateExclusion(ctx, plan.PolicyID.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating governance policy exclusion",
+			fmt.Sprintf("Could not create exclusion of governance policy %s: %s", plan.PolicyID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(updateGovernancePolicyExclusionModel(ctx, &plan, exclusion)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the exclusion from the state when it or its policy was deleted
+func (r *GovernancePolicyExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state GovernancePolicyExclusionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exclusion, err := r.client.GovernancePolicies().GetExclusion(ctx, state.PolicyID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Governance policy exclusion not found, removing from state", map[string]interface{}{
+				"policy_id": state.PolicyID.ValueString(),
+				"id":        state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading governance policy exclusion",
+			fmt.Sprintf("Could not read exclusion %s of governance policy %s: %s", state.ID.ValueString(), state.PolicyID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(updateGovernancePolicyExclusionModel(ctx, &state, exclusion)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *GovernancePolicyExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan GovernancePolicyExclusionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := newGovernancePolicyExclusionRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating governance policy exclusion", map[string]interface{}{
+		"policy_id": plan.PolicyID.ValueString(),
+		"id":        plan.ID.ValueString(),
+	})
+
+	exclusion, err := r.client.GovernancePolicies().UpdateExclusion(ctx, plan.PolicyID.ValueString(), plan.ID.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating governance policy exclusion",
+			fmt.Sprintf("Could not update exclusion %s of governance policy %s: %s", plan.ID.ValueString(), plan.PolicyID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(updateGovernancePolicyExclusionModel(ctx, &plan, exclusion)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GovernancePolicyExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state GovernancePolicyExclusionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting governance policy exclusion", map[string]interface{}{
+		"policy_id": state.PolicyID.ValueString(),
+		"id":        state.ID.ValueString(),
+	})
+
+	err := r.client.GovernancePolicies().DeleteExclusion(ctx, state.PolicyID.ValueString(), state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting governance policy exclusion",
+			fmt.Sprintf("Could not delete exclusion %s of governance policy %s: %s", state.ID.ValueString(), state.PolicyID.ValueString(), err),
+		)
+	}
+}
+
+// ImportState imports an exclusion using the policy_id:exclusion_id format
+func (r *GovernancePolicyExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format: policy_id:exclusion_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), parts[0])...)
+}
+
+// newGovernancePolicyExclusionRequest converts the model to the API request
+func newGovernancePolicyExclusionRequest(ctx context.Context, model GovernancePolicyExclusionResourceModel) (*client.GovernancePolicyExclusionRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	request := &client.GovernancePolicyExclusionRequest{
+		Justification: model.Justification.ValueString(),
+		ExpiresAt:     model.ExpiresAt.ValueString(),
+	}
+
+	if !model.Assets.IsNull() {
+		diags.Append(model.Assets.ElementsAs(ctx, &request.Assets, false)...)
+	}
+	if !model.ResourceTypes.IsNull() {
+		diags.Append(model.ResourceTypes.ElementsAs(ctx, &request.ResourceTypes, false)...)
+	}
+	if !model.ProviderAccounts.IsNull() {
+		diags.Append(model.ProviderAccounts.ElementsAs(ctx, &request.ProviderAccounts, false)...)
+	}
+
+	return request, diags
+}
+
+// updateGovernancePolicyExclusionModel copies the exclusion from the API to the model. expires_at
+// keeps the configured format when the API returns the same time in another format.
+func updateGovernancePolicyExclusionModel(ctx context.Context, model *GovernancePolicyExclusionResourceModel, exclusion *client.GovernancePolicyExclusion) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(exclusion.ID)
+	if exclusion.PolicyID != "" {
+		model.PolicyID = types.StringValue(exclusion.PolicyID)
+	}
+	model.Justification = types.StringValue(exclusion.Justification)
+	model.CreatedBy = StringValueOrNull(exclusion.CreatedBy)
+	model.CreatedAt = StringValueOrNull(exclusion.CreatedAt)
+	model.UpdatedAt = StringValueOrNull(exclusion.UpdatedAt)
+
+	if !sameTimestamp(model.ExpiresAt.ValueString(), exclusion.ExpiresAt) {
+		model.ExpiresAt = StringValueOrNull(exclusion.ExpiresAt)
+	}
+
+	for _, field := range []struct {
+		value  *types.List
+		values []string
+	}{
+		{&model.Assets, exclusion.Assets},
+		{&model.ResourceTypes, exclusion.ResourceTypes},
+		{&model.ProviderAccounts, exclusion.ProviderAccounts},
+	} {
+		if len(field.values) == 0 {
+			*field.value = types.ListNull(types.StringType)
+			continue
+		}
+		list, d := types.ListValueFrom(ctx, types.StringType, field.values)
+		diags.Append(d...)
+		*field.value = list
+	}
+
+	return diags
+}
+
+// sameTimestamp reports whether the two RFC 3339 timestamps are the same time
+func sameTimestamp(a, b string) bool {
+	if a == b {
+		return true
+	}
+	timeA, errA := time.Parse(time.RFC3339, a)
+	timeB, errB := time.Parse(time.RFC3339, b)
+	return errA == nil && errB == nil && timeA.Equal(timeB)
+}
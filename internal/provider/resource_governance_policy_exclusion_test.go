@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccGovernancePolicyExclusionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGovernancePolicyExclusionConfig(`assets = ["arn:aws:s3:::access-logs"]`, "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly_governance_policy_exclusion.test", "id"),
+					resource.TestCheckResourceAttr("firefly_governance_policy_exclusion.test", "policy_id", testAccBuiltinGovernancePolicy.ID),
+					resource.TestCheckResourceAttr("firefly_governance_policy_exclusion.test", "assets.0", "arn:aws:s3:::access-logs"),
+					resource.TestCheckResourceAttr("firefly_governance_policy_exclusion.test", "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("firefly_governance_policy_exclusion.test", "created_by"),
+				),
+			},
+			{
+				ResourceName:      "firefly_governance_policy_exclusion.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					exclusion := state.RootModule().Resources["firefly_governance_policy_exclusion.test"]
+					return exclusion.Primary.Attributes["policy_id"] + ":" + exclusion.Primary.ID, nil
+				},
+			},
+			{
+				Config: testAccGovernancePolicyExclusionConfig(`resource_types = ["aws_s3_bucket"]`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_governance_policy_exclusion.test", "resource_types.0", "aws_s3_bucket"),
+					resource.TestCheckNoResourceAttr("firefly_governance_policy_exclusion.test", "assets"),
+					resource.TestCheckNoResourceAttr("firefly_governance_policy_exclusion.test", "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGovernancePolicyExclusionConfig(target, expiresAt string) string {
+	expiry := ""
+	if expiresAt != "" {
+		expiry = fmt.Sprintf("expires_at    = %q", expiresAt)
+	}
+	return fmt.Sprintf(`
+resource "firefly_governance_policy_exclusion" "test" {
+  policy_id     = %q
+  %s
+  justification = "Access logs don't hold customer data"
+  %s
+}
+`, testAccBuiltinGovernancePolicy.ID, target, expiry)
+}
+
+// testExclusionModel returns an exclusion of the S3 access logs bucket expiring at expiresAt
+func testExclusionModel(expiresAt types.String) GovernancePolicyExclusionResourceModel {
+	return GovernancePolicyExclusionResourceModel{
+		ID:               types.StringUnknown(),
+		PolicyID:         types.StringValue("policy-1"),
+		Assets:           testStrings("arn:aws:s3:::access-logs"),
+		ResourceTypes:    types.ListNull(types.StringType),
+		ProviderAccounts: types.ListNull(types.StringType),
+		Justification:    types.StringValue("Access logs don't hold customer data"),
+		ExpiresAt:        expiresAt,
+		CreatedBy:        types.StringUnknown(),
+		CreatedAt:        types.StringUnknown(),
+		UpdatedAt:        types.StringUnknown(),
+	}
+}
+
+func TestGovernancePolicyExclusionResource_Create(t *testing.T) {
+	var request client.GovernancePolicyExclusionRequest
+	api := &mockAPI{}
+	api.governancePolicies.createExclusion = func(ctx context.Context, policyID string, exclusion *client.GovernancePolicyExclusionRequest) (*client.GovernancePolicyExclusion, error) {
+		request = *exclusion
+		return &client.GovernancePolicyExclusion{
+			ID:            "exclusion-1",
+			PolicyID:      policyID,
+			Assets:        exclusion.Assets,
+			Justification: exclusion.Justification,
+			ExpiresAt:     "2030-06-30T00:00:00.000Z",
+			CreatedBy:     "user@example.com",
+			CreatedAt:     "2025-01-01T00:00:00Z",
+		}, nil
+	}
+
+	r := NewGovernancePolicyExclusionResource()
+	configureResource(t, r, api)
+
+	var state GovernancePolicyExclusionResourceModel
+	testCreate(t, r, testExclusionModel(types.StringValue("2030-06-30T00:00:00Z")), &state)
+
+	if !slices.Equal(request.Assets, []string{"arn:aws:s3:::access-logs"}) || request.ResourceTypes != nil || request.ExpiresAt != "2030-06-30T00:00:00Z" {
+		t.Errorf("Unexpected request %+v", request)
+	}
+	if state.ID.ValueString() != "exclusion-1" || state.CreatedBy.ValueString() != "user@example.com" {
+		t.Errorf("Unexpected state %+v", state)
+	}
+	// The API format of the same time must not change the configured value
+	if state.ExpiresAt.ValueString() != "2030-06-30T00:00:00Z" {
+		t.Errorf("Expected the configured expires_at, got %s", state.ExpiresAt)
+	}
+	if !state.ResourceTypes.IsNull() || !state.UpdatedAt.IsNull() {
+		t.Errorf("Expected null resource_types and updated_at, got %s and %s", state.ResourceTypes, state.UpdatedAt)
+	}
+}
+
+func TestGovernancePolicyExclusionResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.governancePolicies.getExclusion = func(ctx context.Context, policyID, exclusionID string) (*client.GovernancePolicyExclusion, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewGovernancePolicyExclusionResource()
+	configureResource(t, r, api)
+
+	model := testExclusionModel(types.StringNull())
+	model.ID = types.StringValue("exclusion-1")
+	model.CreatedBy = types.StringNull()
+	model.CreatedAt = types.StringNull()
+	model.UpdatedAt = types.StringNull()
+
+	var state GovernancePolicyExclusionResourceModel
+	if testRead(t, r, model, &state) {
+		t.Error("Expected the deleted exclusion to be removed from the state")
+	}
+}
+
+func TestGovernancePolicyExclusionResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		expiresAt types.String
+		wantError bool
+	}{
+		"no expiry":     {types.StringNull(), false},
+		"unknown":       {types.StringUnknown(), false},
+		"rfc 3339":      {types.StringValue("2030-06-30T00:00:00+02:00"), false},
+		"date only":     {types.StringValue("2030-06-30"), true},
+		"not timestamp": {types.StringValue("next quarter"), true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runValidateConfig(t, NewGovernancePolicyExclusionResource(), testExclusionModel(test.expiresAt))
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Errorf("Expected error %t, got %v", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGovernancePolicyExclusionResource_ModifyPlanWarnsWhenExpired(t *testing.T) {
+	tests := map[string]struct {
+		expiresAt   types.String
+		wantWarning bool
+	}{
+		"expired":    {types.StringValue("2020-01-01T00:00:00Z"), true},
+		"not yet":    {types.StringValue("2099-01-01T00:00:00Z"), false},
+		"no expiry":  {types.StringNull(), false},
+		"unknown":    {types.StringUnknown(), false},
+		"not parsed": {types.StringValue("next quarter"), false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runModifyPlan(t, NewGovernancePolicyExclusionResource(), testExclusionModel(test.expiresAt))
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan failed: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != test.wantWarning {
+				t.Errorf("Expected warning %t, got %v", test.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -30,13 +30,23 @@ type GovernancePolicyDataSourceModel struct {
 	Severity    types.String `tfsdk:"severity"`
 	Category    types.String `tfsdk:"category"`
 	Frameworks  types.List   `tfsdk:"frameworks"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
 }
 
 // GovernancePoliciesDataSourceModel represents the data source model for listing governance policies
 type GovernancePoliciesDataSourceModel struct {
-	ID       types.String                       `tfsdk:"id"`
-	Query    types.String                       `tfsdk:"query"`
-	Labels   types.List                         `tfsdk:"labels"`
-	Category types.String                       `tfsdk:"category"`
-	Policies []GovernancePolicyDataSourceModel `tfsdk:"policies"`
-}
\ No newline at end of file
+	ID           types.String                      `tfsdk:"id"`
+	Query        types.String                      `tfsdk:"query"`
+	Labels       types.List                        `tfsdk:"labels"`
+	Category     types.String                      `tfsdk:"category"`
+	Frameworks   types.List                        `tfsdk:"frameworks"`
+	Severity     types.List                        `tfsdk:"severity"`
+	Type         types.List                        `tfsdk:"type"`
+	Providers    types.List                        `tfsdk:"providers"`
+	Integrations types.List                        `tfsdk:"integrations"`
+	OnlyEnabled  types.Bool                        `tfsdk:"only_enabled"`
+	IsDefault    types.Bool                        `tfsdk:"is_default"`
+	Sorting      types.List                        `tfsdk:"sorting"`
+	Limit        types.Int64                       `tfsdk:"limit"`
+	Policies     []GovernancePolicyDataSourceModel `tfsdk:"policies"`
+}
@@ -1,14 +1,15 @@
 package provider
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
 func TestAccGovernancePolicyResource(t *testing.T) {
@@ -76,7 +77,7 @@ func testAccGovernancePolicyResourceConfig(name string) string {
 	} else {
 		severity = "low"
 	}
-	
+
 	return fmt.Sprintf(`
 resource "firefly_governance_policy" "test" {
   name        = %[1]q
@@ -296,4 +297,83 @@ func TestIsLikelyBase64(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestGovernancePolicyResource_Create(t *testing.T) {
+	regoCode := "firefly {\n    input.instance_state == \"stopped\"\n}\n"
+
+	var created *client.GovernancePolicy
+	api := &mockAPI{}
+	api.governancePolicies.create = func(ctx context.Context, policy *client.GovernancePolicy) (*client.GovernancePolicy, error) {
+		created = policy
+		response := *policy
+		response.ID = "policy-1"
+		return &response, nil
+	}
+
+	r := NewGovernancePolicyResource()
+	configureResource(t, r, api)
+
+	var state GovernancePolicyResourceModel
+	testCreate(t, r, &GovernancePolicyResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("stopped-instances"),
+		Description: types.StringValue("Find stopped instances"),
+		Code:        types.StringValue(regoCode),
+		Type:        testStrings("aws_instance"),
+		ProviderIDs: testStrings("aws_all"),
+		Labels:      testStrings("cost"),
+		Severity:    types.StringValue("medium"),
+		Category:    types.StringValue("Optimization"),
+		Frameworks:  testStrings(),
+	}, &state)
+
+	if created == nil {
+		t.Fatal("Expected Create to be called")
+	}
+	if created.Code != base64.StdEncoding.EncodeToString([]byte(regoCode)) {
+		t.Errorf("Expected the code to be sent base64 encoded, got %s", created.Code)
+	}
+	if created.Severity != 4 || created.Category != "Optimization" {
+		t.Errorf("Unexpected severity %d or category %s", created.Severity, created.Category)
+	}
+
+	if state.ID.ValueString() != "policy-1" {
+		t.Errorf("Expected ID policy-1, got %s", state.ID)
+	}
+	if state.Code.ValueString() != regoCode {
+		t.Errorf("Expected the code to be stored decoded, got %s", state.Code)
+	}
+	if !state.Labels.Equal(testStrings("cost")) || state.Severity.ValueString() != "medium" {
+		t.Errorf("Unexpected labels %s or severity %s", state.Labels, state.Severity)
+	}
+}
+
+func TestGovernancePolicyResource_ReadRemoved(t *testing.T) {
+	for name, err := range map[string]error{
+		"not found":    notFoundError(),
+		"server error": &client.APIError{Operation: "get", StatusCode: 500, Message: "internal error"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := &mockAPI{}
+			api.governancePolicies.get = func(ctx context.Context, id string) (*client.GovernancePolicy, error) {
+				return nil, err
+			}
+
+			r := NewGovernancePolicyResource()
+			configureResource(t, r, api)
+
+			var state GovernancePolicyResourceModel
+			if testRead(t, r, &GovernancePolicyResourceModel{
+				ID:          types.StringValue("policy-1"),
+				Name:        types.StringValue("stopped-instances"),
+				Code:        types.StringValue("firefly { true }"),
+				Type:        testStrings("aws_instance"),
+				ProviderIDs: testStrings("aws_all"),
+				Labels:      types.ListNull(types.StringType),
+				Frameworks:  testStrings(),
+			}, &state) {
+				t.Error("Expected the policy to be removed from the state")
+			}
+		})
+	}
+}
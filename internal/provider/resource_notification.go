@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                   = &notificationResource{}
+	_ resource.ResourceWithConfigure      = &notificationResource{}
+	_ resource.ResourceWithValidateConfig = &notificationResource{}
+	_ resource.ResourceWithImportState    = &notificationResource{}
+)
+
+// NewNotificationResource is a helper function to simplify the provider implementation
+func NewNotificationResource() resource.Resource {
+	return &notificationResource{}
+}
+
+// notificationResource manages a notification channel
+type notificationResource struct {
+	client client.API
+}
+
+// NotificationResourceModel describes the resource data model
+type NotificationResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	WebhookURL     types.String `tfsdk:"webhook_url"`
+	Channel        types.String `tfsdk:"channel"`
+	Emails         types.List   `tfsdk:"emails"`
+	Token          types.String `tfsdk:"token"`
+	IntegrationKey types.String `tfsdk:"integration_key"`
+	APIKey         types.String `tfsdk:"api_key"`
+	Region         types.String `tfsdk:"region"`
+}
+
+// notificationDestination lists the destination attributes a channel type requires and the ones it accepts
+type notificationDestination struct {
+	required []string
+	optional []string
+}
+
+// notificationDestinations are the destination attributes of each channel type
+var notificationDestinations = map[string]notificationDestination{
+	client.NotificationTypeSlack:     {required: []string{"webhook_url"}, optional: []string{"channel"}},
+	client.NotificationTypeTeams:     {required: []string{"webhook_url"}},
+	client.NotificationTypeEmail:     {required: []string{"emails"}},
+	client.NotificationTypeWebhook:   {required: []string{"webhook_url"}, optional: []string{"token"}},
+	client.NotificationTypePagerDuty: {required: []string{"integration_key"}},
+	client.NotificationTypeOpsgenie:  {required: []string{"api_key"}, optional: []string{"region"}},
+}
+
+// destinationAttributes returns the destination attributes of the model by name
+func (m NotificationResourceModel) destinationAttributes() map[string]attr.Value {
+	return map[string]attr.Value{
+		"webhook_url":     m.WebhookURL,
+		"channel":         m.Channel,
+		"emails":          m.Emails,
+		"token":           m.Token,
+		"integration_key": m.IntegrationKey,
+		"api_key":         m.APIKey,
+		"region":          m.Region,
+	}
+}
+
+// Metadata returns the resource type name
+func (r *notificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+// Schema defines the schema for the resource
+func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Firefly notification channel, used by guardrails and Backup & DR policies to send alerts to Slack, Microsoft Teams, email, a webhook, PagerDuty or Opsgenie",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the notification channel",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the notification channel",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: fmt.Sprintf("The type of the notification channel: %s. Changing it replaces the channel", strings.Join(client.NotificationTypes, ", ")),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.NotificationTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "The incoming webhook URL of slack and teams channels, or the URL called by webhook channels",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"channel": schema.StringAttribute{
+				Description: "The Slack channel to post to, overriding the channel of the incoming webhook. Only for slack channels",
+				Optional:    true,
+			},
+			"emails": schema.ListAttribute{
+				Description: "The recipients of email channels",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "The bearer token sent in the Authorization header by webhook channels",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"integration_key": schema.StringAttribute{
+				Description: "The Events API v2 integration key of pagerduty channels",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API integration key of opsgenie channels",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The Opsgenie region, us or eu. Only for opsgenie channels",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("us", "eu"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *notificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the destination attributes set match the type of the channel
+func (r *notificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	notificationType := data.Type.ValueString()
+	destination, ok := notificationDestinations[notificationType]
+	if !ok {
+		// The type validator reports it
+		return
+	}
+
+	attributes := data.destinationAttributes()
+	for _, name := range destination.required {
+		if attributes[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Attribute Configuration",
+				fmt.Sprintf("%s is required for %s notification channels.", name, notificationType))
+		}
+	}
+
+	for name, value := range attributes {
+		if value.IsNull() || slices.Contains(destination.required, name) || slices.Contains(destination.optional, name) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination",
+			fmt.Sprintf("%s can't be set for %s notification channels.", name, notificationType))
+	}
+}
+
+// newNotificationRequest converts the plan into the request creating or replacing the channel
+func newNotificationRequest(ctx context.Context, data NotificationResourceModel) (client.NotificationRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	req := client.NotificationRequest{
+		Name:           data.Name.ValueString(),
+		Type:           data.Type.ValueString(),
+		WebhookURL:     data.WebhookURL.ValueString(),
+		Channel:        data.Channel.ValueString(),
+		Token:          data.Token.ValueString(),
+		IntegrationKey: data.IntegrationKey.ValueString(),
+		APIKey:         data.APIKey.ValueString(),
+		Region:         data.Region.ValueString(),
+	}
+	if !data.Emails.IsNull() && !data.Emails.IsUnknown() {
+		diags.Append(data.Emails.ElementsAs(ctx, &req.Emails, false)...)
+	}
+
+	return req, diags
+}
+
+// Create creates the notification channel
+func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationReq, diags := newNotificationRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.Notifications().CreateNotification(ctx, notificationReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Notification", fmt.Sprintf("Could not create notification channel %s: %s", notificationReq.Name, err))
+		return
+	}
+
+	plan.ID = types.StringValue(notification.ID)
+
+	tflog.Trace(ctx, "Created notification", map[string]interface{}{"id": notification.ID, "type": notification.Type})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the notification channel. The API doesn't return the secrets of the channel,
+// they keep the value of the state.
+func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	notification, err := r.client.Notifications().GetNotification(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Notification", fmt.Sprintf("Could not read notification channel ID %s: %s", id, err))
+		return
+	}
+
+	state.Name = types.StringValue(notification.Name)
+	state.Type = types.StringValue(notification.Type)
+	state.Channel = StringValueOrNull(notification.Channel)
+	state.Region = StringValueOrNull(notification.Region)
+	state.Emails = types.ListNull(types.StringType)
+	if len(notification.Emails) > 0 {
+		emails, diags := types.ListValueFrom(ctx, types.StringType, notification.Emails)
+		resp.Diagnostics.Append(diags...)
+		state.Emails = emails
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update replaces the settings of the notification channel
+func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationReq, diags := newNotificationRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueString()
+	if _, err := r.client.Notifications().UpdateNotification(ctx, id, notificationReq); err != nil {
+		resp.Diagnostics.AddError("Error Updating Notification", fmt.Sprintf("Could not update notification channel ID %s: %s", id, err))
+		return
+	}
+
+	tflog.Trace(ctx, "Updated notification", map[string]interface{}{"id": id})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the notification channel
+func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if err := r.client.Notifications().DeleteNotification(ctx, id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Notification", fmt.Sprintf("Could not delete notification channel ID %s: %s", id, err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted notification", map[string]interface{}{"id": id})
+}
+
+// ImportState imports a notification channel by ID. Its secrets can't be read from the API,
+// the next apply sets them from the configuration.
+func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNotificationResourceConfig("test-alerts", "#alerts"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("firefly_notification.slack", "id"),
+					resource.TestCheckResourceAttr("firefly_notification.slack", "type", "slack"),
+					resource.TestCheckResourceAttr("firefly_notification.slack", "channel", "#alerts"),
+					resource.TestCheckResourceAttr("firefly_notification.slack", "webhook_url", "https://hooks.slack.com/services/T000/B000/XXXX"),
+					resource.TestCheckResourceAttr("firefly_notification.email", "emails.#", "2"),
+				),
+			},
+			// ImportState testing, the API doesn't return the webhook URL
+			{
+				ResourceName:            "firefly_notification.slack",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook_url"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationResourceConfig("test-alerts-renamed", "#incidents"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_notification.slack", "name", "test-alerts-renamed"),
+					resource.TestCheckResourceAttr("firefly_notification.slack", "channel", "#incidents"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationResourceConfig(name, channel string) string {
+	return fmt.Sprintf(`
+resource "firefly_notification" "slack" {
+  name        = %q
+  type        = "slack"
+  webhook_url = "https://hooks.slack.com/services/T000/B000/XXXX"
+  channel     = %q
+}
+
+resource "firefly_notification" "email" {
+  name   = "test-emails"
+  type   = "email"
+  emails = ["oncall@example.com", "platform@example.com"]
+}
+`, name, channel)
+}
+
+func TestNotificationResource_Create(t *testing.T) {
+	var received client.NotificationRequest
+	api := &mockAPI{}
+	api.notifications.createNotification = func(ctx context.Context, req client.NotificationRequest) (*client.Notification, error) {
+		received = req
+		return &client.Notification{ID: "notification-1", Name: req.Name, Type: req.Type}, nil
+	}
+
+	r := NewNotificationResource()
+	configureResource(t, r, api)
+
+	var state NotificationResourceModel
+	testCreate(t, r, &NotificationResourceModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("on-call"),
+		Type:           types.StringValue(client.NotificationTypePagerDuty),
+		WebhookURL:     types.StringNull(),
+		Channel:        types.StringNull(),
+		Emails:         types.ListNull(types.StringType),
+		Token:          types.StringNull(),
+		IntegrationKey: types.StringValue("secret-key"),
+		APIKey:         types.StringNull(),
+		Region:         types.StringNull(),
+	}, &state)
+
+	if received.IntegrationKey != "secret-key" || received.Type != client.NotificationTypePagerDuty {
+		t.Errorf("Unexpected request %+v", received)
+	}
+	if state.ID.ValueString() != "notification-1" || state.IntegrationKey.ValueString() != "secret-key" {
+		t.Errorf("Expected the ID and the integration key in state, got %+v", state)
+	}
+}
+
+func TestNotificationResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.notifications.getNotification = func(ctx context.Context, id string) (*client.Notification, error) {
+		// The API doesn't return the token
+		return &client.Notification{ID: id, Name: "renamed", Type: client.NotificationTypeWebhook}, nil
+	}
+
+	r := NewNotificationResource()
+	configureResource(t, r, api)
+
+	var state NotificationResourceModel
+	found := testRead(t, r, &NotificationResourceModel{
+		ID:             types.StringValue("notification-1"),
+		Name:           types.StringValue("hook"),
+		Type:           types.StringValue(client.NotificationTypeWebhook),
+		WebhookURL:     types.StringValue("https://example.com/hook"),
+		Channel:        types.StringNull(),
+		Emails:         types.ListNull(types.StringType),
+		Token:          types.StringValue("secret-token"),
+		IntegrationKey: types.StringNull(),
+		APIKey:         types.StringNull(),
+		Region:         types.StringNull(),
+	}, &state)
+	if !found {
+		t.Fatal("Expected the notification to remain in state")
+	}
+
+	if state.Name.ValueString() != "renamed" {
+		t.Errorf("Expected the name to be refreshed, got %s", state.Name)
+	}
+	if state.WebhookURL.ValueString() != "https://example.com/hook" || state.Token.ValueString() != "secret-token" {
+		t.Errorf("Expected the secrets to be kept, got %s and %s", state.WebhookURL, state.Token)
+	}
+}
+
+func TestNotificationResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.notifications.getNotification = func(ctx context.Context, id string) (*client.Notification, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewNotificationResource()
+	configureResource(t, r, api)
+
+	var state NotificationResourceModel
+	if testRead(t, r, &NotificationResourceModel{
+		ID:             types.StringValue("notification-1"),
+		Name:           types.StringValue("emails"),
+		Type:           types.StringValue(client.NotificationTypeEmail),
+		WebhookURL:     types.StringNull(),
+		Channel:        types.StringNull(),
+		Emails:         testStrings("team@example.com"),
+		Token:          types.StringNull(),
+		IntegrationKey: types.StringNull(),
+		APIKey:         types.StringNull(),
+		Region:         types.StringNull(),
+	}, &state) {
+		t.Error("Expected the deleted notification to be removed from state")
+	}
+}
+
+func TestNotificationResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		model  NotificationResourceModel
+		errors int
+	}{
+		"slack": {
+			model: NotificationResourceModel{
+				Type:       types.StringValue(client.NotificationTypeSlack),
+				WebhookURL: types.StringValue("https://hooks.slack.com/services/secret"),
+				Channel:    types.StringValue("#alerts"),
+			},
+		},
+		"opsgenie without api key": {
+			model: NotificationResourceModel{
+				Type:   types.StringValue(client.NotificationTypeOpsgenie),
+				Region: types.StringValue("eu"),
+			},
+			errors: 1,
+		},
+		"email with webhook settings": {
+			model: NotificationResourceModel{
+				Type:       types.StringValue(client.NotificationTypeEmail),
+				Emails:     testStrings("team@example.com"),
+				WebhookURL: types.StringValue("https://example.com/hook"),
+				Token:      types.StringValue("secret-token"),
+			},
+			errors: 2,
+		},
+		"unknown webhook url": {
+			model: NotificationResourceModel{
+				Type:       types.StringValue(client.NotificationTypeTeams),
+				WebhookURL: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			model := tt.model
+			model.ID = types.StringNull()
+			model.Name = types.StringValue("alerts")
+			// Zero strings are null, a null list needs its element type
+			if model.Emails.ElementType(context.Background()) == nil {
+				model.Emails = types.ListNull(types.StringType)
+			}
+
+			resp := runValidateConfig(t, NewNotificationResource(), &model)
+			if resp.Diagnostics.ErrorsCount() != tt.errors {
+				t.Errorf("Expected %d errors, got %v", tt.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                   = &projectMembersResource{}
+	_ resource.ResourceWithConfigure      = &projectMembersResource{}
+	_ resource.ResourceWithValidateConfig = &projectMembersResource{}
+	_ resource.ResourceWithImportState    = &projectMembersResource{}
+)
+
+// NewProjectMembersResource is a helper function to simplify the provider implementation
+func NewProjectMembersResource() resource.Resource {
+	return &projectMembersResource{}
+}
+
+// projectMembersResource manages the complete list of members of a project
+type projectMembersResource struct {
+	client client.API
+}
+
+// ProjectMembersResourceModel describes the resource data model
+type ProjectMembersResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Members   types.Set    `tfsdk:"member"`
+}
+
+// ProjectMemberModel describes a member of the project
+type ProjectMemberModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+}
+
+// projectMemberAttrTypes are the attribute types of a member block
+var projectMemberAttrTypes = map[string]attr.Type{
+	"user_id": types.StringType,
+	"email":   types.StringType,
+	"role":    types.StringType,
+}
+
+// Metadata returns the resource type name
+func (r *projectMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_members"
+}
+
+// Schema defines the schema for the resource
+func (r *projectMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete list of members of a Firefly project. Members that are not declared are removed from the project",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the resource, the ID of the project",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"member": schema.SetNestedBlock{
+				Description: "A member of the project, identified by user_id or email",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Description: "The ID of the user",
+							Optional:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user, used to identify the user when user_id is not set",
+							Optional:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the user in the project (e.g., 'admin', 'member', 'viewer')",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *projectMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks every member is identified, and only once. A user declared once by user_id
+// and once by email can't be detected before the email is resolved, reconcile rejects it at apply time.
+func (r *projectMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Members.IsUnknown() {
+		return
+	}
+
+	var members []ProjectMemberModel
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.UserID.IsUnknown() || member.Email.IsUnknown() {
+			continue
+		}
+		if member.UserID.ValueString() == "" && member.Email.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("member"), "Missing Member Identifier", "Every member must set user_id or email.")
+			continue
+		}
+
+		key := memberKey(member)
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(path.Root("member"), "Duplicate Member", fmt.Sprintf("The user %s is declared more than once.", key))
+		}
+		seen[key] = true
+	}
+}
+
+// Create adds the declared members to the project and removes the others
+func (r *projectMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ProjectID
+
+	tflog.Trace(ctx, "Created project members resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the members from the project. Members added outside of Terraform are kept in
+// the state, so the next plan removes them.
+func (r *projectMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	actual, err := r.client.Projects().ListProjectMembers(ctx, projectID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Project Members", fmt.Sprintf("Could not list the members of project ID %s: %s", projectID, err))
+		return
+	}
+
+	var known []ProjectMemberModel
+	if !state.Members.IsNull() {
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &known, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	members := make([]ProjectMemberModel, 0, len(actual))
+	var unmanaged []string
+	for _, member := range actual {
+		if i := findProjectMember(known, member); i >= 0 {
+			// Keep the identifier the member was declared with
+			members = append(members, ProjectMemberModel{
+				UserID: known[i].UserID,
+				Email:  known[i].Email,
+				Role:   types.StringValue(member.Role),
+			})
+			continue
+		}
+
+		members = append(members, ProjectMemberModel{
+			UserID: StringValueOrNull(member.UserID),
+			Email:  StringValueOrNull(member.Email),
+			Role:   types.StringValue(member.Role),
+		})
+		unmanaged = append(unmanaged, describeMember(member))
+	}
+
+	// Nothing is known about the members after an import
+	if len(unmanaged) > 0 && len(known) > 0 {
+		tflog.Warn(ctx, "Project has unmanaged members", map[string]interface{}{
+			"project_id": projectID,
+			"members":    unmanaged,
+		})
+		resp.Diagnostics.AddWarning(
+			"Unmanaged Project Members",
+			fmt.Sprintf("Project ID %s has members that are not declared in the configuration: %s. They will be removed on the next apply.", projectID, strings.Join(unmanaged, ", ")),
+		)
+	}
+
+	state.ID = state.ProjectID
+	membersValue, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: projectMemberAttrTypes}, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Members = membersValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update reconciles the members of the project with the plan
+func (r *projectMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ProjectID
+
+	tflog.Trace(ctx, "Updated project members resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes every member managed by the resource from the project
+func (r *projectMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []ProjectMemberModel
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	actual, err := r.client.Projects().ListProjectMembers(ctx, projectID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Project Members", fmt.Sprintf("Could not list the members of project ID %s: %s", projectID, err))
+		return
+	}
+
+	var userIDs []string
+	for _, member := range actual {
+		if findProjectMember(members, member) >= 0 {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing project members", map[string]interface{}{
+		"project_id": projectID,
+		"user_ids":   userIDs,
+	})
+
+	if err := r.client.Projects().RemoveProjectMembers(ctx, projectID, userIDs); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error Deleting Project Members", fmt.Sprintf("Could not remove the members of project ID %s: %s", projectID, err))
+		return
+	}
+
+	tflog.Trace(ctx, "Deleted project members resource")
+}
+
+// ImportState imports the members of a project by its ID
+func (r *projectMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+// reconcile lists the current members of the project and applies the difference with the plan:
+// one call adds the new members, the roles are changed one member at a time, and one last call
+// removes the undeclared members. Adding first means a failure never leaves the project with fewer
+// members than before.
+func (r *projectMembersResource) reconcile(ctx context.Context, plan *ProjectMembersResourceModel, diags *diag.Diagnostics) {
+	var desired []ProjectMemberModel
+	if !plan.Members.IsNull() {
+		diags.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	actual, err := r.client.Projects().ListProjectMembers(ctx, projectID)
+	if err != nil {
+		diags.AddError("Error Reading Project Members", fmt.Sprintf("Could not list the members of project ID %s: %s", projectID, err))
+		return
+	}
+
+	current := make(map[string]client.Member, len(actual))
+	for _, member := range actual {
+		current[member.UserID] = member
+	}
+
+	// Identify every declared member by user ID before changing anything, resolving the users declared
+	// by email, so that a user declared once by user_id and once by email is caught here
+	var add, changed []client.Member
+	declared := make(map[string]ProjectMemberModel, len(desired))
+	for _, member := range desired {
+		userID := r.memberUserID(ctx, member, actual, diags)
+		if userID == "" {
+			return
+		}
+		if previous, ok := declared[userID]; ok {
+			diags.AddAttributeError(path.Root("member"), "Duplicate Member",
+				fmt.Sprintf("The user %s is declared more than once, as %s and as %s.", userID, memberKey(previous), memberKey(member)))
+			return
+		}
+		declared[userID] = member
+
+		role := member.Role.ValueString()
+		existing, ok := current[userID]
+		switch {
+		case !ok:
+			add = append(add, client.Member{UserID: userID, Email: member.Email.ValueString(), Role: role})
+		case existing.Role != role:
+			changed = append(changed, client.Member{UserID: userID, Email: existing.Email, Role: role})
+		}
+	}
+
+	var remove []string
+	for _, member := range actual {
+		if _, ok := declared[member.UserID]; !ok {
+			remove = append(remove, member.UserID)
+		}
+
+	}
+
+	tflog.Debug(ctx, "Reconciling project members", map[string]interface{}{
+		"project_id": projectID,
+		"add":        len(add),
+		"changed":    len(changed),
+		"remove":     remove,
+	})
+
+	if len(add) > 0 {
+		if _, err := r.client.Projects().AddProjectMembers(ctx, projectID, add); err != nil {
+			added := make([]string, len(add))
+			for i, member := range add {
+				added[i] = describeMember(member)
+			}
+			diags.AddError("Error Adding Project Members", fmt.Sprintf("Could not add members %s to project ID %s: %s", strings.Join(added, ", "), projectID, err))
+			return
+		}
+	}
+
+	for _, member := range changed {
+		if _, err := r.client.Projects().UpdateProjectMember(ctx, projectID, member); err != nil {
+			addMemberUpdateError(diags, err)
+			return
+		}
+	}
+
+	if len(remove) > 0 {
+		if err := r.client.Projects().RemoveProjectMembers(ctx, projectID, remove); err != nil {
+			diags.AddError("Error Removing Project Members", fmt.Sprintf("Could not remove members %s from project ID %s: %s", strings.Join(remove, ", "), projectID, err))
+			return
+		}
+	}
+}
+
+// memberUserID returns the user ID of a declared member, looking up the users declared by email
+// that aren't members of the project yet. It returns an empty string when the user isn't found.
+func (r *projectMembersResource) memberUserID(ctx context.Context, member ProjectMemberModel, actual []client.Member, diags *diag.Diagnostics) string {
+	if userID := member.UserID.ValueString(); userID != "" {
+		return userID
+	}
+
+	email := member.Email.ValueString()
+	for _, existing := range actual {
+		if strings.EqualFold(existing.Email, email) {
+			return existing.UserID
+		}
+	}
+
+	user := resolveUserByEmail(ctx, r.client, email, diags)
+	if user == nil {
+		return ""
+	}
+	return user.ID
+}
+
+// findProjectMember returns the index of the declared member matching the project member,
+// by user ID or, for members declared by email only, by email. It returns -1 when none matches.
+func findProjectMember(declared []ProjectMemberModel, member client.Member) int {
+	for i, candidate := range declared {
+		if userID := candidate.UserID.ValueString(); userID != "" {
+			if userID == member.UserID {
+				return i
+			}
+			continue
+		}
+		if email := candidate.Email.ValueString(); email != "" && strings.EqualFold(email, member.Email) {
+			return i
+		}
+	}
+	return -1
+}
+
+// memberKey returns the identifier a member is declared with
+func memberKey(member ProjectMemberModel) string {
+	if userID := member.UserID.ValueString(); userID != "" {
+		return userID
+	}
+	return strings.ToLower(member.Email.ValueString())
+}
+
+// describeMember returns the user ID and the email of a member, for messages
+func describeMember(member client.Member) string {
+	switch {
+	case member.UserID == "":
+		return member.Email
+	case member.Email == "":
+		return member.UserID
+	default:
+		return fmt.Sprintf("%s (%s)", member.UserID, member.Email)
+	}
+}
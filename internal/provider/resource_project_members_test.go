@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectMembersResourceConfig("member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("firefly_project_members.test", "id", "firefly_workflows_project.test", "id"),
+					resource.TestCheckResourceAttr("firefly_project_members.test", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("firefly_project_members.test", "member.*", map[string]string{
+						"user_id": "user123",
+						"role":    "admin",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("firefly_project_members.test", "member.*", map[string]string{
+						"user_id": "user456",
+						"role":    "member",
+					}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProjectMembersResourceConfig("viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_project_members.test", "member.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("firefly_project_members.test", "member.*", map[string]string{
+						"user_id": "user456",
+						"role":    "viewer",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectMembersResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "firefly_workflows_project" "test" {
+  name        = "test-project-members"
+  description = "Test project for members testing"
+}
+
+resource "firefly_project_members" "test" {
+  project_id = firefly_workflows_project.test.id
+
+  member {
+    user_id = "user123"
+    role    = "admin"
+  }
+
+  member {
+    user_id = "user456"
+    role    = %q
+  }
+}
+`, role)
+}
+
+// testProjectMembers returns the members set of the resource
+func testProjectMembers(members ...ProjectMemberModel) types.Set {
+	values := make([]attr.Value, len(members))
+	for i, member := range members {
+		values[i] = types.ObjectValueMust(projectMemberAttrTypes, map[string]attr.Value{
+			"user_id": member.UserID,
+			"email":   member.Email,
+			"role":    member.Role,
+		})
+	}
+	return types.SetValueMust(types.ObjectType{AttrTypes: projectMemberAttrTypes}, values)
+}
+
+// testProjectMember returns a member declared with the given user ID or email
+func testProjectMember(userID, email, role string) ProjectMemberModel {
+	return ProjectMemberModel{
+		UserID: StringValueOrNull(userID),
+		Email:  StringValueOrNull(email),
+		Role:   types.StringValue(role),
+	}
+}
+
+func TestProjectMembersResource_Create(t *testing.T) {
+	var calls []string
+	api := &mockAPI{}
+	api.projects.listProjectMembers = func(ctx context.Context, projectID string) ([]client.Member, error) {
+		return []client.Member{
+			{UserID: "user-1", Email: "one@example.com", Role: "admin"},
+			{UserID: "user-2", Email: "two@example.com", Role: "member"},
+			{UserID: "user-3", Email: "ui@example.com", Role: "admin"},
+		}, nil
+	}
+	api.projects.removeProjectMembers = func(ctx context.Context, projectID string, userIDs []string) error {
+		calls = append(calls, fmt.Sprintf("remove %v", userIDs))
+		return nil
+	}
+	api.projects.addProjectMembers = func(ctx context.Context, projectID string, members []client.Member) ([]client.Member, error) {
+		calls = append(calls, fmt.Sprintf("add %v", members))
+		return members, nil
+	}
+	api.projects.updateProjectMember = func(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+		calls = append(calls, fmt.Sprintf("update %v", member))
+		return &member, nil
+	}
+	api.users.getUserByEmail = func(ctx context.Context, email string) (*client.User, error) {
+		return &client.User{ID: "user-4", Email: email}, nil
+	}
+
+	r := NewProjectMembersResource()
+	configureResource(t, r, api)
+
+	var state ProjectMembersResourceModel
+	testCreate(t, r, &ProjectMembersResourceModel{
+		ID:        types.StringUnknown(),
+		ProjectID: types.StringValue("project-1"),
+		Members: testProjectMembers(
+			testProjectMember("user-1", "", "admin"),
+			testProjectMember("", "TWO@example.com", "viewer"),
+			testProjectMember("", "new@example.com", "member"),
+		),
+	}, &state)
+
+	// The new member is added first, then user-2 changes role, and user-3 that isn't declared is removed last
+	expected := []string{
+		fmt.Sprintf("add %v", []client.Member{{UserID: "user-4", Email: "new@example.com", Role: "member"}}),
+		fmt.Sprintf("update %v", client.Member{UserID: "user-2", Email: "two@example.com", Role: "viewer"}),
+		"remove [user-3]",
+	}
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+	if state.ID.ValueString() != "project-1" || len(state.Members.Elements()) != 3 {
+		t.Errorf("Unexpected id %s or members %s", state.ID, state.Members)
+	}
+}
+
+func TestProjectMembersResource_CreateInvalidMembers(t *testing.T) {
+	tests := map[string]struct {
+		members types.Set
+		summary string
+	}{
+		"unknown email": {
+			members: testProjectMembers(testProjectMember("", "unknown@example.com", "member")),
+			summary: "User Not Found",
+		},
+		"same user by user_id and email": {
+			members: testProjectMembers(
+				testProjectMember("user-1", "", "admin"),
+				testProjectMember("", "one@example.com", "viewer"),
+			),
+			summary: "Duplicate Member",
+		},
+		"same new user by user_id and email": {
+			members: testProjectMembers(
+				testProjectMember("user-4", "", "admin"),
+				testProjectMember("", "new@example.com", "viewer"),
+			),
+			summary: "Duplicate Member",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			api := &mockAPI{}
+			api.projects.listProjectMembers = func(ctx context.Context, projectID string) ([]client.Member, error) {
+				return []client.Member{
+					{UserID: "user-1", Email: "one@example.com", Role: "admin"},
+					{UserID: "user-3", Email: "ui@example.com", Role: "admin"},
+				}, nil
+			}
+			api.users.getUserByEmail = func(ctx context.Context, email string) (*client.User, error) {
+				if email == "new@example.com" {
+					return &client.User{ID: "user-4", Email: email}, nil
+				}
+				return nil, notFoundError()
+			}
+
+			r := NewProjectMembersResource()
+			configureResource(t, r, api)
+
+			// The error stops the update before user-3 is removed, any change fails the test
+			resp := runCreate(t, r, &ProjectMembersResourceModel{
+				ID:        types.StringUnknown(),
+				ProjectID: types.StringValue("project-1"),
+				Members:   tt.members,
+			})
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.summary {
+				t.Errorf("Expected a %q error, got %v", tt.summary, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProjectMembersResource_UpdateRoleFailed(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.listProjectMembers = func(ctx context.Context, projectID string) ([]client.Member, error) {
+		return []client.Member{
+			{UserID: "user-1", Role: "member"},
+			{UserID: "user-3", Role: "admin"},
+		}, nil
+	}
+	api.projects.updateProjectMember = func(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+		return nil, &client.MemberUpdateError{
+			ProjectID:    projectID,
+			UserID:       member.UserID,
+			PreviousRole: "member",
+			Role:         member.Role,
+			Removed:      true,
+			Err:          fmt.Errorf("invalid role"),
+			RollbackErr:  fmt.Errorf("rate limited"),
+		}
+	}
+
+	r := NewProjectMembersResource()
+	configureResource(t, r, api)
+
+	// The failed role change stops the update before user-3 is removed
+	model := &ProjectMembersResourceModel{
+		ID:        types.StringValue("project-1"),
+		ProjectID: types.StringValue("project-1"),
+		Members:   testProjectMembers(testProjectMember("user-1", "", "admin")),
+	}
+	resp := runUpdate(t, r, model, model)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error")
+	}
+	if diag := resp.Diagnostics.Errors()[0]; diag.Summary() != "Project Membership Lost" || !strings.Contains(diag.Detail(), "rate limited") {
+		t.Errorf("Unexpected diagnostic %q: %s", diag.Summary(), diag.Detail())
+	}
+}
+
+func TestProjectMembersResource_CreateNoChanges(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.listProjectMembers = func(ctx context.Context, projectID string) ([]client.Member, error) {
+		return []client.Member{{UserID: "user-1", Role: "admin"}}, nil
+	}
+
+	r := NewProjectMembersResource()
+	configureResource(t, r, api)
+
+	// Any call to add or remove members fails the test
+	var state ProjectMembersResourceModel
+	testCreate(t, r, &ProjectMembersResourceModel{
+		ID:        types.StringUnknown(),
+		ProjectID: types.StringValue("project-1"),
+		Members:   testProjectMembers(testProjectMember("user-1", "", "admin")),
+	}, &state)
+}
+
+func TestProjectMembersResource_ReadDrift(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.listProjectMembers = func(ctx context.Context, projectID string) ([]client.Member, error) {
+		return []client.Member{
+			{UserID: "user-1", Email: "one@example.com", Role: "viewer"},
+			{UserID: "user-3", Email: "ui@example.com", Role: "admin"},
+		}, nil
+	}
+
+	r := NewProjectMembersResource()
+	configureResource(t, r, api)
+
+	resp := runRead(t, r, &ProjectMembersResourceModel{
+		ID:        types.StringValue("project-1"),
+		ProjectID: types.StringValue("project-1"),
+		Members: testProjectMembers(
+			testProjectMember("", "one@example.com", "admin"),
+			testProjectMember("user-2", "", "member"),
+		),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read failed: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a warning for the unmanaged member, got %v", resp.Diagnostics)
+	}
+
+	var state ProjectMembersResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+
+	// user-1 keeps the email it is declared with, user-2 is gone and user-3 is unmanaged
+	expected := testProjectMembers(
+		testProjectMember("", "one@example.com", "viewer"),
+		testProjectMember("user-3", "ui@example.com", "admin"),
+	)
+	if !state.Members.Equal(expected) {
+		t.Errorf("Expected members %s, got %s", expected, state.Members)
+	}
+}
+
+func TestProjectMembersResource_Delete(t *testing.T) {
+	var removed []string
+	api := &mockAPI{}
+	api.projects.listProjectMembers = func(ctx context.Context, projectID string) ([]client.Member, error) {
+		return []client.Member{
+			{UserID: "user-1", Email: "one@example.com", Role: "admin"},
+			{UserID: "user-3", Email: "ui@example.com", Role: "admin"},
+		}, nil
+	}
+	api.projects.removeProjectMembers = func(ctx context.Context, projectID string, userIDs []string) error {
+		removed = userIDs
+		return nil
+	}
+
+	r := NewProjectMembersResource()
+	configureResource(t, r, api)
+
+	resp := runDelete(t, r, &ProjectMembersResourceModel{
+		ID:        types.StringValue("project-1"),
+		ProjectID: types.StringValue("project-1"),
+		Members:   testProjectMembers(testProjectMember("", "one@example.com", "admin")),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete failed: %v", resp.Diagnostics)
+	}
+
+	// Members added since the last refresh are left alone
+	if fmt.Sprint(removed) != "[user-1]" {
+		t.Errorf("Expected only user-1 to be removed, got %v", removed)
+	}
+}
+
+func TestProjectMembersResource_ValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		members types.Set
+		errors  int
+	}{
+		"valid": {
+			members: testProjectMembers(testProjectMember("user-1", "", "admin"), testProjectMember("", "dev@example.com", "member")),
+		},
+		"missing identifier": {
+			members: testProjectMembers(testProjectMember("", "", "admin")),
+			errors:  1,
+		},
+		"duplicate": {
+			members: testProjectMembers(testProjectMember("", "dev@example.com", "admin"), testProjectMember("", "DEV@example.com", "member")),
+			errors:  1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runValidateConfig(t, NewProjectMembersResource(), &ProjectMembersResourceModel{
+				ID:        types.StringNull(),
+				ProjectID: types.StringValue("project-1"),
+				Members:   tt.members,
+			})
+			if resp.Diagnostics.ErrorsCount() != tt.errors {
+				t.Errorf("Expected %d errors, got %v", tt.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
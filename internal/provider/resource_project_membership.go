@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectMembershipResource{}
 var _ resource.ResourceWithImportState = &ProjectMembershipResource{}
+var _ resource.ResourceWithConfigValidators = &ProjectMembershipResource{}
 
 func NewProjectMembershipResource() resource.Resource {
 	return &ProjectMembershipResource{}
@@ -25,7 +29,7 @@ func NewProjectMembershipResource() resource.Resource {
 
 // ProjectMembershipResource defines the resource implementation.
 type ProjectMembershipResource struct {
-	client *client.Client
+	client client.API
 }
 
 // ProjectMembershipResourceModel describes the resource data model.
@@ -62,16 +66,25 @@ func (r *ProjectMembershipResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"user_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The ID of the user to add to the project",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the user to add to the project. Either `user_id` or `email` must be set",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"email": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The email address of the user",
+				MarkdownDescription: "The email address of the user. When `user_id` is not set, the user of the account with this email is added to the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						emailIdentifiesUser,
+						"Changing the email of a member identified by email adds another user to the project",
+						"Changing the email of a member identified by email adds another user to the project",
+					),
+				},
 			},
 			"role": schema.StringAttribute{
 				Required:            true,
@@ -81,18 +94,34 @@ func (r *ProjectMembershipResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *ProjectMembershipResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+// emailIdentifiesUser replaces the membership when the email changes and the user is identified by it
+func emailIdentifiesUser(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var userID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_id"), &userID)...)
+	resp.RequiresReplace = userID.IsNull()
+}
+
 func (r *ProjectMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider is not configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -111,6 +140,15 @@ func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	// Resolve the user from the email when no ID is given
+	if data.UserID.IsNull() || data.UserID.IsUnknown() {
+		user := resolveUserByEmail(ctx, r.client, data.Email.ValueString(), &resp.Diagnostics)
+		if user == nil {
+			return
+		}
+		data.UserID = types.StringValue(user.ID)
+	}
+
 	// Create the member object
 	member := client.Member{
 		UserID: data.UserID.ValueString(),
@@ -130,7 +168,7 @@ func (r *ProjectMembershipResource) Create(ctx context.Context, req resource.Cre
 		"role":       member.Role,
 	})
 
-	addedMember, err := r.client.Projects.AddProjectMember(data.ProjectID.ValueString(), member)
+	addedMember, err := r.client.Projects().AddProjectMember(ctx, data.ProjectID.ValueString(), member)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add member to project, got error: %s", err))
 		return
@@ -170,9 +208,9 @@ func (r *ProjectMembershipResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get the member from the project
-	member, err := r.client.Projects.GetProjectMember(data.ProjectID.ValueString(), data.UserID.ValueString())
+	member, err := r.client.Projects().GetProjectMember(ctx, data.ProjectID.ValueString(), data.UserID.ValueString())
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if client.IsNotFound(err) {
 			// Member has been removed outside of Terraform
 			resp.State.RemoveResource(ctx)
 			return
@@ -199,10 +237,11 @@ func (r *ProjectMembershipResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *ProjectMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProjectMembershipResourceModel
+	var data, state ProjectMembershipResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -226,9 +265,12 @@ func (r *ProjectMembershipResource) Update(ctx context.Context, req resource.Upd
 		"new_role":   member.Role,
 	})
 
-	updatedMember, err := r.client.Projects.UpdateProjectMember(data.ProjectID.ValueString(), member)
+	updatedMember, err := r.client.Projects().UpdateProjectMember(ctx, data.ProjectID.ValueString(), member)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project member, got error: %s", err))
+		addMemberUpdateError(&resp.Diagnostics, err)
+
+		// Keep the previous role in the state. A membership lost on the way is removed by the next refresh.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
 
@@ -252,6 +294,58 @@ func (r *ProjectMembershipResource) Update(ctx context.Context, req resource.Upd
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// resolveUserByEmail returns the user of the account with the email. It reports an error and
+// returns nil when the user can't be found.
+func resolveUserByEmail(ctx context.Context, api client.API, email string, diags *diag.Diagnostics) *client.User {
+	tflog.Debug(ctx, "Resolving user by email", map[string]interface{}{"email": email})
+
+	user, err := api.Users().GetUserByEmail(ctx, email)
+	if client.IsNotFound(err) {
+		diags.AddError(
+			"User Not Found",
+			fmt.Sprintf("No user with email %s has joined the Firefly account yet. Invite them to the account, or set user_id, before adding them to a project.", email),
+		)
+		return nil
+	}
+	if err != nil {
+		diags.AddError("Error Resolving User", fmt.Sprintf("Could not look up the user with email %s: %s", email, err))
+		return nil
+	}
+	return user
+}
+
+// addMemberUpdateError reports a failed role update with the role the member ended up with
+func addMemberUpdateError(diags *diag.Diagnostics, err error) {
+	var updateErr *client.MemberUpdateError
+	if !errors.As(err, &updateErr) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update project member, got error: %s", err))
+		return
+	}
+
+	change := fmt.Sprintf("Changing the role of user %s in project %s from %s to %s failed: %s",
+		updateErr.UserID, updateErr.ProjectID, updateErr.PreviousRole, updateErr.Role, updateErr.Err)
+
+	switch {
+	case updateErr.MembershipLost():
+		diags.AddError(
+			"Project Membership Lost",
+			fmt.Sprintf("%s\n\nThe user was removed from the project to change the role, and adding them back with role %s failed too: %s\n\n"+
+				"The user no longer has access to the project. Run terraform apply again to add them back.",
+				change, updateErr.PreviousRole, updateErr.RollbackErr),
+		)
+	case updateErr.RolledBack:
+		diags.AddError(
+			"Project Member Role Not Changed",
+			fmt.Sprintf("%s\n\nThe user was added back to the project with their previous role %s.", change, updateErr.PreviousRole),
+		)
+	default:
+		diags.AddError(
+			"Project Member Role Not Changed",
+			fmt.Sprintf("%s\n\nThe membership was not modified, the user keeps role %s.", change, updateErr.PreviousRole),
+		)
+	}
+}
+
 func (r *ProjectMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data ProjectMembershipResourceModel
 
@@ -268,10 +362,10 @@ func (r *ProjectMembershipResource) Delete(ctx context.Context, req resource.Del
 		"user_id":    data.UserID.ValueString(),
 	})
 
-	err := r.client.Projects.RemoveProjectMember(data.ProjectID.ValueString(), data.UserID.ValueString())
+	err := r.client.Projects().RemoveProjectMember(ctx, data.ProjectID.ValueString(), data.UserID.ValueString())
 	if err != nil {
 		// If member is already gone, don't error
-		if !strings.Contains(err.Error(), "not found") {
+		if !client.IsNotFound(err) {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove member from project, got error: %s", err))
 			return
 		}
@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -45,6 +50,47 @@ func TestAccProjectMembershipResource(t *testing.T) {
 	})
 }
 
+func TestAccProjectMembershipResource_email(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipResourceEmailConfig("dev@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_project_membership.test", "user_id", "dev-user-id"),
+					resource.TestCheckResourceAttr("firefly_project_membership.test", "email", "dev@example.com"),
+				),
+			},
+			// Another email adds another user
+			{
+				Config: testAccProjectMembershipResourceEmailConfig("viewer@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_project_membership.test", "user_id", "viewer-user-id"),
+				),
+			},
+			{
+				Config:      testAccProjectMembershipResourceEmailConfig("new-hire@example.com"),
+				ExpectError: regexp.MustCompile("has joined the Firefly account yet"),
+			},
+		},
+	})
+}
+
+func testAccProjectMembershipResourceEmailConfig(email string) string {
+	return fmt.Sprintf(`
+resource "firefly_workflows_project" "test" {
+  name = "test-project-membership-email"
+}
+
+resource "firefly_project_membership" "test" {
+  project_id = firefly_workflows_project.test.id
+  email      = %q
+  role       = "member"
+}
+`, email)
+}
+
 func testAccProjectMembershipResourceConfig(email, userID, role string) string {
 	return fmt.Sprintf(`
 resource "firefly_workflows_project" "test" {
@@ -77,4 +123,233 @@ func testAccProjectMembershipImportStateIdFunc(resourceName string) resource.Imp
 
 		return fmt.Sprintf("%s:%s", projectID, userID), nil
 	}
-}
\ No newline at end of file
+}
+func TestProjectMembershipResource_Create(t *testing.T) {
+	var added client.Member
+	api := &mockAPI{}
+	api.projects.addProjectMember = func(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+		if projectID != "project-1" {
+			t.Errorf("Expected project project-1, got %s", projectID)
+		}
+		added = member
+		return &client.Member{UserID: member.UserID, Role: member.Role}, nil
+	}
+
+	r := NewProjectMembershipResource()
+	configureResource(t, r, api)
+
+	var state ProjectMembershipResourceModel
+	testCreate(t, r, &ProjectMembershipResourceModel{
+		ID:        types.StringUnknown(),
+		ProjectID: types.StringValue("project-1"),
+		UserID:    types.StringValue("user-1"),
+		Email:     types.StringValue("user@example.com"),
+		Role:      types.StringValue("member"),
+	}, &state)
+
+	if expected := (client.Member{UserID: "user-1", Email: "user@example.com", Role: "member"}); added != expected {
+		t.Errorf("Expected member %+v, got %+v", expected, added)
+	}
+	if state.ID.ValueString() != "project-1:user-1" {
+		t.Errorf("Expected ID project-1:user-1, got %s", state.ID)
+	}
+	// The API doesn't return the email, the configured one is kept
+	if state.Email.ValueString() != "user@example.com" {
+		t.Errorf("Expected the configured email to be kept, got %s", state.Email)
+	}
+}
+
+func TestProjectMembershipResource_CreateByEmail(t *testing.T) {
+	var added client.Member
+	api := &mockAPI{}
+	api.users.getUserByEmail = func(ctx context.Context, email string) (*client.User, error) {
+		return &client.User{ID: "user-2", Email: "dev@example.com"}, nil
+	}
+	api.projects.addProjectMember = func(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+		added = member
+		return &member, nil
+	}
+
+	r := NewProjectMembershipResource()
+	configureResource(t, r, api)
+
+	var state ProjectMembershipResourceModel
+	testCreate(t, r, &ProjectMembershipResourceModel{
+		ID:        types.StringUnknown(),
+		ProjectID: types.StringValue("project-1"),
+		UserID:    types.StringUnknown(),
+		Email:     types.StringValue("Dev@example.com"),
+		Role:      types.StringValue("member"),
+	}, &state)
+
+	if added.UserID != "user-2" {
+		t.Errorf("Expected the email to be resolved to user-2, got %+v", added)
+	}
+	if state.ID.ValueString() != "project-1:user-2" || state.UserID.ValueString() != "user-2" {
+		t.Errorf("Unexpected id %s or user_id %s", state.ID, state.UserID)
+	}
+}
+
+func TestProjectMembershipResource_CreateUserNotJoined(t *testing.T) {
+	api := &mockAPI{}
+	api.users.getUserByEmail = func(ctx context.Context, email string) (*client.User, error) {
+		return nil, fmt.Errorf("user with email %s: %w", email, client.ErrNotFound)
+	}
+
+	r := NewProjectMembershipResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, &ProjectMembershipResourceModel{
+		ID:        types.StringUnknown(),
+		ProjectID: types.StringValue("project-1"),
+		UserID:    types.StringUnknown(),
+		Email:     types.StringValue("new-hire@example.com"),
+		Role:      types.StringValue("member"),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a user who hasn't joined the account")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "new-hire@example.com has joined the Firefly account yet") {
+		t.Errorf("Unexpected error %s", detail)
+	}
+}
+
+func TestProjectMembershipResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.getProjectMember = func(ctx context.Context, projectID, userID string) (*client.Member, error) {
+		return &client.Member{UserID: userID, Role: "admin"}, nil
+	}
+
+	r := NewProjectMembershipResource()
+	configureResource(t, r, api)
+
+	var state ProjectMembershipResourceModel
+	found := testRead(t, r, &ProjectMembershipResourceModel{
+		ID:        types.StringValue("project-1:user-1"),
+		ProjectID: types.StringValue("project-1"),
+		UserID:    types.StringValue("user-1"),
+		Email:     types.StringNull(),
+		Role:      types.StringValue("member"),
+	}, &state)
+	if !found {
+		t.Fatal("Expected the membership to remain in the state")
+	}
+
+	if state.Role.ValueString() != "admin" || !state.Email.IsNull() {
+		t.Errorf("Unexpected role %s or email %s", state.Role, state.Email)
+	}
+}
+
+func TestProjectMembershipResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.getProjectMember = func(ctx context.Context, projectID, userID string) (*client.Member, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewProjectMembershipResource()
+	configureResource(t, r, api)
+
+	var state ProjectMembershipResourceModel
+	if testRead(t, r, &ProjectMembershipResourceModel{
+		ID:        types.StringValue("project-1:user-1"),
+		ProjectID: types.StringValue("project-1"),
+		UserID:    types.StringValue("user-1"),
+		Role:      types.StringValue("member"),
+	}, &state) {
+		t.Error("Expected the membership to be removed from the state")
+	}
+}
+
+// testMembershipModel returns the model of the membership of user-1 in project-1
+func testMembershipModel(role string) *ProjectMembershipResourceModel {
+	return &ProjectMembershipResourceModel{
+		ID:        types.StringValue("project-1:user-1"),
+		ProjectID: types.StringValue("project-1"),
+		UserID:    types.StringValue("user-1"),
+		Email:     types.StringNull(),
+		Role:      types.StringValue(role),
+	}
+}
+
+func TestProjectMembershipResource_Update(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.updateProjectMember = func(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+		return &member, nil
+	}
+
+	r := NewProjectMembershipResource()
+	configureResource(t, r, api)
+
+	resp := runUpdate(t, r, testMembershipModel("admin"), testMembershipModel("member"))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update failed: %v", resp.Diagnostics)
+	}
+
+	var state ProjectMembershipResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if state.Role.ValueString() != "admin" {
+		t.Errorf("Expected role admin, got %s", state.Role)
+	}
+}
+
+func TestProjectMembershipResource_UpdateFailed(t *testing.T) {
+	tests := map[string]struct {
+		err     *client.MemberUpdateError
+		summary string
+		detail  string
+	}{
+		"unchanged": {
+			err:     &client.MemberUpdateError{},
+			summary: "Project Member Role Not Changed",
+			detail:  "the user keeps role member",
+		},
+		"rolled back": {
+			err:     &client.MemberUpdateError{Removed: true, RolledBack: true},
+			summary: "Project Member Role Not Changed",
+			detail:  "added back to the project with their previous role member",
+		},
+		"membership lost": {
+			err:     &client.MemberUpdateError{Removed: true, RollbackErr: fmt.Errorf("rate limited")},
+			summary: "Project Membership Lost",
+			detail:  "The user no longer has access to the project",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.err.ProjectID = "project-1"
+			tt.err.UserID = "user-1"
+			tt.err.PreviousRole = "member"
+			tt.err.Role = "admin"
+			tt.err.Err = fmt.Errorf("invalid role")
+
+			api := &mockAPI{}
+			api.projects.updateProjectMember = func(ctx context.Context, projectID string, member client.Member) (*client.Member, error) {
+				return nil, tt.err
+			}
+
+			r := NewProjectMembershipResource()
+			configureResource(t, r, api)
+
+			resp := runUpdate(t, r, testMembershipModel("admin"), testMembershipModel("member"))
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("Expected an error")
+			}
+			diag := resp.Diagnostics.Errors()[0]
+			if diag.Summary() != tt.summary || !strings.Contains(diag.Detail(), tt.detail) || !strings.Contains(diag.Detail(), "invalid role") {
+				t.Errorf("Unexpected diagnostic %q: %s", diag.Summary(), diag.Detail())
+			}
+
+			// The state keeps the previous role
+			var state ProjectMembershipResourceModel
+			if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+				t.Fatalf("Failed to decode state: %v", diags)
+			}
+			if state.Role.ValueString() != "member" {
+				t.Errorf("Expected role member, got %s", state.Role)
+			}
+		})
+	}
+}
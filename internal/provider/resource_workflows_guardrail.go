@@ -25,7 +25,7 @@ func NewGuardrailResource() resource.Resource {
 
 // guardrailResource is the resource implementation
 type guardrailResource struct {
-	client *client.Client
+	client client.API
 }
 
 // Metadata returns the resource type name
@@ -39,11 +39,11 @@ func (r *guardrailResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -77,7 +77,7 @@ func (r *guardrailResource) Create(ctx context.Context, req resource.CreateReque
 		"type": guardrail.Type,
 	})
 
-	createResp, err := r.client.Guardrails.CreateGuardrail(guardrail)
+	createResp, err := r.client.Guardrails().CreateGuardrail(ctx, guardrail)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Guardrail",
@@ -97,7 +97,7 @@ func (r *guardrailResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Fetch the created guardrail to get computed properties only
-	createdGuardrail, err := r.client.Guardrails.GetGuardrail(createResp.RuleID)
+	createdGuardrail, err := r.client.Guardrails().GetGuardrail(ctx, createResp.RuleID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Created Guardrail",
@@ -134,8 +134,15 @@ func (r *guardrailResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get guardrail from API
-	guardrail, err := r.client.Guardrails.GetGuardrail(state.ID.ValueString())
+	guardrail, err := r.client.Guardrails().GetGuardrail(ctx, state.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Guardrail not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Guardrail",
 			fmt.Sprintf("Could not read guardrail ID %s: %s", state.ID.ValueString(), err),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,7 +35,7 @@ func (r *guardrailResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Update in the API
-	_, err = r.client.Guardrails.UpdateGuardrail(plan.ID.ValueString(), guardrail)
+	_, err = r.client.Guardrails().UpdateGuardrail(ctx, plan.ID.ValueString(), guardrail)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Guardrail",
@@ -44,7 +45,7 @@ func (r *guardrailResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Get updated guardrail from API
-	updatedGuardrail, err := r.client.Guardrails.GetGuardrail(plan.ID.ValueString())
+	updatedGuardrail, err := r.client.Guardrails().GetGuardrail(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Updated Guardrail",
@@ -128,8 +129,8 @@ func (r *guardrailResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	// Delete guardrail
-	_, err := r.client.Guardrails.DeleteGuardrail(state.ID.ValueString())
-	if err != nil {
+	_, err := r.client.Guardrails().DeleteGuardrail(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Guardrail",
 			fmt.Sprintf("Could not delete guardrail ID %s: %s", state.ID.ValueString(), err),
@@ -164,7 +165,7 @@ func (r *guardrailResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    false,
 			},
 			"notification_id": schema.StringAttribute{
-				Description: "ID of the associated notification",
+				Description: "ID of the notification channel alerted when the guardrail is violated, e.g. the id of a firefly_notification",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -171,3 +175,121 @@ resource "firefly_workflows_guardrail" "scoped" {
 }
 `
 }
+
+func TestGuardrailResource_Create(t *testing.T) {
+	var created *client.GuardrailRule
+	api := &mockAPI{}
+	api.guardrails.createGuardrail = func(ctx context.Context, guardrail *client.GuardrailRule) (*client.CreateGuardrailResponse, error) {
+		created = guardrail
+		return &client.CreateGuardrailResponse{RuleID: "rule-1"}, nil
+	}
+	api.guardrails.getGuardrail = func(ctx context.Context, ruleID string) (*client.GuardrailRule, error) {
+		return &client.GuardrailRule{ID: ruleID, CreatedAt: "2024-01-01T00:00:00Z", UpdatedAt: "2024-01-02T00:00:00Z"}, nil
+	}
+
+	r := NewGuardrailResource()
+	configureResource(t, r, api)
+
+	var state GuardrailResourceModel
+	testCreate(t, r, &GuardrailResourceModel{
+		ID:   types.StringUnknown(),
+		Name: types.StringValue("cost-limit"),
+		Type: types.StringValue("cost"),
+		Scope: &GuardrailScopeModel{
+			Workspaces: &IncludeExcludeWildcardModel{
+				Include: testStrings("prod-*"),
+				Exclude: types.ListNull(types.StringType),
+			},
+		},
+		Criteria: &GuardrailCriteriaModel{
+			Cost: &CostCriteriaModel{
+				ThresholdAmount:     types.Float64Value(100),
+				ThresholdPercentage: types.Float64Null(),
+			},
+		},
+		IsEnabled:      types.BoolValue(true),
+		CreatedAt:      types.StringUnknown(),
+		UpdatedAt:      types.StringUnknown(),
+		NotificationID: types.StringUnknown(),
+		Severity:       types.StringValue("high"),
+	}, &state)
+
+	if created == nil {
+		t.Fatal("Expected CreateGuardrail to be called")
+	}
+	if created.Name != "cost-limit" || created.Type != "cost" || !created.IsEnabled || created.Severity != 5 {
+		t.Errorf("Unexpected guardrail %+v", created)
+	}
+	if expected := []string{"prod-*"}; !reflect.DeepEqual(created.Scope.Workspaces.Include, expected) {
+		t.Errorf("Expected workspaces include %v, got %v", expected, created.Scope.Workspaces.Include)
+	}
+	if expected := []string{"*"}; !reflect.DeepEqual(created.Scope.Branches.Include, expected) {
+		t.Errorf("Expected branches to default to %v, got %v", expected, created.Scope.Branches.Include)
+	}
+	if created.Criteria == nil || created.Criteria.Cost == nil || created.Criteria.Cost.ThresholdAmount == nil || *created.Criteria.Cost.ThresholdAmount != 100 {
+		t.Errorf("Unexpected criteria %+v", created.Criteria)
+	} else if created.Criteria.Cost.ThresholdPercentage != nil {
+		t.Errorf("Expected no threshold percentage, got %v", *created.Criteria.Cost.ThresholdPercentage)
+	}
+
+	if state.ID.ValueString() != "rule-1" || state.NotificationID.ValueString() != "" {
+		t.Errorf("Unexpected ID %s or notification ID %s", state.ID, state.NotificationID)
+	}
+	if state.CreatedAt.ValueString() != "2024-01-01T00:00:00Z" || state.UpdatedAt.ValueString() != "2024-01-02T00:00:00Z" {
+		t.Errorf("Unexpected timestamps %s and %s", state.CreatedAt, state.UpdatedAt)
+	}
+}
+
+func TestGuardrailResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.guardrails.getGuardrail = func(ctx context.Context, ruleID string) (*client.GuardrailRule, error) {
+		return &client.GuardrailRule{
+			ID:        ruleID,
+			Name:      "renamed",
+			Type:      "cost",
+			IsEnabled: false,
+			Severity:  6,
+			UpdatedAt: "2024-02-01T00:00:00Z",
+		}, nil
+	}
+
+	r := NewGuardrailResource()
+	configureResource(t, r, api)
+
+	var state GuardrailResourceModel
+	found := testRead(t, r, &GuardrailResourceModel{
+		ID:             types.StringValue("rule-1"),
+		Name:           types.StringValue("cost-limit"),
+		Type:           types.StringValue("cost"),
+		IsEnabled:      types.BoolValue(true),
+		CreatedAt:      types.StringValue("2024-01-01T00:00:00Z"),
+		UpdatedAt:      types.StringValue("2024-01-02T00:00:00Z"),
+		NotificationID: types.StringValue(""),
+		Severity:       types.StringValue("high"),
+	}, &state)
+	if !found {
+		t.Fatal("Expected the guardrail to remain in the state")
+	}
+
+	if state.Name.ValueString() != "renamed" || state.IsEnabled.ValueBool() || state.Severity.ValueString() != "critical" {
+		t.Errorf("Unexpected name %s, is_enabled %s or severity %s", state.Name, state.IsEnabled, state.Severity)
+	}
+	if state.CreatedAt.ValueString() != "2024-01-01T00:00:00Z" || state.UpdatedAt.ValueString() != "2024-02-01T00:00:00Z" {
+		t.Errorf("Unexpected timestamps %s and %s", state.CreatedAt, state.UpdatedAt)
+	}
+}
+
+func TestGuardrailResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.guardrails.getGuardrail = func(ctx context.Context, ruleID string) (*client.GuardrailRule, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewGuardrailResource()
+	configureResource(t, r, api)
+
+	var state GuardrailResourceModel
+	if testRead(t, r, &GuardrailResourceModel{ID: types.StringValue("rule-1")}, &state) {
+		t.Error("Expected the guardrail to be removed from the state")
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -32,7 +31,7 @@ func NewProjectResource() resource.Resource {
 
 // projectResource is the resource implementation
 type projectResource struct {
-	client *client.Client
+	client client.API
 }
 
 // ProjectResourceModel describes the resource data model
@@ -162,11 +161,11 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -242,7 +241,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		"name": createReq.Name,
 	})
 
-	project, err := r.client.Projects.CreateProject(createReq)
+	project, err := r.client.Projects().CreateProject(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Project",
@@ -252,7 +251,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Fetch the created project to get all computed fields
-	createdProject, err := r.client.Projects.GetProject(project.ID)
+	createdProject, err := r.client.Projects().GetProject(ctx, project.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Project After Creation",
@@ -296,10 +295,10 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get project from API
-	project, err := r.client.Projects.GetProject(state.ID.ValueString())
+	project, err := r.client.Projects().GetProject(ctx, state.ID.ValueString())
 	if err != nil {
 		// Check if the project was deleted outside of Terraform (404 error)
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if client.IsNotFound(err) {
 			tflog.Warn(ctx, "Project not found, removing from state", map[string]interface{}{
 				"id": state.ID.ValueString(),
 			})
@@ -409,7 +408,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		"name": updateReq.Name,
 	})
 
-	_, err := r.client.Projects.UpdateProject(plan.ID.ValueString(), updateReq)
+	_, err := r.client.Projects().UpdateProject(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Project",
@@ -419,7 +418,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Fetch the updated project to get all computed fields
-	updatedProject, err := r.client.Projects.GetProject(plan.ID.ValueString())
+	updatedProject, err := r.client.Projects().GetProject(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Project After Update",
@@ -466,8 +465,8 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		"id": state.ID.ValueString(),
 	})
 
-	err := r.client.Projects.DeleteProject(state.ID.ValueString())
-	if err != nil {
+	err := r.client.Projects().DeleteProject(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Project",
 			fmt.Sprintf("Could not delete project ID %s: %s", state.ID.ValueString(), err),
@@ -512,22 +511,21 @@ func projectVariablesToValues(ctx context.Context, variables []ProjectVariableMo
 
 // getRootProjectID finds the root project ID by listing projects and finding one without a parent
 func (r *projectResource) getRootProjectID(ctx context.Context) (string, error) {
-	// List projects to find the root project
-	projects, err := r.client.Projects.ListProjects(100, 0, "")
+	// Find the project without a parent (root project)
+	project, err := client.Find(r.client.Projects().AllProjects(ctx, ""), func(p client.Project) bool {
+		return p.ParentID == ""
+	})
+	if client.IsNotFound(err) {
+		return "", fmt.Errorf("no root project found")
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	// Find the project without a parent (root project)
-	for _, project := range projects.Data {
-		if project.ParentID == "" {
-			tflog.Debug(ctx, "Found root project", map[string]interface{}{
-				"root_project_id": project.ID,
-				"root_project_name": project.Name,
-			})
-			return project.ID, nil
-		}
-	}
+	tflog.Debug(ctx, "Found root project", map[string]interface{}{
+		"root_project_id":   project.ID,
+		"root_project_name": project.Name,
+	})
 
-	return "", fmt.Errorf("no root project found")
+	return project.ID, nil
 }
\ No newline at end of file
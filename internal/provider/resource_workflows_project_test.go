@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"reflect"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -125,4 +130,130 @@ resource "firefly_workflows_project" "test" {
   cron_execution_pattern = "0 2 * * *"
 }
 `
-}
\ No newline at end of file
+}
+func TestProjectResource_Create(t *testing.T) {
+	var created client.CreateProjectRequest
+	api := &mockAPI{}
+	api.projects.allProjects = func(ctx context.Context, searchQuery string) iter.Seq2[client.Project, error] {
+		return sliceSeq(client.Project{ID: "root", ParentID: ""})
+	}
+	api.projects.createProject = func(ctx context.Context, req client.CreateProjectRequest) (*client.Project, error) {
+		created = req
+		return &client.Project{ID: "project-1"}, nil
+	}
+	api.projects.getProject = func(ctx context.Context, id string) (*client.Project, error) {
+		return &client.Project{
+			ID:             id,
+			AccountID:      "account-1",
+			Name:           created.Name,
+			Labels:         created.Labels,
+			ParentID:       created.ParentID,
+			MembersCount:   2,
+			WorkspaceCount: 3,
+		}, nil
+	}
+
+	r := NewProjectResource()
+	configureResource(t, r, api)
+
+	var state ProjectResourceModel
+	testCreate(t, r, &ProjectResourceModel{
+		Name:                 types.StringValue("platform"),
+		Description:          types.StringValue("Platform team"),
+		Labels:               testStrings("team", "prod"),
+		CronExecutionPattern: types.StringValue("0 * * * *"),
+		Variables:            testVariables(client.Variable{Key: "REGION", Value: "us-east-1", Sensitivity: client.SensitivityString, Destination: client.DestinationEnv}),
+		ParentID:             types.StringNull(),
+		ID:                   types.StringUnknown(),
+		AccountID:            types.StringUnknown(),
+		MembersCount:         types.Int64Unknown(),
+		WorkspaceCount:       types.Int64Unknown(),
+	}, &state)
+
+	expected := client.CreateProjectRequest{
+		Name:                 "platform",
+		Description:          "Platform team",
+		Labels:               []string{"team", "prod"},
+		CronExecutionPattern: "0 * * * *",
+		Variables:            []client.Variable{{Key: "REGION", Value: "us-east-1", Sensitivity: client.SensitivityString, Destination: client.DestinationEnv}},
+		ParentID:             "root",
+	}
+	if !reflect.DeepEqual(created, expected) {
+		t.Errorf("Expected request %+v, got %+v", expected, created)
+	}
+
+	if state.ID.ValueString() != "project-1" || state.AccountID.ValueString() != "account-1" {
+		t.Errorf("Unexpected ID %s or account ID %s", state.ID, state.AccountID)
+	}
+	if state.ParentID.ValueString() != "root" {
+		t.Errorf("Expected parent_id root, got %s", state.ParentID)
+	}
+	if state.MembersCount.ValueInt64() != 2 || state.WorkspaceCount.ValueInt64() != 3 {
+		t.Errorf("Unexpected counts %s and %s", state.MembersCount, state.WorkspaceCount)
+	}
+}
+
+func TestProjectResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.getProject = func(ctx context.Context, id string) (*client.Project, error) {
+		return &client.Project{
+			ID:          id,
+			AccountID:   "account-1",
+			Name:        "renamed",
+			Description: "changed outside terraform",
+			Labels:      []string{"new"},
+			ParentID:    "root",
+			Variables:   []client.Variable{{Key: "A", Value: "1", Sensitivity: client.SensitivityString, Destination: client.DestinationIAC}},
+		}, nil
+	}
+
+	r := NewProjectResource()
+	configureResource(t, r, api)
+
+	var state ProjectResourceModel
+	found := testRead(t, r, &ProjectResourceModel{
+		ID:                   types.StringValue("project-1"),
+		Name:                 types.StringValue("platform"),
+		Description:          types.StringNull(),
+		Labels:               testStrings(),
+		CronExecutionPattern: types.StringNull(),
+		Variables:            types.ListNull(testVariableType),
+		ParentID:             types.StringValue("root"),
+		AccountID:            types.StringValue("account-1"),
+		MembersCount:         types.Int64Value(0),
+		WorkspaceCount:       types.Int64Value(0),
+	}, &state)
+	if !found {
+		t.Fatal("Expected the project to remain in the state")
+	}
+
+	if state.Name.ValueString() != "renamed" || state.Description.ValueString() != "changed outside terraform" {
+		t.Errorf("Unexpected name %s or description %s", state.Name, state.Description)
+	}
+	if !state.Labels.Equal(testStrings("new")) {
+		t.Errorf("Unexpected labels %s", state.Labels)
+	}
+	if expected := testVariables(client.Variable{Key: "A", Value: "1", Sensitivity: client.SensitivityString, Destination: client.DestinationIAC}); !state.Variables.Equal(expected) {
+		t.Errorf("Expected variables %s, got %s", expected, state.Variables)
+	}
+}
+
+func TestProjectResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.projects.getProject = func(ctx context.Context, id string) (*client.Project, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewProjectResource()
+	configureResource(t, r, api)
+
+	var state ProjectResourceModel
+	if testRead(t, r, &ProjectResourceModel{
+		ID:        types.StringValue("project-1"),
+		Name:      types.StringValue("platform"),
+		Labels:    types.ListNull(types.StringType),
+		Variables: types.ListNull(testVariableType),
+	}, &state) {
+		t.Error("Expected the project to be removed from the state")
+	}
+}
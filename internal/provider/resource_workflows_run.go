@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ resource.Resource                = &workflowsRunResource{}
+	_ resource.ResourceWithConfigure   = &workflowsRunResource{}
+	_ resource.ResourceWithImportState = &workflowsRunResource{}
+)
+
+// defaultRunTimeoutMinutes is how long the resource waits for a run to finish when timeout_minutes isn't set
+const defaultRunTimeoutMinutes = 30
+
+// NewWorkflowsRunResource is a helper function to simplify the provider implementation
+func NewWorkflowsRunResource() resource.Resource {
+	return &workflowsRunResource{}
+}
+
+// workflowsRunResource is the resource implementation
+type workflowsRunResource struct {
+	client client.API
+}
+
+// WorkflowsRunResourceModel describes the resource data model
+type WorkflowsRunResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	WorkspaceID    types.String `tfsdk:"workspace_id"`
+	TaskType       types.String `tfsdk:"task_type"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	TimeoutMinutes types.Int64  `tfsdk:"timeout_minutes"`
+	TaskID         types.String `tfsdk:"task_id"`
+	RunID          types.String `tfsdk:"run_id"`
+	Status         types.String `tfsdk:"status"`
+	Branch         types.String `tfsdk:"branch"`
+	CommitID       types.String `tfsdk:"commit_id"`
+	CommitURL      types.String `tfsdk:"commit_url"`
+	BuildURL       types.String `tfsdk:"build_url"`
+}
+
+// Metadata returns the resource type name
+func (r *workflowsRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflows_run"
+}
+
+// Schema defines the schema for the resource
+func (r *workflowsRunResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a plan or apply run on a Firefly runners workspace and waits for its outcome. " +
+			"A new run is started whenever the workspace or the triggers change. Destroying the resource doesn't affect the run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the run",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the runners workspace to run",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_type": schema.StringAttribute{
+				Description: "Type of the run, either `plan` or `apply`. Defaults to `apply`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.TaskTypeApply),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.TaskTypePlan, client.TaskTypeApply),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that start a new run when they change, e.g. the outputs of the workspaces this one depends on",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				Description: fmt.Sprintf("Time limit in minutes for the run to reach a final status. Defaults to %d.", defaultRunTimeoutMinutes),
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultRunTimeoutMinutes),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Description: "Identifier of the task that started the run",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"run_id": schema.StringAttribute{
+				Description: "Identifier of the run",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Last known status of the run, e.g. `apply_success`, `plan_error` or `blocked` when it waits for an approval",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Description: "Branch the run was started from",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commit_id": schema.StringAttribute{
+				Description: "Commit the run was started from",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commit_url": schema.StringAttribute{
+				Description: "URL of the commit the run was started from",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"build_url": schema.StringAttribute{
+				Description: "URL of the run logs",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource
+func (r *workflowsRunResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create starts a run and waits for it to reach a final status
+func (r *workflowsRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan WorkflowsRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID := plan.WorkspaceID.ValueString()
+	taskType := plan.TaskType.ValueString()
+
+	tflog.Debug(ctx, "Starting workspace run", map[string]interface{}{
+		"workspace_id": workspaceID,
+		"task_type":    taskType,
+	})
+
+	task, err := r.client.RunnersWorkspaces().RunWorkspaceTask(ctx, workspaceID, client.RunTaskRequest{TaskType: taskType})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Starting Workspace Run",
+			fmt.Sprintf("Could not start a %s run on workspace ID %s: %s", taskType, workspaceID, err),
+		)
+		return
+	}
+	if task.RunID == "" {
+		resp.Diagnostics.AddError(
+			"Error Starting Workspace Run",
+			fmt.Sprintf("The %s task %s started on workspace ID %s didn't return a run ID", taskType, task.TaskID, workspaceID),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(task.RunID)
+	plan.TaskID = types.StringValue(task.TaskID)
+	plan.RunID = types.StringValue(task.RunID)
+	// The status of the task isn't one of the run, it's only known once the run is read
+	plan.Status = types.StringNull()
+	plan.Branch = types.StringValue("")
+	plan.CommitID = types.StringValue("")
+	plan.CommitURL = types.StringValue("")
+	plan.BuildURL = types.StringValue("")
+
+	timeout := time.Duration(plan.TimeoutMinutes.ValueInt64()) * time.Minute
+	poll := client.WorkspaceRunPoller(r.client.RunnersWorkspaces(), workspaceID, task.RunID, taskType)
+	run, err := client.WaitFor(ctx, poll, waitOptions(timeout))
+	if run != nil {
+		updateWorkflowsRunModel(&plan, run)
+	}
+
+	// The run exists even when waiting failed, so it's saved in the state. An error taints
+	// the resource and the next apply starts a new run.
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting For Workspace Run",
+			fmt.Sprintf("Run %s of workspace ID %s didn't finish: %s", task.RunID, workspaceID, describeWaitError(err, timeout, plan.Status.ValueString())),
+		)
+		return
+	}
+
+	switch {
+	case client.IsFailedRunStatus(run.Status):
+		resp.Diagnostics.AddError(
+			"Workspace Run Failed",
+			fmt.Sprintf("Run %s of workspace ID %s ended with status %s. See %s for the logs.", task.RunID, workspaceID, run.Status, run.BuildURL),
+		)
+	case run.Status == string(client.WorkspaceRunStatusBlocked):
+		resp.Diagnostics.AddWarning(
+			"Workspace Run Blocked",
+			fmt.Sprintf("Run %s of workspace ID %s waits for a manual approval and hasn't been applied yet.", task.RunID, workspaceID),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data
+func (r *workflowsRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state WorkflowsRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, err := r.client.RunnersWorkspaces().GetWorkspaceRun(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "Workspace run not found, removing from state", map[string]interface{}{
+				"id": state.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Workspace Run",
+			fmt.Sprintf("Could not read run %s of workspace ID %s: %s", state.ID.ValueString(), state.WorkspaceID.ValueString(), err),
+		)
+		return
+	}
+
+	updateWorkflowsRunModel(&state, run)
+
+	// An imported run only has its ID, the task that started it tells its type
+	if state.TaskType.IsNull() || state.TaskID.IsNull() {
+		task, err := r.findRunTask(ctx, state.WorkspaceID.ValueString(), state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Workspace Run",
+				fmt.Sprintf("Could not find the task that started run %s of workspace ID %s: %s", state.ID.ValueString(), state.WorkspaceID.ValueString(), err),
+			)
+			return
+		}
+		state.TaskType = types.StringValue(task.TaskType)
+		state.TaskID = types.StringValue(task.TaskID)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only stores the new timeout, every other change starts a new run
+func (r *workflowsRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WorkflowsRunResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the run from the Terraform state. Runs can't be deleted from Firefly.
+func (r *workflowsRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkflowsRunResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing workspace run from state", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+}
+
+// ImportState imports a run using the workspace_id:run_id format. Read fills in task_type and
+// task_id from the task that started the run.
+func (r *workflowsRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID must be in the format: workspace_id:run_id",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("run_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout_minutes"), int64(defaultRunTimeoutMinutes))...)
+}
+
+// findRunTask returns the plan or apply task that started a run of the workspace
+func (r *workflowsRunResource) findRunTask(ctx context.Context, workspaceID, runID string) (*client.TaskResponse, error) {
+	tasks, err := r.client.RunnersWorkspaces().ListTasks(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.RunID == runID && (task.TaskType == client.TaskTypePlan || task.TaskType == client.TaskTypeApply) {
+			return &task, nil
+		}
+	}
+	return nil, client.ErrNotFound
+}
+
+// updateWorkflowsRunModel copies the run returned by the API to the model
+func updateWorkflowsRunModel(model *WorkflowsRunResourceModel, run *client.WorkspaceRun) {
+	if run.RunID != "" {
+		model.RunID = types.StringValue(run.RunID)
+	}
+	model.Status = types.StringValue(run.Status)
+	model.Branch = types.StringValue(run.Branch)
+	model.CommitID = types.StringValue(run.CommitID)
+	model.CommitURL = types.StringValue(run.CommitURL)
+	model.BuildURL = types.StringValue(run.BuildURL)
+}
@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWorkflowsRunResource_basic(t *testing.T) {
+	fastPolling(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowsRunResourceConfig("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_workflows_run.test", "task_type", "apply"),
+					resource.TestCheckResourceAttr("firefly_workflows_run.test", "status", "apply_success"),
+					resource.TestCheckResourceAttrSet("firefly_workflows_run.test", "run_id"),
+					resource.TestCheckResourceAttrSet("firefly_workflows_run.test", "commit_id"),
+					resource.TestCheckResourceAttrSet("firefly_workflows_run.test", "build_url"),
+					resource.TestCheckResourceAttrPair("firefly_workflows_run.test", "workspace_id", "firefly_workflows_runners_workspace.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "firefly_workflows_run.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					run := state.RootModule().Resources["firefly_workflows_run.test"].Primary
+					return run.Attributes["workspace_id"] + ":" + run.ID, nil
+				},
+				ImportStateVerifyIgnore: []string{"triggers", "timeout_minutes"},
+			},
+			// Changing the triggers starts a new run
+			{
+				Config: testAccWorkflowsRunResourceConfig("v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_workflows_run.test", "triggers.version", "v2"),
+					resource.TestCheckResourceAttr("firefly_workflows_run.test", "status", "apply_success"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowsRunResourceConfig(version string) string {
+	return fmt.Sprintf(`
+resource "firefly_workflows_runners_workspace" "test" {
+  name               = "workspace-with-runs"
+  repository         = "myorg/infrastructure"
+  vcs_integration_id = "test-vcs-integration-id"
+  vcs_type           = "github"
+  default_branch     = "main"
+  iac_type           = "terraform"
+  terraform_version  = "1.6.0"
+  apply_rule         = "auto"
+  triggers           = ["merge"]
+}
+
+resource "firefly_workflows_run" "test" {
+  workspace_id    = firefly_workflows_runners_workspace.test.id
+  timeout_minutes = 5
+
+  triggers = {
+    version = %[1]q
+  }
+}
+`, version)
+}
+
+// fastPolling shortens the time between two status checks of a run or a task for the duration of the test
+func fastPolling(t *testing.T) {
+	opts := pollOptions
+	pollOptions = client.WaitOptions{InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+	t.Cleanup(func() { pollOptions = opts })
+}
+
+// testWorkflowsRunModel returns the model of a run to start
+func testWorkflowsRunModel() *WorkflowsRunResourceModel {
+	return &WorkflowsRunResourceModel{
+		ID:             types.StringUnknown(),
+		WorkspaceID:    types.StringValue("workspace-1"),
+		TaskType:       types.StringValue(client.TaskTypeApply),
+		Triggers:       types.MapValueMust(types.StringType, map[string]attr.Value{"version": types.StringValue("v1")}),
+		TimeoutMinutes: types.Int64Value(5),
+		TaskID:         types.StringUnknown(),
+		RunID:          types.StringUnknown(),
+		Status:         types.StringUnknown(),
+		Branch:         types.StringUnknown(),
+		CommitID:       types.StringUnknown(),
+		CommitURL:      types.StringUnknown(),
+		BuildURL:       types.StringUnknown(),
+	}
+}
+
+// mockRunStatuses makes the mock return a run going through statuses, one per poll
+func mockRunStatuses(api *mockAPI, statuses ...client.WorkspaceRunStatusEnum) *int {
+	polls := 0
+	api.runnersWorkspaces.runWorkspaceTask = func(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error) {
+		return &client.TaskResponse{TaskID: "task-1", Status: "queued", RunID: "run-1"}, nil
+	}
+	api.runnersWorkspaces.getWorkspaceRun = func(ctx context.Context, workspaceID, runID string) (*client.WorkspaceRun, error) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+		return &client.WorkspaceRun{
+			ID:          runID,
+			WorkspaceID: workspaceID,
+			RunID:       runID,
+			Status:      string(status),
+			Branch:      "main",
+			CommitID:    "abc123",
+			CommitURL:   "https://github.com/org/network/commit/abc123",
+			BuildURL:    "https://app.firefly.ai/workflows/runs/run-1",
+		}, nil
+	}
+	return &polls
+}
+
+func TestWorkflowsRunResource_Create(t *testing.T) {
+	fastPolling(t)
+
+	var started client.RunTaskRequest
+	api := &mockAPI{}
+	polls := mockRunStatuses(api, client.WorkspaceRunStatusPlanning, client.WorkspaceRunStatusPlanSuccess, client.WorkspaceRunStatusApplying, client.WorkspaceRunStatusApplySuccess)
+	api.runnersWorkspaces.runWorkspaceTask = func(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error) {
+		if id != "workspace-1" {
+			t.Errorf("Expected workspace-1, got %s", id)
+		}
+		started = req
+		return &client.TaskResponse{TaskID: "task-1", Status: "queued", RunID: "run-1"}, nil
+	}
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	var state WorkflowsRunResourceModel
+	testCreate(t, r, testWorkflowsRunModel(), &state)
+
+	if started.TaskType != client.TaskTypeApply {
+		t.Errorf("Expected an apply task, got %s", started.TaskType)
+	}
+	// An apply run goes on after plan_success
+	if *polls != 4 {
+		t.Errorf("Expected 4 polls, got %d", *polls)
+	}
+	if state.ID.ValueString() != "run-1" || state.RunID.ValueString() != "run-1" || state.TaskID.ValueString() != "task-1" {
+		t.Errorf("Unexpected id %s, run_id %s or task_id %s", state.ID, state.RunID, state.TaskID)
+	}
+	if state.Status.ValueString() != "apply_success" {
+		t.Errorf("Expected status apply_success, got %s", state.Status)
+	}
+	if state.CommitID.ValueString() != "abc123" || state.BuildURL.ValueString() != "https://app.firefly.ai/workflows/runs/run-1" {
+		t.Errorf("Unexpected commit_id %s or build_url %s", state.CommitID, state.BuildURL)
+	}
+}
+
+func TestWorkflowsRunResource_CreateFailed(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	mockRunStatuses(api, client.WorkspaceRunStatusPlanning, client.WorkspaceRunStatusPlanError)
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testWorkflowsRunModel())
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a failed run")
+	}
+	if !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "plan_error") {
+		t.Errorf("Expected the error to mention the status, got %v", resp.Diagnostics)
+	}
+
+	// The run is kept in the state, so the tainted resource starts a new run on the next apply
+	var state WorkflowsRunResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if state.RunID.ValueString() != "run-1" || state.Status.ValueString() != "plan_error" {
+		t.Errorf("Unexpected run_id %s or status %s", state.RunID, state.Status)
+	}
+}
+
+func TestWorkflowsRunResource_CreateBlocked(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	mockRunStatuses(api, client.WorkspaceRunStatusPlanning, client.WorkspaceRunStatusBlocked)
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testWorkflowsRunModel())
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create failed: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a warning for a blocked run, got %v", resp.Diagnostics)
+	}
+}
+
+func TestWorkflowsRunResource_CreateWaitError(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	polls := mockRunStatuses(api, client.WorkspaceRunStatusPlanning)
+	getRun := api.runnersWorkspaces.getWorkspaceRun
+	api.runnersWorkspaces.getWorkspaceRun = func(ctx context.Context, workspaceID, runID string) (*client.WorkspaceRun, error) {
+		if *polls == 1 {
+			return nil, &client.APIError{Operation: "get workspace run", StatusCode: 403, Message: "forbidden"}
+		}
+		return getRun(ctx, workspaceID, runID)
+	}
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testWorkflowsRunModel())
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error when the run can't be read")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "forbidden") {
+		t.Errorf("Expected the error to mention the cause, got %s", detail)
+	}
+
+	// The status of the last poll is kept
+	var state WorkflowsRunResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if state.RunID.ValueString() != "run-1" || state.Status.ValueString() != "planning" {
+		t.Errorf("Unexpected run_id %s or status %s", state.RunID, state.Status)
+	}
+}
+
+func TestWorkflowsRunResource_CreateRunUnreadable(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	mockRunStatuses(api, client.WorkspaceRunStatusPlanning)
+	api.runnersWorkspaces.getWorkspaceRun = func(ctx context.Context, workspaceID, runID string) (*client.WorkspaceRun, error) {
+		return nil, &client.APIError{Operation: "get workspace run", StatusCode: 403, Message: "forbidden"}
+	}
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	resp := runCreate(t, r, testWorkflowsRunModel())
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error when the run can't be read")
+	}
+
+	// The queued status of the task isn't saved as the status of the run
+	var state WorkflowsRunResourceModel
+	if diags := resp.State.Get(context.Background(), &state); diags.HasError() {
+		t.Fatalf("Failed to decode state: %v", diags)
+	}
+	if state.RunID.ValueString() != "run-1" || !state.Status.IsNull() {
+		t.Errorf("Unexpected run_id %s or status %s", state.RunID, state.Status)
+	}
+}
+
+func TestDescribeWaitError(t *testing.T) {
+	timeout := fmt.Errorf("%w: %w", client.ErrWaitTimeout, context.DeadlineExceeded)
+	if got := describeWaitError(timeout, 5*time.Minute, "applying"); got != "timed out after 5m0s, last status was applying" {
+		t.Errorf("Unexpected description %q", got)
+	}
+	if got := describeWaitError(timeout, 5*time.Minute, ""); got != "timed out after 5m0s" {
+		t.Errorf("Unexpected description %q", got)
+	}
+	if got := describeWaitError(fmt.Errorf("boom"), 5*time.Minute, "applying"); got != "boom" {
+		t.Errorf("Unexpected description %q", got)
+	}
+}
+
+func TestWorkflowsRunResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	mockRunStatuses(api, client.WorkspaceRunStatusApplySuccess)
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	model := testWorkflowsRunModel()
+	model.ID = types.StringValue("run-1")
+	model.TaskID = types.StringValue("task-1")
+	model.RunID = types.StringValue("run-1")
+	model.Status = types.StringValue("blocked")
+	model.Branch = types.StringValue("")
+	model.CommitID = types.StringValue("")
+	model.CommitURL = types.StringValue("")
+	model.BuildURL = types.StringValue("")
+
+	var state WorkflowsRunResourceModel
+	if !testRead(t, r, model, &state) {
+		t.Fatal("Expected the run to remain in the state")
+	}
+
+	if state.Status.ValueString() != "apply_success" || state.CommitID.ValueString() != "abc123" {
+		t.Errorf("Unexpected status %s or commit_id %s", state.Status, state.CommitID)
+	}
+}
+
+func TestWorkflowsRunResource_ReadImported(t *testing.T) {
+	api := &mockAPI{}
+	mockRunStatuses(api, client.WorkspaceRunStatusPlanSuccess)
+	api.runnersWorkspaces.listTasks = func(ctx context.Context, workspaceID string) ([]client.TaskResponse, error) {
+		return []client.TaskResponse{
+			{TaskID: "task-3", TaskType: "destroy", WorkspaceID: workspaceID},
+			{TaskID: "task-2", TaskType: client.TaskTypeApply, RunID: "run-2", WorkspaceID: workspaceID},
+			{TaskID: "task-1", TaskType: client.TaskTypePlan, RunID: "run-1", WorkspaceID: workspaceID},
+		}, nil
+	}
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	// The attributes set by ImportState
+	model := testWorkflowsRunModel()
+	model.ID = types.StringValue("run-1")
+	model.RunID = types.StringValue("run-1")
+	model.TaskType = types.StringNull()
+	model.TaskID = types.StringNull()
+	model.Triggers = types.MapNull(types.StringType)
+	model.TimeoutMinutes = types.Int64Value(defaultRunTimeoutMinutes)
+
+	var state WorkflowsRunResourceModel
+	if !testRead(t, r, model, &state) {
+		t.Fatal("Expected the run to remain in the state")
+	}
+
+	if state.TaskType.ValueString() != client.TaskTypePlan || state.TaskID.ValueString() != "task-1" {
+		t.Errorf("Unexpected task_type %s or task_id %s", state.TaskType, state.TaskID)
+	}
+	if state.Status.ValueString() != "plan_success" {
+		t.Errorf("Expected status plan_success, got %s", state.Status)
+	}
+}
+
+func TestWorkflowsRunResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.runnersWorkspaces.getWorkspaceRun = func(ctx context.Context, workspaceID, runID string) (*client.WorkspaceRun, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewWorkflowsRunResource()
+	configureResource(t, r, api)
+
+	model := testWorkflowsRunModel()
+	model.ID = types.StringValue("run-1")
+
+	var state WorkflowsRunResourceModel
+	if testRead(t, r, model, &state) {
+		t.Error("Expected the run to be removed from the state")
+	}
+}
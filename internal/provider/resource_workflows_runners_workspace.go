@@ -5,11 +5,16 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -85,7 +90,7 @@ func NewRunnersWorkspaceResource() resource.Resource {
 
 // runnersWorkspaceResource is the resource implementation
 type runnersWorkspaceResource struct {
-	client *client.Client
+	client client.API
 }
 
 // RunnersWorkspaceResourceModel describes the resource data model
@@ -108,6 +113,14 @@ type RunnersWorkspaceResourceModel struct {
 	ConsumedVariableSets types.List   `tfsdk:"consumed_variable_sets"`
 	ProjectID            types.String `tfsdk:"project_id"`
 	AccountID            types.String `tfsdk:"account_id"`
+
+	TerraformVariables          types.Dynamic `tfsdk:"terraform_variables"`
+	TerraformSensitiveVariables types.Dynamic `tfsdk:"terraform_sensitive_variables"`
+	ProvidersCredentials        types.Dynamic `tfsdk:"providers_credentials"`
+	RunnerEnvironment           types.Dynamic `tfsdk:"runner_environment"`
+
+	DestroyResourcesOnDelete types.Bool  `tfsdk:"destroy_resources_on_delete"`
+	DestroyTimeoutMinutes    types.Int64 `tfsdk:"destroy_timeout_minutes"`
 }
 
 // IacProvisionerModel describes the IaC provisioner
@@ -232,6 +245,39 @@ func (r *runnersWorkspaceResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"terraform_variables": schema.DynamicAttribute{
+				Description: "Terraform input variables of the workspace, as an object whose values keep their type (string, number, bool, list or object)",
+				Optional:    true,
+			},
+			"terraform_sensitive_variables": schema.DynamicAttribute{
+				Description: "Sensitive Terraform input variables of the workspace, as an object. The API doesn't return them, so changes made outside Terraform are not detected",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"providers_credentials": schema.DynamicAttribute{
+				Description: "Credentials of the Terraform providers used by the workspace, as an object keyed by provider. The API doesn't return them, so changes made outside Terraform are not detected",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"runner_environment": schema.DynamicAttribute{
+				Description: "Configuration of the environment the runner executes in, as an object",
+				Optional:    true,
+			},
+			"destroy_resources_on_delete": schema.BoolAttribute{
+				Description: "Run a destroy task to tear down the infrastructure managed by the workspace before deleting it. Defaults to `false`, which leaves the infrastructure running.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"destroy_timeout_minutes": schema.Int64Attribute{
+				Description: fmt.Sprintf("Time limit in minutes for the destroy task run on delete. Defaults to %d.", defaultRunTimeoutMinutes),
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultRunTimeoutMinutes),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"variables": schema.ListNestedBlock{
@@ -272,11 +318,11 @@ func (r *runnersWorkspaceResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -351,6 +397,12 @@ func (r *runnersWorkspaceResource) Create(ctx context.Context, req resource.Crea
 		projectID = &pid
 	}
 
+	maps, diags := runnersWorkspaceMaps(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the workspace
 	createReq := client.CreateRunnersWorkspaceRequest{
 		RunnerType:           "firefly",
@@ -370,14 +422,18 @@ func (r *runnersWorkspaceResource) Create(ctx context.Context, req resource.Crea
 			ApplyRule:        plan.ApplyRule.ValueString(),
 			TerraformVersion: plan.TerraformVersion.ValueString(),
 		},
-		Project: projectID,
+		Project:                     projectID,
+		TerraformVariables:          maps.terraformVariables,
+		TerraformSensitiveVariables: maps.terraformSensitiveVariables,
+		ProvidersCredentials:        maps.providersCredentials,
+		RunnerEnvironment:           maps.runnerEnvironment,
 	}
 
 	tflog.Debug(ctx, "Creating runners workspace", map[string]interface{}{
 		"name": createReq.WorkspaceName,
 	})
 
-	workspace, err := r.client.RunnersWorkspaces.CreateRunnersWorkspace(createReq)
+	workspace, err := r.client.RunnersWorkspaces().CreateRunnersWorkspace(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Runners Workspace",
@@ -411,10 +467,10 @@ func (r *runnersWorkspaceResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get workspace from API
-	workspace, err := r.client.RunnersWorkspaces.GetRunnersWorkspace(state.ID.ValueString())
+	workspace, err := r.client.RunnersWorkspaces().GetRunnersWorkspace(ctx, state.ID.ValueString())
 	if err != nil {
 		// Check if the error is a genuine 404 (workspace deleted)
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if client.IsNotFound(err) {
 			tflog.Warn(ctx, "Runners workspace not found, removing from state", map[string]interface{}{
 				"id": state.ID.ValueString(),
 			})
@@ -470,6 +526,38 @@ func (r *runnersWorkspaceResource) Read(ctx context.Context, req resource.ReadRe
 	// If API doesn't return projectId, preserve the existing value from state
 	// This prevents losing the project relationship that was set during creation
 
+	// Refresh the non-sensitive maps when the API returns them, sensitive ones are never returned.
+	// A map that isn't managed stays null, so values set in the UI aren't pulled into the state and
+	// cleared by the next update.
+	if workspace.TerraformVariables != nil && !state.TerraformVariables.IsNull() {
+		state.TerraformVariables, err = refreshDynamic(state.TerraformVariables, workspace.TerraformVariables)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Runners Workspace",
+				fmt.Sprintf("Could not convert terraform_variables: %s", err),
+			)
+			return
+		}
+	}
+	if workspace.RunnerEnvironment != nil && !state.RunnerEnvironment.IsNull() {
+		state.RunnerEnvironment, err = refreshDynamic(state.RunnerEnvironment, workspace.RunnerEnvironment)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Runners Workspace",
+				fmt.Sprintf("Could not convert runner_environment: %s", err),
+			)
+			return
+		}
+	}
+
+	// The delete settings aren't stored by the API, imported workspaces get the defaults
+	if state.DestroyResourcesOnDelete.IsNull() {
+		state.DestroyResourcesOnDelete = types.BoolValue(false)
+	}
+	if state.DestroyTimeoutMinutes.IsNull() {
+		state.DestroyTimeoutMinutes = types.Int64Value(defaultRunTimeoutMinutes)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -485,6 +573,14 @@ func (r *runnersWorkspaceResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	// The prior state tells which maps were removed from the configuration
+	var state RunnersWorkspaceResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert labels to string slice
 	var labels []string
 	if !plan.Labels.IsNull() && !plan.Labels.IsUnknown() {
@@ -525,6 +621,12 @@ func (r *runnersWorkspaceResource) Update(ctx context.Context, req resource.Upda
 		}
 	}
 
+	maps, diags := runnersWorkspaceMaps(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update the workspace
 	updateReq := client.UpdateRunnersWorkspaceRequest{
 		Name:                 plan.Name.ValueString(),
@@ -539,8 +641,12 @@ func (r *runnersWorkspaceResource) Update(ctx context.Context, req resource.Upda
 			Type:    plan.IacType.ValueString(),
 			Version: plan.TerraformVersion.ValueString(),
 		},
-		Variables:            variables,
-		ConsumedVariableSets: consumedVariableSets,
+		Variables:                   variables,
+		ConsumedVariableSets:        consumedVariableSets,
+		TerraformVariables:          updatedMap(maps.terraformVariables, state.TerraformVariables),
+		TerraformSensitiveVariables: updatedMap(maps.terraformSensitiveVariables, state.TerraformSensitiveVariables),
+		ProvidersCredentials:        updatedMap(maps.providersCredentials, state.ProvidersCredentials),
+		RunnerEnvironment:           updatedMap(maps.runnerEnvironment, state.RunnerEnvironment),
 	}
 
 	tflog.Debug(ctx, "Updating runners workspace", map[string]interface{}{
@@ -548,7 +654,7 @@ func (r *runnersWorkspaceResource) Update(ctx context.Context, req resource.Upda
 		"name": updateReq.Name,
 	})
 
-	workspace, err := r.client.RunnersWorkspaces.UpdateRunnersWorkspace(plan.ID.ValueString(), updateReq)
+	workspace, err := r.client.RunnersWorkspaces().UpdateRunnersWorkspace(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Runners Workspace",
@@ -580,13 +686,20 @@ func (r *runnersWorkspaceResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	// Tear down the infrastructure first, the workspace is kept if that fails so the delete can be retried
+	if state.DestroyResourcesOnDelete.ValueBool() {
+		if !r.destroyResources(ctx, state, &resp.Diagnostics) {
+			return
+		}
+	}
+
 	// Delete workspace
 	tflog.Debug(ctx, "Deleting runners workspace", map[string]interface{}{
 		"id": state.ID.ValueString(),
 	})
 
-	err := r.client.RunnersWorkspaces.DeleteRunnersWorkspace(state.ID.ValueString())
-	if err != nil {
+	err := r.client.RunnersWorkspaces().DeleteRunnersWorkspace(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Deleting Runners Workspace",
 			fmt.Sprintf("Could not delete runners workspace ID %s: %s", state.ID.ValueString(), err),
@@ -595,6 +708,104 @@ func (r *runnersWorkspaceResource) Delete(ctx context.Context, req resource.Dele
 	}
 }
 
+// destroyResources runs a destroy task on the workspace and waits for it to complete. It reports
+// whether the workspace can be deleted, adding the reason to diags when it can't.
+func (r *runnersWorkspaceResource) destroyResources(ctx context.Context, state RunnersWorkspaceResourceModel, diags *diag.Diagnostics) bool {
+	id := state.ID.ValueString()
+
+	tflog.Info(ctx, "Destroying the resources of runners workspace", map[string]interface{}{
+		"id": id,
+	})
+
+	task, err := r.client.RunnersWorkspaces().DestroyWorkspaceResources(ctx, id, client.RunTaskRequest{TaskType: "destroy"})
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Nothing left to destroy or delete
+			return true
+		}
+		diags.AddError(
+			"Error Destroying Workspace Resources",
+			fmt.Sprintf("Could not start the destroy task of runners workspace ID %s: %s", id, err),
+		)
+		return false
+	}
+
+	timeout := time.Duration(state.DestroyTimeoutMinutes.ValueInt64()) * time.Minute
+	taskID, lastStatus := task.TaskID, task.Status
+	task, err = client.WaitFor(ctx, client.TaskPoller(r.client.RunnersWorkspaces(), id, taskID), waitOptions(timeout))
+	if task != nil {
+		lastStatus = task.Status
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Destroying Workspace Resources",
+			fmt.Sprintf("Destroy task %s of runners workspace ID %s didn't complete, the workspace was not deleted: %s", taskID, id, describeWaitError(err, timeout, lastStatus)),
+		)
+		return false
+	}
+	if task.Status != client.TaskStatusCompleted {
+		diags.AddError(
+			"Error Destroying Workspace Resources",
+			fmt.Sprintf("Destroy task %s of runners workspace ID %s ended with status %s, the workspace was not deleted. "+
+				"Fix the issue and run terraform destroy again, or set destroy_resources_on_delete to false to delete the workspace only.", task.TaskID, id, task.Status),
+		)
+		return false
+	}
+
+	return true
+}
+
+// runnersWorkspaceRequestMaps holds the map fields of the create and update requests
+type runnersWorkspaceRequestMaps struct {
+	terraformVariables          map[string]interface{}
+	terraformSensitiveVariables map[string]interface{}
+	providersCredentials        map[string]interface{}
+	runnerEnvironment           map[string]interface{}
+}
+
+// runnersWorkspaceMaps converts the dynamic attributes of the plan to the maps of the API requests
+func runnersWorkspaceMaps(plan RunnersWorkspaceResourceModel) (runnersWorkspaceRequestMaps, diag.Diagnostics) {
+	var maps runnersWorkspaceRequestMaps
+	var diags diag.Diagnostics
+
+	for _, attribute := range []struct {
+		name   string
+		value  types.Dynamic
+		target *map[string]interface{}
+	}{
+		{"terraform_variables", plan.TerraformVariables, &maps.terraformVariables},
+		{"terraform_sensitive_variables", plan.TerraformSensitiveVariables, &maps.terraformSensitiveVariables},
+		{"providers_credentials", plan.ProvidersCredentials, &maps.providersCredentials},
+		{"runner_environment", plan.RunnerEnvironment, &maps.runnerEnvironment},
+	} {
+		m, err := dynamicToMap(attribute.value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Could not convert %s: %s", attribute.name, err),
+			)
+			continue
+		}
+		*attribute.target = m
+	}
+
+	return maps, diags
+}
+
+// updatedMap returns the map to send in an update request. An attribute that isn't configured is
+// left out, so the values attached in the UI are kept, unless it was removed from the configuration:
+// an empty map then clears the values the provider had set.
+func updatedMap(planned map[string]interface{}, prior types.Dynamic) *map[string]interface{} {
+	if planned != nil {
+		return &planned
+	}
+	if prior.IsNull() || prior.IsUnderlyingValueNull() {
+		return nil
+	}
+	return &map[string]interface{}{}
+}
+
 // ImportState imports a resource by ID
 func (r *runnersWorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Save the import ID as the resource ID
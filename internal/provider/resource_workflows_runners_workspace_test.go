@@ -1,9 +1,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -104,6 +112,48 @@ func TestAccRunnersWorkspaceResource_withVariableSets(t *testing.T) {
 	})
 }
 
+func TestAccRunnersWorkspaceResource_withTerraformVariables(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunnersWorkspaceResourceWithTerraformVariablesConfig("us-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "terraform_variables.region", "us-east-1"),
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "terraform_variables.replicas", "3"),
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "terraform_variables.zones.#", "2"),
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "runner_environment.image", "custom"),
+				),
+			},
+			{
+				Config: testAccRunnersWorkspaceResourceWithTerraformVariablesConfig("eu-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "terraform_variables.region", "eu-west-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRunnersWorkspaceResource_destroyResourcesOnDelete(t *testing.T) {
+	fastPolling(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunnersWorkspaceResourceDestroyOnDeleteConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "destroy_resources_on_delete", "true"),
+					resource.TestCheckResourceAttr("firefly_workflows_runners_workspace.test", "destroy_timeout_minutes", "5"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRunnersWorkspaceResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "firefly_workflows_runners_workspace" "test" {
@@ -210,4 +260,396 @@ resource "firefly_workflows_runners_workspace" "test" {
   consumed_variable_sets = [firefly_variable_set.test.id]
 }
 `
-}
\ No newline at end of file
+}
+
+// testRunnersWorkspaceModel returns a workspace model with every list set, as the plan of a basic configuration
+func testRunnersWorkspaceModel() *RunnersWorkspaceResourceModel {
+	return &RunnersWorkspaceResourceModel{
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("network"),
+		Description:          types.StringValue("Network workspace"),
+		Repository:           types.StringValue("org/network"),
+		WorkingDirectory:     types.StringValue("envs/prod"),
+		VcsIntegrationID:     types.StringValue("vcs-1"),
+		VcsType:              types.StringValue("github"),
+		DefaultBranch:        types.StringValue("main"),
+		CronExecutionPattern: types.StringNull(),
+		IacType:              types.StringValue("terraform"),
+		TerraformVersion:     types.StringValue("1.6.0"),
+		ApplyRule:            types.StringValue("manual"),
+		Triggers:             testStrings("merge"),
+		Labels:               testStrings("network"),
+		Variables:            testVariables(client.Variable{Key: "TF_LOG", Value: "INFO", Sensitivity: client.SensitivityString, Destination: client.DestinationEnv}),
+		ConsumedVariableSets: types.ListNull(types.StringType),
+		ProjectID:            types.StringValue("project-1"),
+		AccountID:            types.StringUnknown(),
+		TerraformVariables: types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"region": types.StringType, "replicas": types.NumberType},
+			map[string]attr.Value{"region": types.StringValue("us-east-1"), "replicas": types.NumberValue(big.NewFloat(3))},
+		)),
+		TerraformSensitiveVariables: types.DynamicNull(),
+		ProvidersCredentials: types.DynamicValue(types.ObjectValueMust(
+			map[string]attr.Type{"aws": types.MapType{ElemType: types.StringType}},
+			map[string]attr.Value{"aws": types.MapValueMust(types.StringType, map[string]attr.Value{"role_arn": types.StringValue("arn:aws:iam::123456789012:role/firefly")})},
+		)),
+		RunnerEnvironment:        types.DynamicNull(),
+		DestroyResourcesOnDelete: types.BoolValue(false),
+		DestroyTimeoutMinutes:    types.Int64Value(5),
+	}
+}
+
+func TestRunnersWorkspaceResource_Create(t *testing.T) {
+	var created client.CreateRunnersWorkspaceRequest
+	api := &mockAPI{}
+	api.runnersWorkspaces.createRunnersWorkspace = func(ctx context.Context, req client.CreateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error) {
+		created = req
+		return &client.RunnersWorkspace{ID: "workspace-1", AccountID: "account-1"}, nil
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	var state RunnersWorkspaceResourceModel
+	testCreate(t, r, testRunnersWorkspaceModel(), &state)
+
+	projectID := "project-1"
+	expected := client.CreateRunnersWorkspaceRequest{
+		RunnerType:    "firefly",
+		IacType:       "terraform",
+		WorkspaceName: "network",
+		Description:   "Network workspace",
+		Labels:        []string{"network"},
+		VcsID:         "vcs-1",
+		Repo:          "org/network",
+		DefaultBranch: "main",
+		VcsType:       "github",
+		WorkDir:       "envs/prod",
+		Variables:     []client.Variable{{Key: "TF_LOG", Value: "INFO", Sensitivity: client.SensitivityString, Destination: client.DestinationEnv}},
+		Execution: client.ExecutionConfig{
+			Triggers:         []string{"merge"},
+			ApplyRule:        "manual",
+			TerraformVersion: "1.6.0",
+		},
+		Project:              &projectID,
+		TerraformVariables:   map[string]interface{}{"region": "us-east-1", "replicas": int64(3)},
+		ProvidersCredentials: map[string]interface{}{"aws": map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/firefly"}},
+	}
+	if !reflect.DeepEqual(created, expected) {
+		t.Errorf("Expected request %+v, got %+v", expected, created)
+	}
+
+	if state.ID.ValueString() != "workspace-1" || state.AccountID.ValueString() != "account-1" {
+		t.Errorf("Unexpected ID %s or account ID %s", state.ID, state.AccountID)
+	}
+	if !state.ConsumedVariableSets.Equal(testStrings()) {
+		t.Errorf("Expected empty consumed_variable_sets, got %s", state.ConsumedVariableSets)
+	}
+}
+
+func TestRunnersWorkspaceResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.runnersWorkspaces.getRunnersWorkspace = func(ctx context.Context, id string) (*client.RunnersWorkspace, error) {
+		return &client.RunnersWorkspace{
+			ID:               id,
+			Name:             "network",
+			Description:      "Network workspace",
+			AccountID:        "account-1",
+			Repository:       "org/network",
+			WorkingDirectory: "envs/prod",
+			VcsIntegrationID: "vcs-1",
+			Vcs:              "gitlab",
+			DefaultBranch:    "develop",
+			IacProvisioner:   &client.IacProvisioner{Type: "opentofu", Version: "1.7.0"},
+			Labels:           []string{"network", "prod"},
+			// JSON numbers are decoded as float64
+			TerraformVariables: map[string]interface{}{"region": "us-east-1", "replicas": float64(3)},
+			RunnerEnvironment:  map[string]interface{}{"image": "custom"},
+		}, nil
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	model := testRunnersWorkspaceModel()
+	model.ID = types.StringValue("workspace-1")
+	model.AccountID = types.StringValue("account-1")
+	model.RunnerEnvironment, _ = mapToDynamic(map[string]interface{}{"image": "default"})
+
+	var state RunnersWorkspaceResourceModel
+	if !testRead(t, r, model, &state) {
+		t.Fatal("Expected the workspace to remain in the state")
+	}
+
+	if state.VcsType.ValueString() != "gitlab" || state.DefaultBranch.ValueString() != "develop" {
+		t.Errorf("Unexpected vcs_type %s or default_branch %s", state.VcsType, state.DefaultBranch)
+	}
+	if state.IacType.ValueString() != "opentofu" || state.TerraformVersion.ValueString() != "1.7.0" {
+		t.Errorf("Unexpected iac_type %s or terraform_version %s", state.IacType, state.TerraformVersion)
+	}
+	if !state.Labels.Equal(testStrings("network", "prod")) {
+		t.Errorf("Unexpected labels %s", state.Labels)
+	}
+	if state.ProjectID.ValueString() != "project-1" {
+		t.Errorf("Expected project_id to be kept from the state, got %s", state.ProjectID)
+	}
+	if !state.TerraformVariables.Equal(model.TerraformVariables) {
+		t.Errorf("Expected unchanged terraform_variables to be kept, got %s", state.TerraformVariables)
+	}
+	if expected, _ := mapToDynamic(map[string]interface{}{"image": "custom"}); !state.RunnerEnvironment.Equal(expected) {
+		t.Errorf("Expected runner_environment %s, got %s", expected, state.RunnerEnvironment)
+	}
+	if !state.ProvidersCredentials.Equal(model.ProvidersCredentials) {
+		t.Errorf("Expected providers_credentials to be kept from the state, got %s", state.ProvidersCredentials)
+	}
+}
+
+func TestRunnersWorkspaceResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.runnersWorkspaces.getRunnersWorkspace = func(ctx context.Context, id string) (*client.RunnersWorkspace, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	model := testRunnersWorkspaceModel()
+	model.ID = types.StringValue("workspace-1")
+
+	var state RunnersWorkspaceResourceModel
+	if testRead(t, r, model, &state) {
+		t.Error("Expected the workspace to be removed from the state")
+	}
+}
+
+func TestRunnersWorkspaceResource_UnmanagedMapsKept(t *testing.T) {
+	var body map[string]json.RawMessage
+	api := &mockAPI{}
+	api.runnersWorkspaces.getRunnersWorkspace = func(ctx context.Context, id string) (*client.RunnersWorkspace, error) {
+		return &client.RunnersWorkspace{
+			ID:                 id,
+			Name:               "network",
+			AccountID:          "account-1",
+			TerraformVariables: map[string]interface{}{"region": "us-east-1", "replicas": float64(3)},
+			// Set in the UI, the configuration doesn't declare runner_environment
+			RunnerEnvironment: map[string]interface{}{"image": "custom"},
+		}, nil
+	}
+	api.runnersWorkspaces.updateRunnersWorkspace = func(ctx context.Context, id string, req client.UpdateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error) {
+		encoded, err := json.Marshal(req)
+		if err != nil {
+			t.Fatalf("Failed to encode the request: %v", err)
+		}
+		if err := json.Unmarshal(encoded, &body); err != nil {
+			t.Fatalf("Failed to decode the request: %v", err)
+		}
+		return &client.RunnersWorkspace{ID: id, AccountID: "account-1"}, nil
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	model := deleteRunnersWorkspaceModel(false)
+
+	var state RunnersWorkspaceResourceModel
+	if !testRead(t, r, model, &state) {
+		t.Fatal("Expected the workspace to remain in the state")
+	}
+	if !state.RunnerEnvironment.IsNull() {
+		t.Fatalf("Expected the unmanaged runner_environment to stay null, got %s", state.RunnerEnvironment)
+	}
+
+	// The next apply of the same configuration leaves the values set in the UI alone
+	plan := deleteRunnersWorkspaceModel(false)
+	plan.Description = types.StringValue("Updated network workspace")
+	resp := runUpdate(t, r, plan, &state)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update failed: %v", resp.Diagnostics)
+	}
+	if value, ok := body["runnerEnvironment"]; ok {
+		t.Errorf("Expected runnerEnvironment not to be sent, got %s", value)
+	}
+}
+
+func TestRunnersWorkspaceResource_UpdateMaps(t *testing.T) {
+	var body map[string]json.RawMessage
+	api := &mockAPI{}
+	api.runnersWorkspaces.updateRunnersWorkspace = func(ctx context.Context, id string, req client.UpdateRunnersWorkspaceRequest) (*client.RunnersWorkspace, error) {
+		encoded, err := json.Marshal(req)
+		if err != nil {
+			t.Fatalf("Failed to encode the request: %v", err)
+		}
+		body = nil
+		if err := json.Unmarshal(encoded, &body); err != nil {
+			t.Fatalf("Failed to decode the request: %v", err)
+		}
+		return &client.RunnersWorkspace{ID: id, AccountID: "account-1"}, nil
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	prior := deleteRunnersWorkspaceModel(false)
+
+	// Maps that aren't configured keep the values attached in the UI
+	plan := deleteRunnersWorkspaceModel(false)
+	plan.Description = types.StringValue("Updated network workspace")
+	resp := runUpdate(t, r, plan, prior)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update failed: %v", resp.Diagnostics)
+	}
+	if value := string(body["terraformVariables"]); value != `{"region":"us-east-1","replicas":3}` {
+		t.Errorf("Expected the configured terraformVariables, got %s", value)
+	}
+	for _, field := range []string{"terraformSensitiveVariables", "runnerEnvironment"} {
+		if value, ok := body[field]; ok {
+			t.Errorf("Expected %s not to be sent, got %s", field, value)
+		}
+	}
+
+	// A map removed from the configuration clears the values the provider had set
+	plan.ProvidersCredentials = types.DynamicNull()
+	resp = runUpdate(t, r, plan, prior)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update failed: %v", resp.Diagnostics)
+	}
+	if value := string(body["providersCredentials"]); value != `{}` {
+		t.Errorf("Expected providersCredentials to be cleared, got %s", value)
+	}
+}
+
+// deleteRunnersWorkspaceModel returns the state of a created workspace, with destroy_resources_on_delete set to destroy
+func deleteRunnersWorkspaceModel(destroy bool) *RunnersWorkspaceResourceModel {
+	model := testRunnersWorkspaceModel()
+	model.ID = types.StringValue("workspace-1")
+	model.AccountID = types.StringValue("account-1")
+	model.DestroyResourcesOnDelete = types.BoolValue(destroy)
+	return model
+}
+
+func TestRunnersWorkspaceResource_Delete(t *testing.T) {
+	var deleted string
+	api := &mockAPI{}
+	api.runnersWorkspaces.deleteRunnersWorkspace = func(ctx context.Context, id string) error {
+		deleted = id
+		return nil
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	// No destroy task is started by default
+	resp := runDelete(t, r, deleteRunnersWorkspaceModel(false))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete failed: %v", resp.Diagnostics)
+	}
+	if deleted != "workspace-1" {
+		t.Errorf("Expected workspace-1 to be deleted, got %q", deleted)
+	}
+}
+
+func TestRunnersWorkspaceResource_DeleteDestroysResources(t *testing.T) {
+	fastPolling(t)
+
+	var calls []string
+	statuses := []string{client.TaskStatusRunning, client.TaskStatusCompleted}
+	api := &mockAPI{}
+	api.runnersWorkspaces.destroyWorkspaceResources = func(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error) {
+		calls = append(calls, "destroy "+req.TaskType)
+		return &client.TaskResponse{TaskID: "task-1", Status: client.TaskStatusQueued}, nil
+	}
+	api.runnersWorkspaces.getTask = func(ctx context.Context, workspaceID, taskID string) (*client.TaskResponse, error) {
+		calls = append(calls, "get "+taskID)
+		status := statuses[0]
+		statuses = statuses[1:]
+		return &client.TaskResponse{TaskID: taskID, Status: status}, nil
+	}
+	api.runnersWorkspaces.deleteRunnersWorkspace = func(ctx context.Context, id string) error {
+		calls = append(calls, "delete "+id)
+		return nil
+	}
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	resp := runDelete(t, r, deleteRunnersWorkspaceModel(true))
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Delete failed: %v", resp.Diagnostics)
+	}
+
+	expected := []string{"destroy destroy", "get task-1", "get task-1", "delete workspace-1"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestRunnersWorkspaceResource_DeleteDestroyFailed(t *testing.T) {
+	fastPolling(t)
+
+	api := &mockAPI{}
+	api.runnersWorkspaces.destroyWorkspaceResources = func(ctx context.Context, id string, req client.RunTaskRequest) (*client.TaskResponse, error) {
+		return &client.TaskResponse{TaskID: "task-1", Status: client.TaskStatusQueued}, nil
+	}
+	api.runnersWorkspaces.getTask = func(ctx context.Context, workspaceID, taskID string) (*client.TaskResponse, error) {
+		return &client.TaskResponse{TaskID: taskID, Status: client.TaskStatusFailed}, nil
+	}
+	// deleteRunnersWorkspace isn't set, deleting the workspace fails the test
+
+	r := NewRunnersWorkspaceResource()
+	configureResource(t, r, api)
+
+	resp := runDelete(t, r, deleteRunnersWorkspaceModel(true))
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for a failed destroy task")
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "task-1") || !strings.Contains(detail, "failed") {
+		t.Errorf("Expected the error to mention the task and its status, got %s", detail)
+	}
+}
+
+func testAccRunnersWorkspaceResourceWithTerraformVariablesConfig(region string) string {
+	return fmt.Sprintf(`
+resource "firefly_workflows_runners_workspace" "test" {
+  name               = "workspace-with-tfvars"
+  repository         = "myorg/infrastructure"
+  vcs_integration_id = "test-vcs-integration-id"
+  vcs_type           = "github"
+  default_branch     = "main"
+
+  terraform_variables = {
+    region   = %[1]q
+    replicas = 3
+    zones    = ["a", "b"]
+  }
+
+  terraform_sensitive_variables = {
+    db_password = "not-a-real-password"
+  }
+
+  providers_credentials = {
+    aws = {
+      role_arn = "arn:aws:iam::123456789012:role/firefly"
+    }
+  }
+
+  runner_environment = {
+    image = "custom"
+  }
+}
+`, region)
+}
+
+func testAccRunnersWorkspaceResourceDestroyOnDeleteConfig() string {
+	return `
+resource "firefly_workflows_runners_workspace" "test" {
+  name               = "ephemeral-workspace"
+  repository         = "myorg/infrastructure"
+  vcs_integration_id = "test-vcs-integration-id"
+  vcs_type           = "github"
+  default_branch     = "main"
+
+  destroy_resources_on_delete = true
+  destroy_timeout_minutes     = 5
+}
+`
+}
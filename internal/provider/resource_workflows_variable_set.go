@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,7 +28,7 @@ func NewVariableSetResource() resource.Resource {
 }
 
 type variableSetResource struct {
-	client *client.Client
+	client client.API
 }
 
 type VariableSetResourceModel struct {
@@ -128,11 +127,11 @@ func (r *variableSetResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -195,7 +194,7 @@ func (r *variableSetResource) Create(ctx context.Context, req resource.CreateReq
 
 	tflog.Debug(ctx, "Creating variable set", map[string]interface{}{"name": createReq.Name})
 
-	createResp, err := r.client.VariableSets.CreateVariableSet(createReq)
+	createResp, err := r.client.VariableSets().CreateVariableSet(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Creating Variable Set", fmt.Sprintf("Could not create variable set: %s", err))
 		return
@@ -204,7 +203,7 @@ func (r *variableSetResource) Create(ctx context.Context, req resource.CreateReq
 	plan.ID = types.StringValue(createResp.VariableSetID)
 
 	// Fetch the created variable set to get computed fields
-	variableSet, err := r.client.VariableSets.GetVariableSet(createResp.VariableSetID)
+	variableSet, err := r.client.VariableSets().GetVariableSet(ctx, createResp.VariableSetID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading Variable Set", fmt.Sprintf("Could not read variable set after creation: %s", err))
 		return
@@ -248,10 +247,10 @@ func (r *variableSetResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	variableSet, err := r.client.VariableSets.GetVariableSet(state.ID.ValueString())
+	variableSet, err := r.client.VariableSets().GetVariableSet(ctx, state.ID.ValueString())
 	if err != nil {
 		// Check if the variable set was deleted outside of Terraform (404 error)
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") {
+		if client.IsNotFound(err) {
 			tflog.Warn(ctx, "Variable set not found, removing from state", map[string]interface{}{
 				"id": state.ID.ValueString(),
 			})
@@ -383,7 +382,7 @@ func (r *variableSetResource) Update(ctx context.Context, req resource.UpdateReq
 
 	tflog.Debug(ctx, "Updating variable set", map[string]interface{}{"id": plan.ID.ValueString()})
 
-	variableSet, err := r.client.VariableSets.UpdateVariableSet(plan.ID.ValueString(), updateReq)
+	variableSet, err := r.client.VariableSets().UpdateVariableSet(ctx, plan.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Updating Variable Set", fmt.Sprintf("Could not update variable set ID %s: %s", plan.ID.ValueString(), err))
 		return
@@ -429,8 +428,8 @@ func (r *variableSetResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	tflog.Debug(ctx, "Deleting variable set", map[string]interface{}{"id": state.ID.ValueString()})
 
-	err := r.client.VariableSets.DeleteVariableSet(state.ID.ValueString())
-	if err != nil {
+	err := r.client.VariableSets().DeleteVariableSet(ctx, state.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Error Deleting Variable Set", fmt.Sprintf("Could not delete variable set ID %s: %s", state.ID.ValueString(), err))
 		return
 	}
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -157,4 +161,112 @@ resource "firefly_workflows_variable_set" "child" {
   }
 }
 `
-}
\ No newline at end of file
+}
+func TestVariableSetResource_Create(t *testing.T) {
+	var created client.CreateVariableSetRequest
+	api := &mockAPI{}
+	api.variableSets.createVariableSet = func(ctx context.Context, req client.CreateVariableSetRequest) (*client.CreateVariableSetResponse, error) {
+		created = req
+		return &client.CreateVariableSetResponse{VariableSetID: "set-1"}, nil
+	}
+	api.variableSets.getVariableSet = func(ctx context.Context, id string) (*client.VariableSet, error) {
+		return &client.VariableSet{ID: id, Version: 1, Name: created.Name, Parents: created.Parents}, nil
+	}
+
+	r := NewVariableSetResource()
+	configureResource(t, r, api)
+
+	var state VariableSetResourceModel
+	testCreate(t, r, &VariableSetResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("shared"),
+		Description: types.StringValue("Shared variables"),
+		Labels:      testStrings("shared"),
+		Parents:     testStrings("parent-1"),
+		Variables:   testVariables(client.Variable{Key: "TOKEN", Value: "secret", Sensitivity: client.SensitivitySecret, Destination: client.DestinationEnv}),
+		Version:     types.Int64Unknown(),
+	}, &state)
+
+	expected := client.CreateVariableSetRequest{
+		Name:        "shared",
+		Description: "Shared variables",
+		Labels:      []string{"shared"},
+		Parents:     []string{"parent-1"},
+		Variables:   []client.Variable{{Key: "TOKEN", Value: "secret", Sensitivity: client.SensitivitySecret, Destination: client.DestinationEnv}},
+	}
+	if !reflect.DeepEqual(created, expected) {
+		t.Errorf("Expected request %+v, got %+v", expected, created)
+	}
+
+	if state.ID.ValueString() != "set-1" || state.Version.ValueInt64() != 1 {
+		t.Errorf("Unexpected ID %s or version %s", state.ID, state.Version)
+	}
+	if !state.Parents.Equal(testStrings("parent-1")) {
+		t.Errorf("Unexpected parents %s", state.Parents)
+	}
+	// The API may not return the labels, the planned ones are kept
+	if !state.Labels.Equal(testStrings("shared")) {
+		t.Errorf("Expected the planned labels to be kept, got %s", state.Labels)
+	}
+}
+
+func TestVariableSetResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.variableSets.getVariableSet = func(ctx context.Context, id string) (*client.VariableSet, error) {
+		return &client.VariableSet{
+			ID:          id,
+			Version:     4,
+			Name:        "shared",
+			Description: "updated",
+			Variables:   []client.Variable{{Key: "REGION", Value: "eu-west-1", Sensitivity: client.SensitivityString, Destination: client.DestinationIAC}},
+		}, nil
+	}
+
+	r := NewVariableSetResource()
+	configureResource(t, r, api)
+
+	var state VariableSetResourceModel
+	found := testRead(t, r, &VariableSetResourceModel{
+		ID:          types.StringValue("set-1"),
+		Name:        types.StringValue("shared"),
+		Description: types.StringValue("Shared variables"),
+		Labels:      testStrings("shared"),
+		Parents:     testStrings(),
+		Variables:   types.ListNull(testVariableType),
+		Version:     types.Int64Value(1),
+	}, &state)
+	if !found {
+		t.Fatal("Expected the variable set to remain in the state")
+	}
+
+	if state.Description.ValueString() != "updated" || state.Version.ValueInt64() != 4 {
+		t.Errorf("Unexpected description %s or version %s", state.Description, state.Version)
+	}
+	if !state.Labels.Equal(testStrings()) || !state.Parents.Equal(testStrings()) {
+		t.Errorf("Expected empty labels and parents, got %s and %s", state.Labels, state.Parents)
+	}
+	if expected := testVariables(client.Variable{Key: "REGION", Value: "eu-west-1", Sensitivity: client.SensitivityString, Destination: client.DestinationIAC}); !state.Variables.Equal(expected) {
+		t.Errorf("Expected variables %s, got %s", expected, state.Variables)
+	}
+}
+
+func TestVariableSetResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.variableSets.getVariableSet = func(ctx context.Context, id string) (*client.VariableSet, error) {
+		return nil, notFoundError()
+	}
+
+	r := NewVariableSetResource()
+	configureResource(t, r, api)
+
+	var state VariableSetResourceModel
+	if testRead(t, r, &VariableSetResourceModel{
+		ID:        types.StringValue("set-1"),
+		Name:      types.StringValue("shared"),
+		Labels:    types.ListNull(types.StringType),
+		Parents:   types.ListNull(types.StringType),
+		Variables: types.ListNull(testVariableType),
+	}, &state) {
+		t.Error("Expected the variable set to be removed from the state")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
 )
 
@@ -27,7 +28,7 @@ func NewWorkspaceLabelsResource() resource.Resource {
 
 // workspaceLabelsResource is the resource implementation
 type workspaceLabelsResource struct {
-	client *client.Client
+	client client.API
 }
 
 // WorkspaceLabelsResourceModel describes the workspace labels resource data model
@@ -86,11 +87,11 @@ func (r *workspaceLabelsResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.API)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -117,7 +118,7 @@ func (r *workspaceLabelsResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Update the workspace labels
-	updateResp, err := r.client.Workspaces.UpdateWorkspaceLabels(plan.WorkspaceID.ValueString(), labels)
+	updateResp, err := r.client.Workspaces().UpdateWorkspaceLabels(ctx, plan.WorkspaceID.ValueString(), labels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Workspace Labels",
@@ -155,8 +156,17 @@ func (r *workspaceLabelsResource) Read(ctx context.Context, req resource.ReadReq
 	// Search for workspace by ID
 	workspaceID := state.WorkspaceID.ValueString()
 	
-	// List workspaces (filtering will be done client-side since there's no direct get endpoint)
-	workspaces, err := r.client.Workspaces.ListWorkspaces(&client.ListWorkspacesRequest{}, 0, 100)
+	// Find the workspace with matching ID across every page (there's no direct get endpoint)
+	workspace, err := client.Find(r.client.Workspaces().AllWorkspaces(ctx, &client.ListWorkspacesRequest{}), func(w client.Workspace) bool {
+		return w.WorkspaceID == workspaceID
+	})
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "Workspace not found, removing from state", map[string]interface{}{
+			"workspace_id": workspaceID,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Workspace",
@@ -165,23 +175,6 @@ func (r *workspaceLabelsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// Find the workspace with matching ID
-	var workspace *client.Workspace
-	for _, w := range workspaces {
-		if w.WorkspaceID == workspaceID {
-			workspace = &w
-			break
-		}
-	}
-
-	if workspace == nil {
-		resp.Diagnostics.AddError(
-			"Workspace Not Found",
-			fmt.Sprintf("Workspace with ID %s not found", workspaceID),
-		)
-		return
-	}
-
 	// Update state with found workspace data
 	state.ID = types.StringValue(workspace.ID)
 	state.WorkspaceName = types.StringValue(workspace.WorkspaceName)
@@ -219,7 +212,7 @@ func (r *workspaceLabelsResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Update the workspace labels
-	updateResp, err := r.client.Workspaces.UpdateWorkspaceLabels(plan.WorkspaceID.ValueString(), labels)
+	updateResp, err := r.client.Workspaces().UpdateWorkspaceLabels(ctx, plan.WorkspaceID.ValueString(), labels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Workspace Labels",
@@ -255,8 +248,8 @@ func (r *workspaceLabelsResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Clear labels from workspace by setting an empty list
-	_, err := r.client.Workspaces.UpdateWorkspaceLabels(state.WorkspaceID.ValueString(), []string{})
-	if err != nil {
+	_, err := r.client.Workspaces().UpdateWorkspaceLabels(ctx, state.WorkspaceID.ValueString(), []string{})
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Clearing Workspace Labels",
 			fmt.Sprintf("Could not clear labels for workspace ID %s: %s", state.WorkspaceID.ValueString(), err),
@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
+	"iter"
+	"reflect"
 	"testing"
 
+	"github.com/gofireflyio/terraform-provider-firefly/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -112,4 +117,87 @@ resource "firefly_workspace_labels" "empty" {
   labels       = []
 }
 `
-}
\ No newline at end of file
+}
+func TestWorkspaceLabelsResource_Create(t *testing.T) {
+	var updated []string
+	api := &mockAPI{}
+	api.workspaces.updateWorkspaceLabels = func(ctx context.Context, workspaceID string, labels []string) (*client.UpdateWorkspaceLabelsResponse, error) {
+		if workspaceID != "workspace-1" {
+			t.Errorf("Expected workspace workspace-1, got %s", workspaceID)
+		}
+		updated = labels
+		return &client.UpdateWorkspaceLabelsResponse{
+			ID:            "doc-1",
+			WorkspaceName: "network",
+			Labels:        labels,
+			UpdatedAt:     "2024-01-01T00:00:00Z",
+		}, nil
+	}
+
+	r := NewWorkspaceLabelsResource()
+	configureResource(t, r, api)
+
+	var state WorkspaceLabelsResourceModel
+	testCreate(t, r, &WorkspaceLabelsResourceModel{
+		ID:            types.StringUnknown(),
+		WorkspaceID:   types.StringValue("workspace-1"),
+		WorkspaceName: types.StringUnknown(),
+		Labels:        testStrings("prod", "network"),
+		UpdatedAt:     types.StringUnknown(),
+	}, &state)
+
+	if expected := []string{"prod", "network"}; !reflect.DeepEqual(updated, expected) {
+		t.Errorf("Expected labels %v, got %v", expected, updated)
+	}
+	if state.ID.ValueString() != "doc-1" || state.WorkspaceName.ValueString() != "network" {
+		t.Errorf("Unexpected ID %s or workspace name %s", state.ID, state.WorkspaceName)
+	}
+}
+
+func TestWorkspaceLabelsResource_Read(t *testing.T) {
+	api := &mockAPI{}
+	api.workspaces.allWorkspaces = func(ctx context.Context, request *client.ListWorkspacesRequest) iter.Seq2[client.Workspace, error] {
+		return sliceSeq(
+			client.Workspace{ID: "doc-0", WorkspaceID: "workspace-0", Labels: []string{"other"}},
+			client.Workspace{ID: "doc-1", WorkspaceID: "workspace-1", WorkspaceName: "network", Labels: []string{"prod"}},
+		)
+	}
+
+	r := NewWorkspaceLabelsResource()
+	configureResource(t, r, api)
+
+	var state WorkspaceLabelsResourceModel
+	found := testRead(t, r, &WorkspaceLabelsResourceModel{
+		ID:            types.StringValue("doc-1"),
+		WorkspaceID:   types.StringValue("workspace-1"),
+		WorkspaceName: types.StringValue("network"),
+		Labels:        testStrings("prod", "network"),
+		UpdatedAt:     types.StringValue("2024-01-01T00:00:00Z"),
+	}, &state)
+	if !found {
+		t.Fatal("Expected the labels to remain in the state")
+	}
+
+	if !state.Labels.Equal(testStrings("prod")) {
+		t.Errorf("Expected labels [prod], got %s", state.Labels)
+	}
+}
+
+func TestWorkspaceLabelsResource_ReadNotFound(t *testing.T) {
+	api := &mockAPI{}
+	api.workspaces.allWorkspaces = func(ctx context.Context, request *client.ListWorkspacesRequest) iter.Seq2[client.Workspace, error] {
+		return sliceSeq[client.Workspace]()
+	}
+
+	r := NewWorkspaceLabelsResource()
+	configureResource(t, r, api)
+
+	var state WorkspaceLabelsResourceModel
+	if testRead(t, r, &WorkspaceLabelsResourceModel{
+		ID:          types.StringValue("doc-1"),
+		WorkspaceID: types.StringValue("workspace-1"),
+		Labels:      testStrings("prod"),
+	}, &state) {
+		t.Error("Expected the labels to be removed from the state")
+	}
+}
@@ -0,0 +1,34 @@
+#!/bin/bash
+
+# Run the acceptance tests against the in-memory fake of the Firefly API without network access.
+# The test binary is built first, then run in a new network namespace that only has the loopback
+# interface, so any request leaving the machine fails the tests. Needs Linux, root or sudo, and a
+# terraform binary in the PATH or in TF_ACC_TERRAFORM_PATH.
+set -euo pipefail
+
+if [[ -n "${FIREFLY_ACCESS_KEY:-}" || -n "${FIREFLY_SECRET_KEY:-}" ]]; then
+    echo 'Unset FIREFLY_ACCESS_KEY and FIREFLY_SECRET_KEY to run the acceptance tests against the fake API.'
+    exit 1
+fi
+
+terraform_path=${TF_ACC_TERRAFORM_PATH:-$(command -v terraform || true)}
+if [[ -z ${terraform_path} ]]; then
+    echo 'terraform is required, it cannot be downloaded without network access.'
+    exit 1
+fi
+
+echo "==> Building the provider tests..."
+test_dir=$(mktemp -d)
+trap 'rm -rf "${test_dir}"' EXIT
+go test -c -o "${test_dir}/provider.test" ./internal/provider
+
+sudo=""
+if [[ $(id -u) -ne 0 ]]; then
+    sudo="sudo"
+fi
+
+echo "==> Running the acceptance tests without network access..."
+cd internal/provider
+${sudo} env TF_ACC=1 TF_ACC_TERRAFORM_PATH="${terraform_path}" CHECKPOINT_DISABLE=1 PATH="${PATH}" \
+    unshare --net sh -c 'ip link set lo up && exec "$@"' sh \
+    "${test_dir}/provider.test" -test.run "${TESTARGS:-TestAcc}" -test.timeout 30m -test.v